@@ -13,12 +13,21 @@ type DomainHints struct {
 type TestFile struct {
 	// DomainHints contains metadata for AI-based domain classification.
 	DomainHints *DomainHints `json:"domainHints,omitempty"`
+	// ExternalPackage reports whether the file declares an external test package
+	// (package orders_test), which sees only the exported API of the package it tests.
+	ExternalPackage bool `json:"externalPackage,omitempty"`
 	// Framework is the detected test framework (e.g., "jest", "vitest").
 	Framework string `json:"framework"`
 	// Language is the programming language of this file.
 	Language Language `json:"language"`
+	// Package is the import path of the package the file's tests run in, as go test
+	// reports it (e.g., "example.com/shop/orders"), for external test packages too.
+	Package string `json:"package,omitempty"`
 	// Path is the file path.
 	Path string `json:"path"`
+	// Project is the name of the runner project the file belongs to (e.g., a Vitest
+	// workspace project or a PHPUnit testsuite), as used to select it on the command line.
+	Project string `json:"project,omitempty"`
 	// Suites contains the test suites in this file.
 	Suites []TestSuite `json:"suites,omitempty"`
 	// Tests contains the top-level tests in this file (outside any suite).
@@ -0,0 +1,16 @@
+package domain
+
+// TestKind classifies how a test exercises the code under test.
+// The zero value is an ordinary example-based test.
+type TestKind string
+
+const (
+	// TestKindProperty indicates a property-based test run against generated inputs
+	// (Hypothesis @given, proptest! blocks).
+	TestKindProperty TestKind = "property"
+	// TestKindDoctest indicates examples embedded in documentation (Python >>> blocks).
+	TestKindDoctest TestKind = "doctest"
+	// TestKindIntegration indicates a test the build runs as an integration test
+	// (Maven Failsafe *IT classes, Gradle integrationTest source sets).
+	TestKindIntegration TestKind = "integration"
+)
@@ -6,16 +6,25 @@ type Language string
 
 // Supported languages for test file parsing.
 const (
+	LanguageC          Language = "c"
 	LanguageCpp        Language = "cpp"
 	LanguageCSharp     Language = "csharp"
+	LanguageElixir     Language = "elixir"
+	LanguageGherkin    Language = "gherkin"
 	LanguageGo         Language = "go"
+	LanguageGroovy     Language = "groovy"
+	LanguageHCL        Language = "hcl"
 	LanguageJava       Language = "java"
 	LanguageJavaScript Language = "javascript"
 	LanguageKotlin     Language = "kotlin"
+	LanguageLua        Language = "lua"
 	LanguagePHP        Language = "php"
 	LanguagePython     Language = "python"
+	LanguageRobot      Language = "robot"
 	LanguageRuby       Language = "ruby"
 	LanguageRust       Language = "rust"
+	LanguageScala      Language = "scala"
+	LanguageShell      Language = "shell"
 	LanguageSwift      Language = "swift"
 	LanguageTSX        Language = "tsx"
 	LanguageTypeScript Language = "typescript"
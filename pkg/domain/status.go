@@ -1,7 +1,7 @@
 package domain
 
 // TestStatus represents the execution behavior of a test.
-// Maps to database ENUM: active, skipped, todo, focused, xfail
+// Maps to database ENUM: active, skipped, todo, focused, xfail, excluded
 type TestStatus string
 
 // Test status values aligned with DB schema.
@@ -17,4 +17,7 @@ const (
 	TestStatusFocused TestStatus = "focused"
 	// TestStatusXfail indicates a test expected to fail (pytest xfail, RSpec pending).
 	TestStatusXfail TestStatus = "xfail"
+	// TestStatusExcluded indicates a test filtered out by the runner's default
+	// configuration (RSpec filter_run_excluding, --tag ~slow).
+	TestStatusExcluded TestStatus = "excluded"
 )
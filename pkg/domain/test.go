@@ -10,6 +10,13 @@ type Test struct {
 	Status TestStatus `json:"status"`
 	// Modifier is the original framework marker (skip, todo, fixme, @Disabled, etc.).
 	Modifier string `json:"modifier,omitempty"`
+	// Tags contains framework-level labels attached to the test (Catch2 "[tag]", Boost.Test label, etc.).
+	Tags []string `json:"tags,omitempty"`
+	// Kind distinguishes property-based, documentation and integration tests from example tests.
+	Kind TestKind `json:"kind,omitempty"`
+	// ID is the fully qualified name the runner reports for the test, when the file
+	// tells it (e.g., go test's "example.com/shop/orders.TestCheckout/empty_cart").
+	ID string `json:"id,omitempty"`
 }
 
 // TestSuite represents a test suite (describe, test.describe).
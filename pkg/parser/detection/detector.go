@@ -42,6 +42,10 @@ func (d *Detector) SetProjectScope(scope *framework.AggregatedProjectScope) {
 func (d *Detector) Detect(ctx context.Context, filePath string, content []byte) Result {
 	lang := detectLanguage(filePath)
 	if lang == "" {
+		// Files such as pytest doctest .txt/.rst are only tests because a config collects them.
+		if scope := d.projectScope.FindCollectingConfig(filePath); scope != nil {
+			return ConfirmedWithScope(scope.Framework, scope)
+		}
 		return Unknown()
 	}
 
@@ -90,6 +94,8 @@ func (d *Detector) detectFromImport(ctx context.Context, lang domain.Language, c
 		return ""
 	case domain.LanguageJava:
 		imports = extraction.ExtractJavaImports(ctx, content)
+	case domain.LanguageGroovy:
+		imports = extraction.ExtractGroovyImports(ctx, content)
 	case domain.LanguagePython:
 		imports = extraction.ExtractPythonImports(ctx, content)
 	case domain.LanguageCSharp:
@@ -97,14 +103,17 @@ func (d *Detector) detectFromImport(ctx context.Context, lang domain.Language, c
 	case domain.LanguageRuby:
 		imports = extraction.ExtractRubyRequires(ctx, content)
 	case domain.LanguageRust:
-		// Rust built-in tests use #[test] attribute, not imports.
-		// Third-party frameworks (rstest, proptest, criterion) are not yet supported.
-		// Future: implement ExtractRustImports for `use rstest::rstest;` etc.
-		return ""
+		imports = extraction.ExtractRustImports(ctx, content)
+	case domain.LanguageScala:
+		imports = extraction.ExtractScalaImports(ctx, content)
 	case domain.LanguagePHP:
 		imports = extraction.ExtractPHPUses(ctx, content)
 	case domain.LanguageSwift:
 		imports = extraction.ExtractSwiftImports(ctx, content)
+	case domain.LanguageC, domain.LanguageCpp:
+		imports = extraction.ExtractCppIncludes(ctx, content)
+	case domain.LanguageElixir:
+		imports = extraction.ExtractElixirImports(ctx, content)
 	}
 
 	if len(imports) == 0 {
@@ -265,8 +274,12 @@ func detectLanguage(filePath string) domain.Language {
 		return domain.LanguageGo
 	case ".java":
 		return domain.LanguageJava
+	case ".groovy":
+		return domain.LanguageGroovy
 	case ".kt", ".kts":
 		return domain.LanguageKotlin
+	case ".lua":
+		return domain.LanguageLua
 	case ".py":
 		return domain.LanguagePython
 	case ".cs":
@@ -275,12 +288,26 @@ func detectLanguage(filePath string) domain.Language {
 		return domain.LanguageRuby
 	case ".rs":
 		return domain.LanguageRust
+	case ".scala":
+		return domain.LanguageScala
+	case ".c":
+		return domain.LanguageC
 	case ".cc", ".cpp", ".cxx":
 		return domain.LanguageCpp
+	case ".ex", ".exs":
+		return domain.LanguageElixir
 	case ".php":
 		return domain.LanguagePHP
 	case ".swift":
 		return domain.LanguageSwift
+	case ".feature":
+		return domain.LanguageGherkin
+	case ".bats":
+		return domain.LanguageShell
+	case ".hcl":
+		return domain.LanguageHCL
+	case ".robot":
+		return domain.LanguageRobot
 	default:
 		return ""
 	}
@@ -361,6 +361,16 @@ func TestDetectLanguage(t *testing.T) {
 		{"/project/test.cjs", domain.LanguageJavaScript},
 		{"/project/test.go", domain.LanguageGo},
 		{"/project/test.py", domain.LanguagePython},
+		{"/project/test_stack.c", domain.LanguageC},
+		{"/project/stack_test.cpp", domain.LanguageCpp},
+		{"/project/src/test/scala/SetSpec.scala", domain.LanguageScala},
+		{"/project/test/user_test.exs", domain.LanguageElixir},
+		{"/project/src/test/groovy/StackSpec.groovy", domain.LanguageGroovy},
+		{"/project/features/login.feature", domain.LanguageGherkin},
+		{"/project/test/deploy.bats", domain.LanguageShell},
+		{"/project/spec/calc_spec.lua", domain.LanguageLua},
+		{"/project/tests/network.tftest.hcl", domain.LanguageHCL},
+		{"/project/atest/login.robot", domain.LanguageRobot},
 		{"/project/test.txt", ""},
 	}
 
@@ -0,0 +1,44 @@
+package extraction
+
+import (
+	"context"
+	"regexp"
+)
+
+// C/C++ include patterns:
+// - #include <gtest/gtest.h>
+// - #include "catch2/catch_test_macros.hpp"
+// - #  include <boost/test/unit_test.hpp>
+
+var cppIncludePattern = regexp.MustCompile(`(?m)^\s*#\s*include\s*[<"]([^>"\n]+)[>"]`)
+
+// ExtractCppIncludes extracts header paths from C/C++ #include directives.
+func ExtractCppIncludes(_ context.Context, content []byte) []string {
+	matches := cppIncludePattern.FindAllSubmatch(content, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]struct{}, len(matches))
+	includes := make([]string, 0, len(matches))
+
+	for _, match := range matches {
+		if len(match) < 2 {
+			continue
+		}
+
+		path := string(match[1])
+		if path == "" {
+			continue
+		}
+
+		if _, ok := seen[path]; ok {
+			continue
+		}
+
+		seen[path] = struct{}{}
+		includes = append(includes, path)
+	}
+
+	return includes
+}
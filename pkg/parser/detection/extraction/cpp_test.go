@@ -0,0 +1,54 @@
+package extraction
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestExtractCppIncludes(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name: "system include",
+			content: `#include <gtest/gtest.h>
+`,
+			expected: []string{"gtest/gtest.h"},
+		},
+		{
+			name: "quoted include",
+			content: `#include "doctest/doctest.h"
+`,
+			expected: []string{"doctest/doctest.h"},
+		},
+		{
+			name: "spacing variations and duplicates",
+			content: `#  include <boost/test/unit_test.hpp>
+#include<catch2/catch_test_macros.hpp>
+#include <boost/test/unit_test.hpp>
+`,
+			expected: []string{"boost/test/unit_test.hpp", "catch2/catch_test_macros.hpp"},
+		},
+		{
+			name: "no includes",
+			content: `int main() {
+    return 0;
+}
+`,
+			expected: nil,
+		},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ExtractCppIncludes(ctx, []byte(tt.content))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package extraction
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+// Elixir module directive patterns:
+// - use ExUnit.Case, async: true
+// - import Plug.Conn
+// - alias MyApp.Accounts.{User, Org}
+// - require Logger
+
+var elixirDirectivePattern = regexp.MustCompile(`(?m)^\s*(?:use|import|alias|require)\s+([A-Z]\w*(?:\.[A-Z]\w*)*(?:\.\{[^}]*\})?)`)
+
+// ExtractElixirImports extracts module names from Elixir use/import/alias/require directives.
+// Multi-alias groups are expanded, so `alias MyApp.{User, Org}` yields "MyApp.User" and "MyApp.Org".
+func ExtractElixirImports(_ context.Context, content []byte) []string {
+	matches := elixirDirectivePattern.FindAllSubmatch(content, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]struct{}, len(matches))
+	imports := make([]string, 0, len(matches))
+
+	for _, match := range matches {
+		if len(match) < 2 {
+			continue
+		}
+
+		for _, module := range expandElixirAliases(string(match[1])) {
+			if _, ok := seen[module]; ok {
+				continue
+			}
+
+			seen[module] = struct{}{}
+			imports = append(imports, module)
+		}
+	}
+
+	return imports
+}
+
+func expandElixirAliases(module string) []string {
+	idx := strings.Index(module, ".{")
+	if idx < 0 {
+		return []string{module}
+	}
+
+	prefix := module[:idx]
+	var modules []string
+	for _, name := range strings.Split(strings.TrimSuffix(module[idx+2:], "}"), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			modules = append(modules, prefix+"."+name)
+		}
+	}
+	return modules
+}
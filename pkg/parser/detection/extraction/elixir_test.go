@@ -0,0 +1,54 @@
+package extraction
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestExtractElixirImports(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name: "use with options",
+			content: `defmodule MyApp.UserTest do
+  use ExUnit.Case, async: true
+`,
+			expected: []string{"ExUnit.Case"},
+		},
+		{
+			name: "import alias and require",
+			content: `  import Plug.Conn
+  alias MyApp.Accounts
+  require Logger
+`,
+			expected: []string{"Plug.Conn", "MyApp.Accounts", "Logger"},
+		},
+		{
+			name: "multi alias",
+			content: `  alias MyApp.Accounts.{User, Org}
+`,
+			expected: []string{"MyApp.Accounts.User", "MyApp.Accounts.Org"},
+		},
+		{
+			name: "no directives",
+			content: `defmodule MyApp.User do
+  def name(user), do: user.name
+end
+`,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExtractElixirImports(context.Background(), []byte(tt.content))
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ExtractElixirImports() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package extraction
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+// Groovy import patterns:
+// - import spock.lang.Specification
+// - import spock.lang.*
+// - import static org.junit.Assert.*
+// - import spock.lang.Unroll as U
+
+var groovyImportPattern = regexp.MustCompile(`(?m)^\s*import\s+(?:static\s+)?([A-Za-z_][\w.]*)`)
+
+// ExtractGroovyImports extracts package paths from Groovy import statements.
+// Wildcards are dropped, so `import spock.lang.*` yields "spock.lang".
+func ExtractGroovyImports(_ context.Context, content []byte) []string {
+	matches := groovyImportPattern.FindAllSubmatch(content, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]struct{}, len(matches))
+	imports := make([]string, 0, len(matches))
+
+	for _, match := range matches {
+		if len(match) < 2 {
+			continue
+		}
+
+		importPath := strings.TrimSuffix(string(match[1]), ".")
+		if importPath == "" {
+			continue
+		}
+
+		if _, ok := seen[importPath]; ok {
+			continue
+		}
+
+		seen[importPath] = struct{}{}
+		imports = append(imports, importPath)
+	}
+
+	return imports
+}
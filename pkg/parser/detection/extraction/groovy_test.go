@@ -0,0 +1,52 @@
+package extraction
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestExtractGroovyImports(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name: "single import",
+			content: `import spock.lang.Specification
+`,
+			expected: []string{"spock.lang.Specification"},
+		},
+		{
+			name: "wildcard and static imports",
+			content: `import spock.lang.*
+import static org.junit.Assert.*
+`,
+			expected: []string{"spock.lang", "org.junit.Assert"},
+		},
+		{
+			name: "aliased import with semicolon",
+			content: `import spock.lang.Unroll as U;
+`,
+			expected: []string{"spock.lang.Unroll"},
+		},
+		{
+			name: "no imports",
+			content: `class Helper {
+  def run() {}
+}
+`,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExtractGroovyImports(context.Background(), []byte(tt.content))
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ExtractGroovyImports() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package extraction
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+// Rust use patterns:
+// - use rstest::rstest;
+// - use proptest::prelude::*;
+// - use test_case::test_case as tc;
+// - pub use std::{io, fmt::Write};
+// - extern crate rstest;
+
+var (
+	rustUsePattern         = regexp.MustCompile(`(?m)^\s*(?:pub(?:\([^)]*\))?\s+)?use\s+([^;]+);`)
+	rustExternCratePattern = regexp.MustCompile(`(?m)^\s*extern\s+crate\s+([A-Za-z_][A-Za-z0-9_]*)`)
+	rustAliasPattern       = regexp.MustCompile(`\s+as\s+[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// ExtractRustImports extracts fully qualified paths from Rust use declarations.
+// Grouped imports are expanded (use a::{b, c::d} yields "a::b" and "a::c::d"),
+// aliases are dropped, and extern crate declarations yield the crate name.
+func ExtractRustImports(_ context.Context, content []byte) []string {
+	var paths []string
+
+	for _, match := range rustExternCratePattern.FindAllSubmatch(content, -1) {
+		paths = append(paths, string(match[1]))
+	}
+	for _, match := range rustUsePattern.FindAllSubmatch(content, -1) {
+		paths = append(paths, expandRustUseTree("", string(match[1]))...)
+	}
+
+	if len(paths) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]struct{}, len(paths))
+	imports := make([]string, 0, len(paths))
+	for _, path := range paths {
+		if _, ok := seen[path]; ok {
+			continue
+		}
+		seen[path] = struct{}{}
+		imports = append(imports, path)
+	}
+
+	return imports
+}
+
+// expandRustUseTree flattens a use tree into individual paths joined to prefix.
+func expandRustUseTree(prefix, tree string) []string {
+	tree = strings.TrimPrefix(strings.TrimSpace(tree), "::")
+	if tree == "" {
+		return nil
+	}
+
+	open := strings.Index(tree, "{")
+	if open < 0 || !strings.HasSuffix(tree, "}") {
+		tree = rustAliasPattern.ReplaceAllString(tree, "")
+		return []string{joinRustPath(prefix, strings.Join(strings.Fields(tree), ""))}
+	}
+
+	head := strings.Join(strings.Fields(tree[:open]), "")
+	base := joinRustPath(prefix, strings.TrimSuffix(head, "::"))
+	var paths []string
+	for _, item := range splitRustUseList(tree[open+1 : len(tree)-1]) {
+		if item == "self" {
+			paths = append(paths, base)
+			continue
+		}
+		paths = append(paths, expandRustUseTree(base, item)...)
+	}
+	return paths
+}
+
+// splitRustUseList splits a use list on top-level commas.
+func splitRustUseList(list string) []string {
+	var items []string
+	depth := 0
+	start := 0
+	for i, r := range list {
+		switch r {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				if item := strings.TrimSpace(list[start:i]); item != "" {
+					items = append(items, item)
+				}
+				start = i + 1
+			}
+		}
+	}
+	if item := strings.TrimSpace(list[start:]); item != "" {
+		items = append(items, item)
+	}
+	return items
+}
+
+func joinRustPath(prefix, path string) string {
+	if prefix == "" {
+		return path
+	}
+	if path == "" {
+		return prefix
+	}
+	return prefix + "::" + path
+}
@@ -0,0 +1,82 @@
+package extraction
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestExtractRustImports(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name: "simple use",
+			content: `use rstest::rstest;
+`,
+			expected: []string{"rstest::rstest"},
+		},
+		{
+			name: "glob use",
+			content: `use proptest::prelude::*;
+`,
+			expected: []string{"proptest::prelude::*"},
+		},
+		{
+			name: "aliased use",
+			content: `use test_case::test_case as tc;
+`,
+			expected: []string{"test_case::test_case"},
+		},
+		{
+			name: "grouped use with nested paths",
+			content: `use std::{io, fmt::{self, Write}};
+`,
+			expected: []string{"std::io", "std::fmt", "std::fmt::Write"},
+		},
+		{
+			name: "pub and leading path separator",
+			content: `pub(crate) use ::serde::Deserialize;
+pub use super::*;
+`,
+			expected: []string{"serde::Deserialize", "super::*"},
+		},
+		{
+			name: "extern crate",
+			content: `extern crate rstest;
+use rstest::fixture;
+`,
+			expected: []string{"rstest", "rstest::fixture"},
+		},
+		{
+			name: "use inside test module",
+			content: `#[cfg(test)]
+mod tests {
+    use super::*;
+    use tokio::test;
+}
+`,
+			expected: []string{"super::*", "tokio::test"},
+		},
+		{
+			name: "no imports",
+			content: `fn main() {
+    println!("hello");
+}
+`,
+			expected: nil,
+		},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ExtractRustImports(ctx, []byte(tt.content))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package extraction
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+// Scala import patterns:
+// - import org.scalatest.funsuite.AnyFunSuite
+// - import org.scalatest._ / import munit.*
+// - import org.scalatest.{BeforeAndAfter, Matchers}
+// - import munit.FunSuite, munit.Location (Scala 3)
+
+var scalaImportPattern = regexp.MustCompile(`(?m)^\s*import\s+([^\n;]+)`)
+
+var scalaImportPathPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(?:\.[A-Za-z_][A-Za-z0-9_]*)*`)
+
+// ExtractScalaImports extracts package paths from Scala import statements.
+// Wildcards and selector groups are dropped, so `import munit._` yields "munit".
+func ExtractScalaImports(_ context.Context, content []byte) []string {
+	matches := scalaImportPattern.FindAllSubmatch(content, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]struct{}, len(matches))
+	imports := make([]string, 0, len(matches))
+
+	for _, match := range matches {
+		if len(match) < 2 {
+			continue
+		}
+
+		for _, clause := range splitScalaImportClauses(string(match[1])) {
+			importPath := strings.TrimSuffix(scalaImportPathPattern.FindString(clause), "._")
+			if importPath == "" {
+				continue
+			}
+
+			if _, ok := seen[importPath]; ok {
+				continue
+			}
+
+			seen[importPath] = struct{}{}
+			imports = append(imports, importPath)
+		}
+	}
+
+	return imports
+}
+
+// splitScalaImportClauses splits comma-separated import clauses, ignoring commas inside selector braces.
+func splitScalaImportClauses(statement string) []string {
+	var clauses []string
+	depth, start := 0, 0
+	for i, r := range statement {
+		switch r {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				clauses = append(clauses, strings.TrimSpace(statement[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(clauses, strings.TrimSpace(statement[start:]))
+}
@@ -0,0 +1,66 @@
+package extraction
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestExtractScalaImports(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name: "single import",
+			content: `import org.scalatest.funsuite.AnyFunSuite
+`,
+			expected: []string{"org.scalatest.funsuite.AnyFunSuite"},
+		},
+		{
+			name: "wildcard imports",
+			content: `import munit._
+import org.specs2.*
+`,
+			expected: []string{"munit", "org.specs2"},
+		},
+		{
+			name: "selector group",
+			content: `import org.scalatest.{BeforeAndAfter, Matchers}
+`,
+			expected: []string{"org.scalatest"},
+		},
+		{
+			name: "multiple clauses",
+			content: `import munit.FunSuite, scala.concurrent.{Future, ExecutionContext}
+`,
+			expected: []string{"munit.FunSuite", "scala.concurrent"},
+		},
+		{
+			name: "indented import inside class",
+			content: `class A {
+  import A.helpers._
+}
+`,
+			expected: []string{"A.helpers"},
+		},
+		{
+			name: "no imports",
+			content: `object Main extends App {
+  println("hi")
+}
+`,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExtractScalaImports(context.Background(), []byte(tt.content))
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ExtractScalaImports() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
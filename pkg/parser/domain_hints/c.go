@@ -0,0 +1,161 @@
+package domain_hints
+
+import (
+	"context"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/tspool"
+)
+
+// CExtractor extracts domain hints from C source code.
+type CExtractor struct{}
+
+const (
+	// #include <stdio.h>, #include "stack.h"
+	cIncludeQuery = `(preproc_include path: (_) @include)`
+
+	// Function calls and function pointer calls through struct fields
+	cCallQuery = `
+		(call_expression
+			function: [
+				(identifier) @call
+				(field_expression) @call
+			]
+		)
+	`
+)
+
+func (e *CExtractor) Extract(ctx context.Context, source []byte) *domain.DomainHints {
+	tree, err := tspool.Parse(ctx, domain.LanguageC, source)
+	if err != nil {
+		return nil
+	}
+	defer tree.Close()
+
+	root := tree.RootNode()
+
+	hints := &domain.DomainHints{
+		Imports: e.extractImports(root, source),
+		Calls:   e.extractCalls(root, source),
+	}
+
+	if len(hints.Imports) == 0 && len(hints.Calls) == 0 {
+		return nil
+	}
+
+	return hints
+}
+
+func (e *CExtractor) extractImports(root *sitter.Node, source []byte) []string {
+	results, err := tspool.QueryWithCache(root, source, domain.LanguageC, cIncludeQuery)
+	if err != nil {
+		return nil
+	}
+
+	seen := make(map[string]struct{})
+	var imports []string
+
+	for _, r := range results {
+		if node, ok := r.Captures["include"]; ok {
+			path := extractCppIncludePath(node, source)
+			if path == "" {
+				continue
+			}
+
+			if _, exists := seen[path]; exists {
+				continue
+			}
+			seen[path] = struct{}{}
+			imports = append(imports, path)
+		}
+	}
+
+	return imports
+}
+
+func (e *CExtractor) extractCalls(root *sitter.Node, source []byte) []string {
+	results, err := tspool.QueryWithCache(root, source, domain.LanguageC, cCallQuery)
+	if err != nil {
+		return nil
+	}
+
+	seen := make(map[string]struct{})
+	calls := make([]string, 0, len(results))
+
+	for _, r := range results {
+		if node, ok := r.Captures["call"]; ok {
+			call := getNodeText(node, source)
+			if call == "" {
+				continue
+			}
+
+			// Handle -> operator for pointer access
+			call = strings.ReplaceAll(call, "->", ".")
+			call = normalizeCall(call)
+			if call == "" {
+				continue
+			}
+
+			if isCTestFrameworkCall(call) {
+				continue
+			}
+
+			if _, exists := seen[call]; exists {
+				continue
+			}
+			seen[call] = struct{}{}
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// cTestFrameworkPrefixes are assertion and registration prefixes of C test frameworks
+// (Unity, CMocka, Check, CUnit) that should be excluded from domain hints.
+var cTestFrameworkPrefixes = []string{
+	"TEST_",
+	"RUN_TEST",
+	"UNITY_",
+	"assert_",
+	"will_return",
+	"expect_",
+	"check_expected",
+	"mock",
+	"cmocka_",
+	"ck_",
+	"tcase_",
+	"suite_",
+	"srunner_",
+	"CU_",
+}
+
+// cCommonCalls are standard library calls that carry no domain meaning.
+var cCommonCalls = map[string]struct{}{
+	"printf":  {},
+	"fprintf": {},
+	"puts":    {},
+	"malloc":  {},
+	"calloc":  {},
+	"free":    {},
+	"memset":  {},
+	"memcpy":  {},
+	"assert":  {},
+	"skip":    {},
+	"fail":    {},
+}
+
+func isCTestFrameworkCall(call string) bool {
+	if _, ok := cCommonCalls[call]; ok {
+		return true
+	}
+	for _, prefix := range cTestFrameworkPrefixes {
+		if strings.HasPrefix(call, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package domain_hints
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCExtractor_Extract_IncludeStatements(t *testing.T) {
+	source := []byte(`
+#include <stdio.h>
+#include "stack.h"
+#include <cmocka.h>
+`)
+
+	extractor := &CExtractor{}
+	hints := extractor.Extract(context.Background(), source)
+
+	if hints == nil {
+		t.Fatal("expected hints, got nil")
+	}
+
+	expected := []string{"stdio.h", "stack.h", "cmocka.h"}
+	if len(hints.Imports) != len(expected) {
+		t.Fatalf("expected %d imports, got %v", len(expected), hints.Imports)
+	}
+	for i, imp := range expected {
+		if hints.Imports[i] != imp {
+			t.Errorf("expected import %q at %d, got %q", imp, i, hints.Imports[i])
+		}
+	}
+}
+
+func TestCExtractor_Extract_FiltersFrameworkCalls(t *testing.T) {
+	source := []byte(`
+#include "unity.h"
+#include "stack.h"
+
+void test_push(void)
+{
+    Stack *s = stack_new();
+    stack_push(s, 1);
+    s->ops->reset(s);
+    TEST_ASSERT_EQUAL_INT(1, stack_size(s));
+    ck_assert_int_eq(1, 1);
+    assert_int_equal(1, 1);
+    CU_ASSERT(1);
+    free(s);
+}
+`)
+
+	extractor := &CExtractor{}
+	hints := extractor.Extract(context.Background(), source)
+
+	if hints == nil {
+		t.Fatal("expected hints, got nil")
+	}
+
+	callSet := make(map[string]bool)
+	for _, call := range hints.Calls {
+		callSet[call] = true
+	}
+
+	for _, want := range []string{"stack_new", "stack_push", "stack_size"} {
+		if !callSet[want] {
+			t.Errorf("expected call %q, got %v", want, hints.Calls)
+		}
+	}
+	for _, unwanted := range []string{"TEST_ASSERT_EQUAL_INT", "ck_assert_int_eq", "assert_int_equal", "CU_ASSERT", "free"} {
+		if callSet[unwanted] {
+			t.Errorf("expected call %q to be filtered, got %v", unwanted, hints.Calls)
+		}
+	}
+}
+
+func TestCExtractor_Extract_Empty(t *testing.T) {
+	extractor := &CExtractor{}
+	if hints := extractor.Extract(context.Background(), []byte("")); hints != nil {
+		t.Errorf("expected nil hints for empty source, got %+v", hints)
+	}
+}
@@ -0,0 +1,170 @@
+package domain_hints
+
+import (
+	"context"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/tspool"
+)
+
+// ElixirExtractor extracts domain hints from Elixir source code.
+type ElixirExtractor struct{}
+
+const (
+	// alias MyApp.Accounts, import Plug.Conn, alias MyApp.{User, Org}
+	elixirDirectiveQuery = `
+		(call
+			target: (identifier) @directive
+			(arguments . [(alias) (dot)] @module)
+		)
+	`
+
+	// Remote calls: Accounts.create_user(attrs), user.name()
+	elixirRemoteCallQuery = `
+		(call
+			target: (dot
+				left: [(alias) (identifier)] @receiver
+				right: (identifier) @function
+			)
+		)
+	`
+)
+
+// elixirImportDirectives declare module dependencies; use is excluded since it
+// mostly pulls in test case templates rather than domain modules.
+var elixirImportDirectives = map[string]struct{}{
+	"alias": {}, "import": {}, "require": {},
+}
+
+func (e *ElixirExtractor) Extract(ctx context.Context, source []byte) *domain.DomainHints {
+	tree, err := tspool.Parse(ctx, domain.LanguageElixir, source)
+	if err != nil {
+		return nil
+	}
+	defer tree.Close()
+
+	root := tree.RootNode()
+
+	hints := &domain.DomainHints{
+		Imports: e.extractImports(root, source),
+		Calls:   e.extractCalls(root, source),
+	}
+
+	if len(hints.Imports) == 0 && len(hints.Calls) == 0 {
+		return nil
+	}
+
+	return hints
+}
+
+func (e *ElixirExtractor) extractImports(root *sitter.Node, source []byte) []string {
+	results, err := tspool.QueryWithCache(root, source, domain.LanguageElixir, elixirDirectiveQuery)
+	if err != nil {
+		return nil
+	}
+
+	seen := make(map[string]struct{})
+	var imports []string
+
+	for _, r := range results {
+		directive, ok := r.Captures["directive"]
+		if !ok {
+			continue
+		}
+		if _, ok := elixirImportDirectives[getNodeText(directive, source)]; !ok {
+			continue
+		}
+		node, ok := r.Captures["module"]
+		if !ok {
+			continue
+		}
+
+		for _, module := range expandElixirModule(node, source) {
+			if _, exists := seen[module]; exists {
+				continue
+			}
+			seen[module] = struct{}{}
+			imports = append(imports, module)
+		}
+	}
+
+	return imports
+}
+
+// expandElixirModule returns the module names of a directive argument,
+// expanding multi-alias groups: MyApp.{User, Org} yields MyApp.User and MyApp.Org.
+func expandElixirModule(node *sitter.Node, source []byte) []string {
+	if node.Type() == "alias" {
+		return []string{getNodeText(node, source)}
+	}
+
+	left := node.ChildByFieldName("left")
+	right := node.ChildByFieldName("right")
+	if left == nil || right == nil || left.Type() != "alias" || right.Type() != "tuple" {
+		return nil
+	}
+
+	prefix := getNodeText(left, source)
+	var modules []string
+	for i := 0; i < int(right.NamedChildCount()); i++ {
+		child := right.NamedChild(i)
+		if child.Type() == "alias" {
+			modules = append(modules, prefix+"."+getNodeText(child, source))
+		}
+	}
+	return modules
+}
+
+func (e *ElixirExtractor) extractCalls(root *sitter.Node, source []byte) []string {
+	results, err := tspool.QueryWithCache(root, source, domain.LanguageElixir, elixirRemoteCallQuery)
+	if err != nil {
+		return nil
+	}
+
+	seen := make(map[string]struct{})
+	calls := make([]string, 0, len(results))
+
+	for _, r := range results {
+		receiver, ok := r.Captures["receiver"]
+		if !ok {
+			continue
+		}
+		function, ok := r.Captures["function"]
+		if !ok {
+			continue
+		}
+
+		// Module names are kept whole: normalizeCall would cut MyApp.Accounts.create_user
+		// down to its namespace.
+		call := getNodeText(receiver, source) + "." + getNodeText(function, source)
+		if isElixirTestFrameworkCall(call) {
+			continue
+		}
+		if _, exists := seen[call]; exists {
+			continue
+		}
+		seen[call] = struct{}{}
+		calls = append(calls, call)
+	}
+
+	return calls
+}
+
+// elixirTestFrameworkModules contains modules from ExUnit and common test
+// libraries whose remote calls should be excluded from domain hints.
+var elixirTestFrameworkModules = map[string]struct{}{
+	"ExUnit": {}, "ExUnitProperties": {}, "StreamData": {},
+	"Mox": {}, "Mock": {}, "Bypass": {},
+}
+
+func isElixirTestFrameworkCall(call string) bool {
+	baseName := call
+	if idx := strings.Index(call, "."); idx > 0 {
+		baseName = call[:idx]
+	}
+	_, exists := elixirTestFrameworkModules[baseName]
+	return exists
+}
@@ -0,0 +1,88 @@
+package domain_hints
+
+import (
+	"context"
+	"testing"
+)
+
+func TestElixirExtractor_Extract_Imports(t *testing.T) {
+	source := []byte(`
+defmodule MyApp.OrdersTest do
+  use MyApp.DataCase, async: true
+
+  import Plug.Conn
+  alias MyApp.Orders
+  alias MyApp.Billing.{Invoice, Receipt}
+  require Logger
+end
+`)
+
+	extractor := &ElixirExtractor{}
+	hints := extractor.Extract(context.Background(), source)
+
+	if hints == nil {
+		t.Fatal("expected hints, got nil")
+	}
+
+	expected := []string{
+		"Plug.Conn",
+		"MyApp.Orders",
+		"MyApp.Billing.Invoice",
+		"MyApp.Billing.Receipt",
+		"Logger",
+	}
+	if len(hints.Imports) != len(expected) {
+		t.Fatalf("expected %d imports, got %v", len(expected), hints.Imports)
+	}
+	for i, imp := range expected {
+		if hints.Imports[i] != imp {
+			t.Errorf("expected import %q at %d, got %q", imp, i, hints.Imports[i])
+		}
+	}
+}
+
+func TestElixirExtractor_Extract_RemoteCalls(t *testing.T) {
+	source := []byte(`
+defmodule MyApp.OrdersTest do
+  use ExUnit.Case
+
+  test "places an order" do
+    {:ok, order} = MyApp.Orders.place_order(cart)
+    assert order.total == Money.new(10)
+    Mox.expect(PaymentMock, :charge, fn _ -> :ok end)
+    ExUnit.CaptureLog.capture_log(fn -> :ok end)
+    callback.(order)
+  end
+end
+`)
+
+	extractor := &ElixirExtractor{}
+	hints := extractor.Extract(context.Background(), source)
+
+	if hints == nil {
+		t.Fatal("expected hints, got nil")
+	}
+
+	callSet := make(map[string]bool)
+	for _, call := range hints.Calls {
+		callSet[call] = true
+	}
+
+	for _, want := range []string{"MyApp.Orders.place_order", "Money.new"} {
+		if !callSet[want] {
+			t.Errorf("expected call %q, got %v", want, hints.Calls)
+		}
+	}
+	for _, unwanted := range []string{"Mox.expect", "ExUnit.CaptureLog.capture_log", "callback."} {
+		if callSet[unwanted] {
+			t.Errorf("expected call %q to be filtered, got %v", unwanted, hints.Calls)
+		}
+	}
+}
+
+func TestElixirExtractor_Extract_Empty(t *testing.T) {
+	extractor := &ElixirExtractor{}
+	if hints := extractor.Extract(context.Background(), []byte("")); hints != nil {
+		t.Errorf("expected nil hints for empty source, got %+v", hints)
+	}
+}
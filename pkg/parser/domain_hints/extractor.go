@@ -35,10 +35,20 @@ func GetExtractor(lang domain.Language) Extractor {
 		return &PHPExtractor{}
 	case domain.LanguageRust:
 		return &RustExtractor{}
+	case domain.LanguageScala:
+		return &ScalaExtractor{}
 	case domain.LanguageSwift:
 		return &SwiftExtractor{}
+	case domain.LanguageC:
+		return &CExtractor{}
 	case domain.LanguageCpp:
 		return &CppExtractor{}
+	case domain.LanguageElixir:
+		return &ElixirExtractor{}
+	case domain.LanguageShell:
+		return &ShellExtractor{}
+	case domain.LanguageHCL:
+		return &HCLExtractor{}
 	default:
 		return nil
 	}
@@ -0,0 +1,135 @@
+package domain_hints
+
+import (
+	"context"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/tspool"
+)
+
+// HCLExtractor extracts domain hints from Terraform and OpenTofu test files.
+// Module sources become imports and referenced resources become calls.
+type HCLExtractor struct{}
+
+const (
+	// module { source = "./modules/network" }
+	hclModuleSourceQuery = `
+		(block
+			(identifier) @block
+			(body
+				(attribute
+					(identifier) @attribute
+					(expression (literal_value (string_lit (template_literal) @source)))
+				)
+			)
+		)
+	`
+
+	// aws_s3_bucket.logs.bucket, module.network.vpc_id
+	hclReferenceQuery = `
+		(_
+			(variable_expr (identifier) @root)
+			.
+			(get_attr (identifier) @name)
+		)
+	`
+)
+
+// hclNonResourceRoots are references to test inputs and built-in values rather
+// than infrastructure under test.
+var hclNonResourceRoots = map[string]struct{}{
+	"var": {}, "local": {}, "run": {}, "each": {}, "count": {},
+	"path": {}, "terraform": {}, "self": {},
+}
+
+func (e *HCLExtractor) Extract(ctx context.Context, source []byte) *domain.DomainHints {
+	tree, err := tspool.Parse(ctx, domain.LanguageHCL, source)
+	if err != nil {
+		return nil
+	}
+	defer tree.Close()
+
+	root := tree.RootNode()
+
+	hints := &domain.DomainHints{
+		Imports: e.extractImports(root, source),
+		Calls:   e.extractCalls(root, source),
+	}
+
+	if len(hints.Imports) == 0 && len(hints.Calls) == 0 {
+		return nil
+	}
+
+	return hints
+}
+
+func (e *HCLExtractor) extractImports(root *sitter.Node, source []byte) []string {
+	results, err := tspool.QueryWithCache(root, source, domain.LanguageHCL, hclModuleSourceQuery)
+	if err != nil {
+		return nil
+	}
+
+	seen := make(map[string]struct{})
+	var imports []string
+
+	for _, r := range results {
+		block, ok := r.Captures["block"]
+		if !ok || getNodeText(block, source) != "module" {
+			continue
+		}
+		attribute, ok := r.Captures["attribute"]
+		if !ok || getNodeText(attribute, source) != "source" {
+			continue
+		}
+		node, ok := r.Captures["source"]
+		if !ok {
+			continue
+		}
+
+		path := getNodeText(node, source)
+		if _, exists := seen[path]; exists {
+			continue
+		}
+		seen[path] = struct{}{}
+		imports = append(imports, path)
+	}
+
+	return imports
+}
+
+func (e *HCLExtractor) extractCalls(root *sitter.Node, source []byte) []string {
+	results, err := tspool.QueryWithCache(root, source, domain.LanguageHCL, hclReferenceQuery)
+	if err != nil {
+		return nil
+	}
+
+	seen := make(map[string]struct{})
+	calls := make([]string, 0, len(results))
+
+	for _, r := range results {
+		rootNode, ok := r.Captures["root"]
+		if !ok {
+			continue
+		}
+		name, ok := r.Captures["name"]
+		if !ok {
+			continue
+		}
+
+		rootName := getNodeText(rootNode, source)
+		if _, skip := hclNonResourceRoots[rootName]; skip {
+			continue
+		}
+
+		call := rootName + "." + getNodeText(name, source)
+		if _, exists := seen[call]; exists {
+			continue
+		}
+		seen[call] = struct{}{}
+		calls = append(calls, call)
+	}
+
+	return calls
+}
@@ -0,0 +1,87 @@
+package domain_hints
+
+import (
+	"context"
+	"testing"
+)
+
+func TestHCLExtractor_Extract_Imports(t *testing.T) {
+	source := []byte(`provider "aws" {
+  region = "us-east-1"
+}
+
+run "setup" {
+  module {
+    source = "./tests/setup"
+  }
+}
+
+run "registry" {
+  module {
+    source  = "terraform-aws-modules/s3-bucket/aws"
+    version = "4.1.0"
+  }
+}
+
+run "again" {
+  module {
+    source = "./tests/setup"
+  }
+}
+`)
+
+	extractor := &HCLExtractor{}
+	hints := extractor.Extract(context.Background(), source)
+
+	if hints == nil {
+		t.Fatal("expected hints, got nil")
+	}
+
+	expected := []string{"./tests/setup", "terraform-aws-modules/s3-bucket/aws"}
+	if len(hints.Imports) != len(expected) {
+		t.Fatalf("expected %d imports, got %v", len(expected), hints.Imports)
+	}
+	for i, imp := range expected {
+		if hints.Imports[i] != imp {
+			t.Errorf("expected import %q at %d, got %q", imp, i, hints.Imports[i])
+		}
+	}
+}
+
+func TestHCLExtractor_Extract_References(t *testing.T) {
+	source := []byte(`run "valid_name" {
+  command = plan
+
+  assert {
+    condition     = aws_s3_bucket.bucket.bucket == "${var.prefix}-bucket"
+    error_message = "wrong name"
+  }
+
+  assert {
+    condition     = module.network.vpc_id != run.setup.vpc_id
+    error_message = "unexpected vpc"
+  }
+}
+
+run "invalid_name" {
+  expect_failures = [var.bucket_prefix, aws_s3_bucket.bucket]
+}
+`)
+
+	extractor := &HCLExtractor{}
+	hints := extractor.Extract(context.Background(), source)
+
+	if hints == nil {
+		t.Fatal("expected hints, got nil")
+	}
+
+	expected := []string{"aws_s3_bucket.bucket", "module.network"}
+	if len(hints.Calls) != len(expected) {
+		t.Fatalf("expected %d calls, got %v", len(expected), hints.Calls)
+	}
+	for i, call := range expected {
+		if hints.Calls[i] != call {
+			t.Errorf("expected call %q at %d, got %q", call, i, hints.Calls[i])
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package domain_hints
+
+import (
+	"context"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/tspool"
+)
+
+// ScalaExtractor extracts domain hints from Scala source code.
+type ScalaExtractor struct{}
+
+const (
+	// import x.y.Z, import x.y._, import x.y.{A, B}
+	scalaImportQuery = `(import_declaration) @import`
+
+	// Function calls: obj.method(), function()
+	scalaCallQuery = `
+		(call_expression
+			function: [
+				(identifier) @call
+				(field_expression) @call
+			]
+		)
+	`
+)
+
+func (e *ScalaExtractor) Extract(ctx context.Context, source []byte) *domain.DomainHints {
+	tree, err := tspool.Parse(ctx, domain.LanguageScala, source)
+	if err != nil {
+		return nil
+	}
+	defer tree.Close()
+
+	root := tree.RootNode()
+
+	hints := &domain.DomainHints{
+		Imports: e.extractImports(root, source),
+		Calls:   e.extractCalls(root, source),
+	}
+
+	if len(hints.Imports) == 0 && len(hints.Calls) == 0 {
+		return nil
+	}
+
+	return hints
+}
+
+func (e *ScalaExtractor) extractImports(root *sitter.Node, source []byte) []string {
+	results, err := tspool.QueryWithCache(root, source, domain.LanguageScala, scalaImportQuery)
+	if err != nil {
+		return nil
+	}
+
+	seen := make(map[string]struct{})
+	var imports []string
+
+	for _, r := range results {
+		if node, ok := r.Captures["import"]; ok {
+			importPath := extractScalaImportPath(node, source)
+			if importPath == "" {
+				continue
+			}
+			if _, exists := seen[importPath]; exists {
+				continue
+			}
+			seen[importPath] = struct{}{}
+			imports = append(imports, importPath)
+		}
+	}
+
+	return imports
+}
+
+// extractScalaImportPath joins the path segments of an import_declaration.
+// Wildcards and selector groups are dropped: import x.y.{A, B} yields "x.y".
+func extractScalaImportPath(node *sitter.Node, source []byte) string {
+	var segments []string
+	for i := 0; i < int(node.ChildCount()); i++ {
+		if node.FieldNameForChild(i) != "path" {
+			continue
+		}
+		child := node.Child(i)
+		if child.Type() == "identifier" {
+			segments = append(segments, child.Content(source))
+		}
+	}
+	return strings.Join(segments, ".")
+}
+
+func (e *ScalaExtractor) extractCalls(root *sitter.Node, source []byte) []string {
+	results, err := tspool.QueryWithCache(root, source, domain.LanguageScala, scalaCallQuery)
+	if err != nil {
+		return nil
+	}
+
+	seen := make(map[string]struct{})
+	calls := make([]string, 0, len(results))
+
+	for _, r := range results {
+		if node, ok := r.Captures["call"]; ok {
+			call := normalizeCall(getNodeText(node, source))
+			if call == "" {
+				continue
+			}
+			if isScalaTestFrameworkCall(call) {
+				continue
+			}
+			if _, exists := seen[call]; exists {
+				continue
+			}
+			seen[call] = struct{}{}
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// scalaTestFrameworkCalls contains base names from Scala test frameworks
+// (ScalaTest, MUnit, specs2, ScalaCheck) that should be excluded from domain hints.
+var scalaTestFrameworkCalls = map[string]struct{}{
+	// Registration DSL
+	"test": {}, "ignore": {}, "it": {}, "they": {}, "describe": {}, "property": {},
+	"feature": {}, "Feature": {}, "scenario": {}, "Scenario": {}, "behavior": {},
+	"pending": {}, "pendingUntilFixed": {}, "skipped": {},
+	// ScalaTest assertions and matchers
+	"assert": {}, "assertResult": {}, "assertThrows": {}, "intercept": {},
+	"fail": {}, "cancel": {}, "be": {}, "equal": {}, "have": {}, "contain": {},
+	"a": {}, "an": {}, "length": {}, "size": {},
+	// MUnit assertions
+	"assertEquals": {}, "assertNotEquals": {}, "assertNoDiff": {}, "interceptMessage": {},
+	"FunFixture": {},
+	// specs2 matchers
+	"haveSize": {}, "startWith": {}, "endWith": {}, "beEqualTo": {}, "beSome": {},
+	"beNone": {}, "beRight": {}, "beLeft": {}, "throwA": {},
+	// ScalaCheck
+	"forAll": {}, "Gen": {}, "Prop": {},
+}
+
+func isScalaTestFrameworkCall(call string) bool {
+	baseName := call
+	if idx := strings.Index(call, "."); idx > 0 {
+		baseName = call[:idx]
+	}
+	_, exists := scalaTestFrameworkCalls[baseName]
+	return exists
+}
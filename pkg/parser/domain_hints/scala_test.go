@@ -0,0 +1,82 @@
+package domain_hints
+
+import (
+	"context"
+	"testing"
+)
+
+func TestScalaExtractor_Extract_Imports(t *testing.T) {
+	source := []byte(`
+package com.example.orders
+
+import org.scalatest.funsuite.AnyFunSuite
+import com.example.orders.OrderService
+import com.example.payments._
+import com.example.billing.{Invoice, Receipt}
+`)
+
+	extractor := &ScalaExtractor{}
+	hints := extractor.Extract(context.Background(), source)
+
+	if hints == nil {
+		t.Fatal("expected hints, got nil")
+	}
+
+	expected := []string{
+		"org.scalatest.funsuite.AnyFunSuite",
+		"com.example.orders.OrderService",
+		"com.example.payments",
+		"com.example.billing",
+	}
+	if len(hints.Imports) != len(expected) {
+		t.Fatalf("expected %d imports, got %v", len(expected), hints.Imports)
+	}
+	for i, imp := range expected {
+		if hints.Imports[i] != imp {
+			t.Errorf("expected import %q at %d, got %q", imp, i, hints.Imports[i])
+		}
+	}
+}
+
+func TestScalaExtractor_Extract_FiltersFrameworkCalls(t *testing.T) {
+	source := []byte(`
+class OrderServiceSuite extends munit.FunSuite {
+  test("creates an order") {
+    val service = OrderService.create(repo)
+    val order = service.placeOrder(cart)
+    assertEquals(order.total, 10)
+    assert(order.isValid())
+  }
+}
+`)
+
+	extractor := &ScalaExtractor{}
+	hints := extractor.Extract(context.Background(), source)
+
+	if hints == nil {
+		t.Fatal("expected hints, got nil")
+	}
+
+	callSet := make(map[string]bool)
+	for _, call := range hints.Calls {
+		callSet[call] = true
+	}
+
+	for _, want := range []string{"OrderService.create", "service.placeOrder", "order.isValid"} {
+		if !callSet[want] {
+			t.Errorf("expected call %q, got %v", want, hints.Calls)
+		}
+	}
+	for _, unwanted := range []string{"test", "assertEquals", "assert"} {
+		if callSet[unwanted] {
+			t.Errorf("expected call %q to be filtered, got %v", unwanted, hints.Calls)
+		}
+	}
+}
+
+func TestScalaExtractor_Extract_Empty(t *testing.T) {
+	extractor := &ScalaExtractor{}
+	if hints := extractor.Extract(context.Background(), []byte("")); hints != nil {
+		t.Errorf("expected nil hints for empty source, got %+v", hints)
+	}
+}
@@ -0,0 +1,233 @@
+package domain_hints
+
+import (
+	"context"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/strategies/shared/batsfile"
+	"github.com/specvital/core/pkg/parser/tspool"
+)
+
+// ShellExtractor extracts domain hints from shell scripts and Bats test files.
+// Sourced scripts become imports and invoked commands become calls. Bats files are
+// preprocessed first, so that their @test blocks parse as functions.
+type ShellExtractor struct{}
+
+const (
+	// source ./lib.sh, . "$DIR/env.sh", load test_helper/common, run deploy --dry-run
+	shellCommandQuery = `(command name: (command_name) @name) @command`
+)
+
+// shellSourceCommands read another script into the current shell.
+// load and bats_load_library are the Bats variants.
+var shellSourceCommands = map[string]struct{}{
+	"source": {}, ".": {}, "load": {}, "bats_load_library": {},
+}
+
+// shellRunCommands execute their arguments as a command.
+var shellRunCommands = map[string]struct{}{
+	"run": {}, "bats_pipe": {}, "command": {}, "exec": {}, "sudo": {}, "env": {},
+}
+
+func (e *ShellExtractor) Extract(ctx context.Context, source []byte) *domain.DomainHints {
+	source, _ = batsfile.Preprocess(source)
+	tree, err := tspool.Parse(ctx, domain.LanguageShell, source)
+	if err != nil {
+		return nil
+	}
+	defer tree.Close()
+
+	root := tree.RootNode()
+
+	hints := &domain.DomainHints{
+		Imports: e.extractImports(root, source),
+		Calls:   e.extractCalls(root, source),
+	}
+
+	if len(hints.Imports) == 0 && len(hints.Calls) == 0 {
+		return nil
+	}
+
+	return hints
+}
+
+func (e *ShellExtractor) extractImports(root *sitter.Node, source []byte) []string {
+	results, err := tspool.QueryWithCache(root, source, domain.LanguageShell, shellCommandQuery)
+	if err != nil {
+		return nil
+	}
+
+	seen := make(map[string]struct{})
+	var imports []string
+
+	for _, r := range results {
+		command, ok := r.Captures["command"]
+		if !ok {
+			continue
+		}
+		words := shellWords(command, source)
+		if len(words) < 2 {
+			continue
+		}
+		if _, ok := shellSourceCommands[words[0]]; !ok {
+			continue
+		}
+
+		path := trimShellDirPrefix(words[1])
+		if path == "" || isBatsHelperLibrary(path) {
+			continue
+		}
+		if _, exists := seen[path]; exists {
+			continue
+		}
+		seen[path] = struct{}{}
+		imports = append(imports, path)
+	}
+
+	return imports
+}
+
+func (e *ShellExtractor) extractCalls(root *sitter.Node, source []byte) []string {
+	results, err := tspool.QueryWithCache(root, source, domain.LanguageShell, shellCommandQuery)
+	if err != nil {
+		return nil
+	}
+
+	seen := make(map[string]struct{})
+	calls := make([]string, 0, len(results))
+
+	for _, r := range results {
+		command, ok := r.Captures["command"]
+		if !ok {
+			continue
+		}
+
+		call := invokedCommand(shellWords(command, source))
+		if call == "" || isShellBuiltinCommand(call) {
+			continue
+		}
+		if _, exists := seen[call]; exists {
+			continue
+		}
+		seen[call] = struct{}{}
+		calls = append(calls, call)
+	}
+
+	return calls
+}
+
+// shellWords returns the command name followed by its unquoted arguments.
+func shellWords(command *sitter.Node, source []byte) []string {
+	var words []string
+	for i := 0; i < int(command.ChildCount()); i++ {
+		child := command.Child(i)
+		switch command.FieldNameForChild(i) {
+		case "name", "argument":
+			words = append(words, unquoteShellWord(child, source))
+		}
+	}
+	return words
+}
+
+func unquoteShellWord(node *sitter.Node, source []byte) string {
+	text := getNodeText(node, source)
+	switch node.Type() {
+	case "string":
+		return strings.TrimSuffix(strings.TrimPrefix(text, `"`), `"`)
+	case "raw_string":
+		return strings.TrimSuffix(strings.TrimPrefix(text, "'"), "'")
+	default:
+		return text
+	}
+}
+
+// invokedCommand returns the program a command line runs, looking through wrappers
+// such as Bats' run.
+func invokedCommand(words []string) string {
+	for len(words) > 0 {
+		name := strings.TrimSuffix(words[0], ";")
+		switch {
+		case isShellRunCommand(name):
+			words = skipShellFlags(words[1:])
+			continue
+		case strings.Contains(name, "="):
+			// Environment assignment prefix: FOO=1 cmd
+			words = words[1:]
+			continue
+		}
+		if strings.HasPrefix(name, "$") && !strings.Contains(name, "/") {
+			// The program is only known at runtime.
+			return ""
+		}
+		return trimShellDirPrefix(name)
+	}
+	return ""
+}
+
+func isShellRunCommand(name string) bool {
+	_, ok := shellRunCommands[name]
+	return ok
+}
+
+// skipShellFlags drops wrapper options such as run -1, run --separate-stderr or run !.
+func skipShellFlags(words []string) []string {
+	for len(words) > 0 && (strings.HasPrefix(words[0], "-") || words[0] == "!") {
+		if words[0] == "--" {
+			return words[1:]
+		}
+		words = words[1:]
+	}
+	return words
+}
+
+// trimShellDirPrefix removes a leading directory variable so that
+// "$BATS_TEST_DIRNAME/../lib/deploy.sh" is reported as "../lib/deploy.sh".
+func trimShellDirPrefix(path string) string {
+	if !strings.HasPrefix(path, "$") {
+		return path
+	}
+	if idx := strings.Index(path, "/"); idx > 0 {
+		return path[idx+1:]
+	}
+	return ""
+}
+
+// batsHelperLibraries are the standard Bats assertion libraries.
+var batsHelperLibraries = []string{"bats-support", "bats-assert", "bats-file", "bats-mock", "bats-detik"}
+
+func isBatsHelperLibrary(path string) bool {
+	for _, lib := range batsHelperLibraries {
+		if strings.Contains(path, lib) {
+			return true
+		}
+	}
+	return false
+}
+
+// shellBuiltinCommands contains shell builtins, Bats helpers and common text utilities
+// that say nothing about the domain under test.
+var shellBuiltinCommands = map[string]struct{}{
+	// Shell builtins and keywords
+	"[": {}, "[[": {}, "cd": {}, "echo": {}, "eval": {}, "exit": {}, "export": {},
+	"false": {}, "local": {}, "printf": {}, "pwd": {}, "read": {}, "return": {},
+	"set": {}, "shift": {}, "test": {}, "trap": {}, "true": {}, "unset": {},
+	"source": {}, ".": {}, "}": {}, "{": {},
+	// Bats
+	"bats_load_library": {}, "bats_require_minimum_version": {}, "fail": {}, "load": {}, "skip": {},
+	"assert": {}, "assert_dir_exists": {}, "assert_equal": {}, "assert_failure": {},
+	"assert_file_exists": {}, "assert_file_not_exists": {}, "assert_line": {}, "assert_not_equal": {},
+	"assert_output": {}, "assert_regex": {}, "assert_success": {},
+	"refute": {}, "refute_line": {}, "refute_output": {}, "refute_regex": {},
+	// Text and file utilities
+	"awk": {}, "cat": {}, "cp": {}, "cut": {}, "diff": {}, "grep": {}, "head": {},
+	"ls": {}, "mkdir": {}, "mktemp": {}, "mv": {}, "rm": {}, "sed": {}, "sleep": {},
+	"sort": {}, "tail": {}, "touch": {}, "tr": {}, "uniq": {}, "wc": {},
+}
+
+func isShellBuiltinCommand(name string) bool {
+	_, exists := shellBuiltinCommands[name]
+	return exists
+}
@@ -0,0 +1,91 @@
+package domain_hints
+
+import (
+	"context"
+	"testing"
+)
+
+func TestShellExtractor_Extract_Imports(t *testing.T) {
+	source := []byte(`#!/usr/bin/env bats
+
+load 'test_helper/bats-support/load'
+load test_helper/common
+source "$BATS_TEST_DIRNAME/../lib/deploy.sh"
+
+setup() {
+  . ./scripts/env.sh
+  bats_load_library bats-assert
+}
+`)
+
+	extractor := &ShellExtractor{}
+	hints := extractor.Extract(context.Background(), source)
+
+	if hints == nil {
+		t.Fatal("expected hints, got nil")
+	}
+
+	expected := []string{"test_helper/common", "../lib/deploy.sh", "./scripts/env.sh"}
+	if len(hints.Imports) != len(expected) {
+		t.Fatalf("expected %d imports, got %v", len(expected), hints.Imports)
+	}
+	for i, imp := range expected {
+		if hints.Imports[i] != imp {
+			t.Errorf("expected import %q at %d, got %q", imp, i, hints.Imports[i])
+		}
+	}
+}
+
+func TestShellExtractor_Extract_Commands(t *testing.T) {
+	source := []byte(`#!/usr/bin/env bats
+
+@test "deploys the release" {
+  run deploy --dry-run
+  assert_success
+  run -1 --separate-stderr kubectl get pods
+  KUBECONFIG=/tmp/kube helm upgrade app ./chart
+  echo "done" | grep done
+  $cmd --version
+}
+
+@test "reports status" { run ./bin/app status; }
+
+@test "writes the report" {
+  [ "$status" -eq 0 ]
+  { ./bin/report; } > out.txt
+  if [ -f out.txt ]; then
+    jq . out.txt
+  fi
+}
+`)
+
+	extractor := &ShellExtractor{}
+	hints := extractor.Extract(context.Background(), source)
+
+	if hints == nil {
+		t.Fatal("expected hints, got nil")
+	}
+
+	callSet := make(map[string]bool)
+	for _, call := range hints.Calls {
+		callSet[call] = true
+	}
+
+	for _, want := range []string{"deploy", "kubectl", "helm", "./bin/app", "./bin/report", "jq"} {
+		if !callSet[want] {
+			t.Errorf("expected call %q, got %v", want, hints.Calls)
+		}
+	}
+	for _, unwanted := range []string{"run", "assert_success", "echo", "grep", "@test", "}", "$cmd", `"$status"`, "then", "fi"} {
+		if callSet[unwanted] {
+			t.Errorf("expected call %q to be filtered, got %v", unwanted, hints.Calls)
+		}
+	}
+}
+
+func TestShellExtractor_Extract_Empty(t *testing.T) {
+	extractor := &ShellExtractor{}
+	if hints := extractor.Extract(context.Background(), []byte("")); hints != nil {
+		t.Errorf("expected nil hints for empty source, got %+v", hints)
+	}
+}
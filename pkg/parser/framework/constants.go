@@ -23,8 +23,22 @@ const (
 
 // Common framework names as constants to ensure consistency.
 const (
+	FrameworkBats         = "bats"
+	FrameworkBehave       = "behave"
+	FrameworkBoostTest    = "boost-test"
+	FrameworkBusted       = "busted"
 	FrameworkCargoTest    = "cargo-test"
+	FrameworkCatch2       = "catch2"
+	FrameworkCheck        = "check"
+	FrameworkCMocka       = "cmocka"
+	FrameworkCodeception  = "codeception"
+	FrameworkCucumber     = "cucumber"
+	FrameworkCucumberJS   = "cucumber-js"
+	FrameworkCucumberJVM  = "cucumber-jvm"
+	FrameworkCUnit        = "cunit"
 	FrameworkCypress      = "cypress"
+	FrameworkDoctest      = "doctest"
+	FrameworkExUnit       = "exunit"
 	FrameworkGoTesting    = "go-testing"
 	FrameworkGTest        = "gtest"
 	FrameworkJest         = "jest"
@@ -33,15 +47,25 @@ const (
 	FrameworkKotest       = "kotest"
 	FrameworkMinitest     = "minitest"
 	FrameworkMocha        = "mocha"
+	FrameworkMUnit        = "munit"
 	FrameworkMSTest       = "mstest"
 	FrameworkNUnit        = "nunit"
+	FrameworkPest         = "pest"
 	FrameworkPHPUnit      = "phpunit"
 	FrameworkPlaywright   = "playwright"
 	FrameworkPytest       = "pytest"
+	FrameworkQuick        = "quick"
+	FrameworkReqnroll     = "reqnroll"
+	FrameworkRobot        = "robot-framework"
 	FrameworkRSpec        = "rspec"
+	FrameworkScalaTest    = "scalatest"
+	FrameworkSpecs2       = "specs2"
+	FrameworkSpock        = "spock"
 	FrameworkSwiftTesting = "swift-testing"
+	FrameworkTerraform    = "terraform-test"
 	FrameworkTestNG       = "testng"
 	FrameworkUnittest     = "unittest"
+	FrameworkUnity        = "unity"
 	FrameworkVitest       = "vitest"
 	FrameworkXCTest       = "xctest"
 	FrameworkXUnit        = "xunit"
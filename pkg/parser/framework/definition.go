@@ -104,6 +104,8 @@ type ConfigParser interface {
 	// Parse reads and interprets a framework configuration file.
 	// Returns ConfigScope containing parsed settings like test patterns, globals mode, etc.
 	// Returns error if the config file cannot be parsed.
+	// Returns a nil scope and nil error when a shared file (e.g. pom.xml) does not configure
+	// this framework, so other frameworks matching the same file name can claim it.
 	Parse(ctx context.Context, configPath string, content []byte) (*ConfigScope, error)
 }
 
@@ -112,9 +114,18 @@ type Parser interface {
 	// Parse analyzes source code and extracts test suites and test cases.
 	// Returns a domain.TestFile containing all discovered tests.
 	// Returns error if the file cannot be parsed or doesn't contain valid tests.
+	// Returns a nil TestFile and nil error when the file turns out not to be a test file
+	// (e.g., a Robot Framework resource file sharing the .robot extension).
 	Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error)
 }
 
+// ScopedParser is implemented by parsers whose results depend on the config covering
+// the file (e.g., pytest collects docstring examples only under --doctest-modules).
+// The scanner calls ParseWithScope instead of Parse when such a config exists.
+type ScopedParser interface {
+	ParseWithScope(ctx context.Context, source []byte, filename string, scope *ConfigScope) (*domain.TestFile, error)
+}
+
 // NoMatch returns a MatchResult indicating no match was found.
 func NoMatch() MatchResult {
 	return MatchResult{Confidence: 0}
@@ -11,7 +11,8 @@ import (
 // For example: "jest.config.js", "vitest.config.ts"
 type ConfigMatcher struct {
 	// Patterns is a list of config file name patterns to match.
-	// These should be exact file names (e.g., "jest.config.js").
+	// These are exact file names (e.g., "jest.config.js") or, for files named after
+	// their project, file name globs (e.g., "*.csproj").
 	Patterns []string
 }
 
@@ -30,7 +31,7 @@ func (m *ConfigMatcher) Match(ctx context.Context, signal framework.Signal) fram
 	base := filepath.Base(filename)
 
 	for _, pattern := range m.Patterns {
-		if base == pattern {
+		if matched, err := filepath.Match(pattern, base); err == nil && matched {
 			return framework.DefiniteMatch("config: " + base)
 		}
 	}
@@ -45,6 +45,34 @@ func TestConfigMatcher_Match(t *testing.T) {
 	}
 }
 
+func TestConfigMatcher_MatchGlob(t *testing.T) {
+	m := matchers.NewConfigMatcher("*.csproj")
+
+	tests := []struct {
+		filename  string
+		wantMatch bool
+	}{
+		{"Shop.Tests.csproj", true},
+		{"/project/tests/Shop.Tests/Shop.Tests.csproj", true},
+		{"Shop.Tests.fsproj", false},
+		{"Shop.csproj.user", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			signal := framework.Signal{
+				Type:  framework.SignalConfigFile,
+				Value: tt.filename,
+			}
+
+			result := m.Match(context.Background(), signal)
+			if got := result.Confidence == 100; got != tt.wantMatch {
+				t.Errorf("Match(%s) confidence = %d, want match %v", tt.filename, result.Confidence, tt.wantMatch)
+			}
+		})
+	}
+}
+
 func TestConfigMatcher_WrongSignalType(t *testing.T) {
 	m := matchers.NewConfigMatcher("jest.config.js")
 
@@ -12,8 +12,8 @@ import (
 // For example: "import { test } from 'vitest'" matches Vitest.
 type ImportMatcher struct {
 	// Patterns is a list of import path patterns to match.
-	// Supports exact matches and prefix matching.
-	// Examples: ["vitest", "vitest/"], ["@playwright/test", "@playwright/test/"]
+	// Supports exact matches and prefix matching (patterns ending in "/", "::", "." or "\\").
+	// Examples: ["vitest", "vitest/"], ["@playwright/test", "@playwright/test/"], ["rstest", "rstest::"], ["munit", "munit."], ["Pest\\"]
 	Patterns []string
 }
 
@@ -42,8 +42,14 @@ func (m *ImportMatcher) matchesPattern(importPath, pattern string) bool {
 	if importPath == pattern {
 		return true
 	}
-	if strings.HasSuffix(pattern, "/") && strings.HasPrefix(importPath, pattern) {
+	if isPrefixPattern(pattern) && strings.HasPrefix(importPath, pattern) {
 		return true
 	}
 	return false
 }
+
+// isPrefixPattern reports whether pattern ends in a path, module, package or namespace separator.
+func isPrefixPattern(pattern string) bool {
+	return strings.HasSuffix(pattern, "/") || strings.HasSuffix(pattern, "::") || strings.HasSuffix(pattern, ".") ||
+		strings.HasSuffix(pattern, "\\")
+}
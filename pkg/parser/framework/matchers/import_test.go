@@ -45,7 +45,7 @@ func TestImportMatcher_ExactMatch(t *testing.T) {
 }
 
 func TestImportMatcher_PrefixMatch(t *testing.T) {
-	m := matchers.NewImportMatcher("vitest/", "@jest/")
+	m := matchers.NewImportMatcher("vitest/", "@jest/", "rstest::", "munit.", "Pest\\")
 
 	tests := []struct {
 		name       string
@@ -56,6 +56,12 @@ func TestImportMatcher_PrefixMatch(t *testing.T) {
 		{"prefix match jest", "@jest/globals", true},
 		{"no match - different package", "jest", false},
 		{"no match - not a prefix", "vitestify", false},
+		{"prefix match rust path", "rstest::rstest", true},
+		{"no match - rust crate with shared prefix", "rstest_reuse::template", false},
+		{"prefix match package", "munit.FunSuite", true},
+		{"no match - package with shared prefix", "munitx.FunSuite", false},
+		{"prefix match namespace", "Pest\\Laravel\\get", true},
+		{"no match - namespace with shared prefix", "PestPlugin\\Foo", false},
 	}
 
 	for _, tt := range tests {
@@ -1,7 +1,11 @@
 package framework
 
 import (
+	"path"
 	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/bmatcuk/doublestar/v4"
@@ -9,6 +13,30 @@ import (
 
 // ConfigScope defines the effective scope of a config file.
 // Handles root resolution, include/exclude patterns, and workspace projects.
+//
+// The scanner asks the configs of a file four questions, each answered by a group of
+// fields. A config Contains the files under its Roots (by default BaseDir and the
+// directories of its Projects) that match Include or IncludeRegexps, when set, and no
+// Exclude pattern.
+//
+//   - Is the file a test file? The deepest config whose ExclusivePatterns match it
+//     decides alone: the file is a test file only if that config Collects it. Without
+//     a deciding config, the file is one if the language's naming says so or if any
+//     config Collects it. Collects matches the files Contains matches that also match
+//     CollectPatterns, or all of them for IncludeCollects configs with Include or
+//     IncludeRegexps.
+//   - Which config applies? The deepest config of the framework containing the file,
+//     a Passive one only when no other does. Among the ranked configs of a framework in
+//     one directory, only the highest Precedence is kept, and a config that Inherits
+//     merges in the Settings of the enclosing configs of its framework.
+//   - Which project? The deepest matching Projects entry of the configs of the
+//     framework, or of any SharedProjects config, unless a ProjectBoundary config of
+//     the framework lies between the project and the file. The scanner expands
+//     ProjectPatterns into Projects first.
+//   - How do its tests run? The deciding config marks the files matching
+//     IntegrationPatterns as integration tests. The IncludeTags and ExcludeTags of the
+//     deciding config and of the Passive configs containing the file, with those of
+//     their TagFilters matching it, filter the tests.
 type ConfigScope struct {
 	ConfigPath      string
 	BaseDir         string
@@ -21,20 +49,100 @@ type ConfigScope struct {
 	ExcludePatterns []string
 	RootDir         string
 
+	// IncludeRegexps are regular expressions matched against the slash-separated
+	// absolute path (e.g., Jest's testRegex). A file matching one of them is included
+	// even when it matches no Include pattern.
+	IncludeRegexps []*regexp.Regexp
+
 	// Roots contains additional root directories (e.g., Jest's roots config).
 	// When set, Contains() checks if a file is within any of these roots.
 	Roots []string
 
 	// GlobalsMode: when true, test files don't need explicit imports (e.g., Jest default).
 	GlobalsMode bool
+
+	// CollectPatterns are globs, relative to BaseDir, of files the runner collects beyond
+	// the language's test file naming (e.g., pytest --doctest-modules collects every module).
+	CollectPatterns []string
+
+	// ExclusivePatterns are globs, relative to BaseDir, of files whose collection the
+	// config decides alone: such a file is a test file only if Collects matches it,
+	// whatever its name (e.g., PHPUnit testsuites decide for the *.php files in their
+	// directories).
+	ExclusivePatterns []string
+
+	// IncludeCollects marks configs whose Include patterns and IncludeRegexps name the
+	// test files themselves, whatever the language's naming says (e.g., Vitest's
+	// test.include, Jest's testMatch and testRegex): Collects matches the files they
+	// include.
+	IncludeCollects bool
+
+	// Passive scopes mark a framework's presence without configuring it (e.g., pytest's
+	// conftest.py). FindNearestConfig prefers any other config that contains the file.
+	Passive bool
+
+	// ProjectPatterns are globs, relative to BaseDir, of project directories or config
+	// files that the scanner expands into Projects (e.g., Vitest workspace entries such
+	// as "packages/*"). Patterns starting with "!" drop matches.
+	ProjectPatterns []string
+
+	// Inherits marks configs that extend, rather than replace, the enclosing configs of
+	// the same framework (e.g., RSpec's .rspec and spec_helper.rb configure one run).
+	// ResolveConfig merges their Settings.
+	Inherits bool
+
+	// IntegrationPatterns are globs, relative to BaseDir, of files the runner runs as
+	// integration tests (e.g., Maven Failsafe's **/*IT.java). The scanner marks the
+	// tests of the files the config decides with the integration kind.
+	IntegrationPatterns []string
+
+	// IncludeTags and ExcludeTags filter the tests of the files the config decides, or
+	// contains when Passive, by their Tags, for runners that filter every framework they
+	// run (e.g., Gradle's useJUnitPlatform includeTags, a .runsettings TestCaseFilter).
+	// With IncludeTags, only tests tagged with one of them run.
+	IncludeTags []string
+	ExcludeTags []string
+
+	// TagFilters are tag filters that apply only to the decided files matching their
+	// Patterns, on top of IncludeTags and ExcludeTags (e.g., the filters of Gradle's test
+	// task skip no test of the other source sets).
+	TagFilters []TagFilter
+
+	// SharedProjects marks configs whose Projects group the tests of every framework
+	// run through them, not only of Framework (e.g., phpunit.xml testsuites also run
+	// Pest tests). FindProject considers them whatever the framework of the file.
+	SharedProjects bool
+
+	// ProjectBoundary marks configs that set their directory apart from the projects of
+	// the enclosing configs (e.g., a nested go.mod is a module of its own, even inside a
+	// go.work module directory). FindProject returns no such project for its files.
+	ProjectBoundary bool
+
+	// Precedence ranks the config files a runner looks for in one directory: only the
+	// highest-ranked config of the framework there applies (e.g., pytest reads pytest.ini
+	// over pyproject.toml, tox.ini and setup.cfg). Zero leaves the config unranked.
+	Precedence int
+
+	// SourceRoot is the root of the scanned source, set by the scanner. Parsers receive
+	// file names relative to it, which RelPath resolves against BaseDir.
+	SourceRoot string
 }
 
-type ProjectScope struct {
-	Name     string
-	BaseDir  string
+// TagFilter filters by their Tags the tests of the files matching Patterns, globs
+// relative to the config's BaseDir.
+type TagFilter struct {
+	Patterns []string
 	Include  []string
 	Exclude  []string
-	Settings map[string]interface{}
+}
+
+type ProjectScope struct {
+	Name           string
+	BaseDir        string
+	Include        []string
+	IncludeRegexps []*regexp.Regexp
+	Exclude        []string
+	Settings       map[string]interface{}
 }
 
 // AggregatedProjectScope aggregates multiple ConfigScope instances for hierarchical config resolution.
@@ -50,11 +158,36 @@ func NewProjectScope() *AggregatedProjectScope {
 	}
 }
 
+// AddConfig adds the config at path. A ranked config replaces the lower-ranked configs
+// of its framework in the same directory, and is dropped if one ranks higher.
 func (ps *AggregatedProjectScope) AddConfig(path string, scope *ConfigScope) {
+	if rival := ps.rankedRival(path, scope); rival != "" {
+		if ps.Configs[rival].Precedence >= scope.Precedence {
+			return
+		}
+		delete(ps.Configs, rival)
+		ps.ConfigFiles = slices.DeleteFunc(ps.ConfigFiles, func(file string) bool { return file == rival })
+	}
+
 	ps.Configs[path] = scope
 	ps.ConfigFiles = append(ps.ConfigFiles, path)
 }
 
+// rankedRival returns the path of the ranked config of the same framework in the
+// directory of a ranked config at path, or "".
+func (ps *AggregatedProjectScope) rankedRival(path string, scope *ConfigScope) string {
+	if scope == nil || scope.Precedence == 0 {
+		return ""
+	}
+	for _, other := range ps.ConfigFiles {
+		existing := ps.Configs[other]
+		if existing != nil && existing.Precedence > 0 && existing.Framework == scope.Framework && filepath.Dir(other) == filepath.Dir(path) {
+			return other
+		}
+	}
+	return ""
+}
+
 func (ps *AggregatedProjectScope) FindConfig(path string) *ConfigScope {
 	return ps.Configs[path]
 }
@@ -68,6 +201,262 @@ func (ps *AggregatedProjectScope) HasConfigFile(filename string) bool {
 	return false
 }
 
+// FindNearestConfig returns the deepest config of the given framework containing filePath.
+func (ps *AggregatedProjectScope) FindNearestConfig(filePath, frameworkName string) *ConfigScope {
+	if ps == nil {
+		return nil
+	}
+
+	var best *ConfigScope
+	for _, path := range ps.ConfigFiles {
+		scope := ps.Configs[path]
+		if scope == nil || scope.Framework != frameworkName || !scope.Contains(filePath) {
+			continue
+		}
+		switch {
+		case best == nil:
+			best = scope
+		case scope.Passive != best.Passive:
+			if best.Passive {
+				best = scope
+			}
+		case scope.Depth() > best.Depth():
+			best = scope
+		}
+	}
+	return best
+}
+
+// ResolveConfig returns the config that applies to filePath: the nearest config of the
+// framework, with the Settings of the enclosing configs merged in when it Inherits.
+// Nearer settings win, except []string settings, which are concatenated.
+func (ps *AggregatedProjectScope) ResolveConfig(filePath, frameworkName string) *ConfigScope {
+	nearest := ps.FindNearestConfig(filePath, frameworkName)
+	if nearest == nil || !nearest.Inherits {
+		return nearest
+	}
+
+	var chain []*ConfigScope
+	for _, path := range ps.ConfigFiles {
+		scope := ps.Configs[path]
+		if scope != nil && scope != nearest && scope.Framework == frameworkName && scope.Contains(filePath) {
+			chain = append(chain, scope)
+		}
+	}
+	if len(chain) == 0 {
+		return nearest
+	}
+	sort.SliceStable(chain, func(i, j int) bool { return chain[i].Depth() < chain[j].Depth() })
+	chain = append(chain, nearest)
+
+	resolved := *nearest
+	resolved.Settings = make(map[string]interface{})
+	for _, scope := range chain {
+		for key, value := range scope.Settings {
+			values, ok := value.([]string)
+			existing, merge := resolved.Settings[key].([]string)
+			if ok && merge {
+				resolved.Settings[key] = append(append([]string{}, existing...), values...)
+				continue
+			}
+			resolved.Settings[key] = value
+		}
+	}
+	return &resolved
+}
+
+// FindProject returns the most specific named project containing filePath among the
+// configs of the given framework and the configs with SharedProjects. Projects of
+// deeper configs win ties, so a nested config naming itself overrides the workspace
+// entry that points at it. A project does not extend past a ProjectBoundary config
+// below its directory.
+func (ps *AggregatedProjectScope) FindProject(filePath, frameworkName string) *ProjectScope {
+	if ps == nil {
+		return nil
+	}
+
+	var (
+		best      *ProjectScope
+		bestScope *ConfigScope
+		bestDepth = -1
+		cleanPath = filepath.Clean(filePath)
+	)
+	for _, path := range ps.ConfigFiles {
+		scope := ps.Configs[path]
+		if scope == nil || scope.Framework != frameworkName && !scope.SharedProjects {
+			continue
+		}
+		project := scope.FindMatchingProject(cleanPath)
+		if project == nil || project.Name == "" {
+			continue
+		}
+		depth := strings.Count(filepath.ToSlash(filepath.Clean(project.BaseDir)), "/")
+		if depth > bestDepth || depth == bestDepth && scope.Depth() > bestScope.Depth() {
+			best, bestScope, bestDepth = project, scope, depth
+		}
+	}
+	if best != nil && ps.crossesBoundary(cleanPath, frameworkName, best.BaseDir) {
+		return nil
+	}
+	return best
+}
+
+// crossesBoundary reports whether a ProjectBoundary config of the framework below
+// baseDir contains filePath.
+func (ps *AggregatedProjectScope) crossesBoundary(filePath, frameworkName, baseDir string) bool {
+	for _, path := range ps.ConfigFiles {
+		scope := ps.Configs[path]
+		if scope == nil || !scope.ProjectBoundary || scope.Framework != frameworkName || !scope.Contains(filePath) {
+			continue
+		}
+		rel, err := filepath.Rel(filepath.Clean(baseDir), filepath.Clean(scope.BaseDir))
+		if err == nil && rel != "." && !strings.HasPrefix(filepath.ToSlash(rel), "..") {
+			return true
+		}
+	}
+	return false
+}
+
+// FindCollectingConfig returns a config whose CollectPatterns match filePath.
+func (ps *AggregatedProjectScope) FindCollectingConfig(filePath string) *ConfigScope {
+	if ps == nil {
+		return nil
+	}
+
+	for _, path := range ps.ConfigFiles {
+		if scope := ps.Configs[path]; scope.Collects(filePath) {
+			return scope
+		}
+	}
+	return nil
+}
+
+// FindDecidingConfig returns the deepest config whose ExclusivePatterns match filePath.
+func (ps *AggregatedProjectScope) FindDecidingConfig(filePath string) *ConfigScope {
+	if ps == nil {
+		return nil
+	}
+
+	var best *ConfigScope
+	for _, path := range ps.ConfigFiles {
+		scope := ps.Configs[path]
+		if scope.Decides(filePath) && (best == nil || scope.Depth() > best.Depth()) {
+			best = scope
+		}
+	}
+	return best
+}
+
+// FindTagFilters returns the tag filters applying to filePath: those of the config
+// deciding it and of the passive configs containing it.
+func (ps *AggregatedProjectScope) FindTagFilters(filePath string) (include, exclude []string) {
+	if ps == nil {
+		return nil, nil
+	}
+
+	deciding := ps.FindDecidingConfig(filePath)
+	for _, path := range ps.ConfigFiles {
+		scope := ps.Configs[path]
+		if scope == nil {
+			continue
+		}
+		if scope == deciding || scope.Passive && scope.Contains(filePath) {
+			include = append(include, scope.IncludeTags...)
+			exclude = append(exclude, scope.ExcludeTags...)
+			for _, filter := range scope.TagFilters {
+				if scope.matchesAny(filter.Patterns, filePath) {
+					include = append(include, filter.Include...)
+					exclude = append(exclude, filter.Exclude...)
+				}
+			}
+		}
+	}
+	return include, exclude
+}
+
+// Decides checks if filePath is under BaseDir and matches ExclusivePatterns.
+// Exclude does not apply: an excluded file is still decided, as not collected.
+func (s *ConfigScope) Decides(filePath string) bool {
+	if s == nil || len(s.ExclusivePatterns) == 0 {
+		return false
+	}
+
+	relPath, err := filepath.Rel(s.BaseDir, filepath.Clean(filePath))
+	if err != nil {
+		return false
+	}
+	relPath = filepath.ToSlash(relPath)
+	if strings.HasPrefix(relPath, "..") {
+		return false
+	}
+
+	for _, pattern := range s.ExclusivePatterns {
+		if match, err := doublestar.Match(pattern, relPath); err == nil && match {
+			return true
+		}
+	}
+	return false
+}
+
+// Integrates checks if filePath is under BaseDir and matches IntegrationPatterns.
+func (s *ConfigScope) Integrates(filePath string) bool {
+	if s == nil {
+		return false
+	}
+	return s.matchesAny(s.IntegrationPatterns, filePath)
+}
+
+// matchesAny checks if filePath is under BaseDir and matches one of patterns, globs
+// relative to BaseDir.
+func (s *ConfigScope) matchesAny(patterns []string, filePath string) bool {
+	if len(patterns) == 0 {
+		return false
+	}
+
+	relPath, err := filepath.Rel(s.BaseDir, filepath.Clean(filePath))
+	if err != nil {
+		return false
+	}
+	relPath = filepath.ToSlash(relPath)
+	if strings.HasPrefix(relPath, "..") {
+		return false
+	}
+
+	for _, pattern := range patterns {
+		if match, err := doublestar.Match(pattern, relPath); err == nil && match {
+			return true
+		}
+	}
+	return false
+}
+
+// Collects checks if filePath is within this config's scope and matches CollectPatterns,
+// or any include pattern when IncludeCollects.
+func (s *ConfigScope) Collects(filePath string) bool {
+	if s == nil {
+		return false
+	}
+	if s.IncludeCollects && (len(s.Include) > 0 || len(s.IncludeRegexps) > 0) {
+		return s.Contains(filePath)
+	}
+	if len(s.CollectPatterns) == 0 || !s.Contains(filePath) {
+		return false
+	}
+
+	relPath, err := filepath.Rel(s.BaseDir, filepath.Clean(filePath))
+	if err != nil {
+		return false
+	}
+	relPath = filepath.ToSlash(relPath)
+
+	for _, pattern := range s.CollectPatterns {
+		if match, err := doublestar.Match(pattern, relPath); err == nil && match {
+			return true
+		}
+	}
+	return false
+}
+
 // Contains checks if filePath is within this config's scope.
 func (s *ConfigScope) Contains(filePath string) bool {
 	if s == nil {
@@ -93,17 +482,8 @@ func (s *ConfigScope) Contains(filePath string) bool {
 			continue
 		}
 
-		if len(s.Include) > 0 {
-			matched := false
-			for _, pattern := range s.Include {
-				if match, err := doublestar.Match(pattern, relPath); err == nil && match {
-					matched = true
-					break
-				}
-			}
-			if !matched {
-				continue
-			}
+		if !matchesInclude(s.Include, s.IncludeRegexps, relPath, filePath) {
+			continue
 		}
 
 		excluded := false
@@ -137,6 +517,52 @@ func (s *ConfigScope) effectiveRoots() []string {
 	return roots
 }
 
+// matchesInclude reports whether a file passes the include filters: relPath against
+// the globs or the slash-separated absolute path against the regexps. A file passes
+// when there are no filters.
+func matchesInclude(patterns []string, regexps []*regexp.Regexp, relPath, absPath string) bool {
+	if len(patterns) == 0 && len(regexps) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if match, err := doublestar.Match(pattern, relPath); err == nil && match {
+			return true
+		}
+	}
+	for _, re := range regexps {
+		if re.MatchString(absPath) {
+			return true
+		}
+	}
+	return false
+}
+
+// RelPath returns filename relative to BaseDir, with forward slashes. Parsers receive
+// file names relative to the scanned root: with a SourceRoot they are resolved against
+// it, and otherwise the leading directories of filename that BaseDir ends with are
+// dropped; a filename BaseDir does not end with is relative to the root itself.
+func (s *ConfigScope) RelPath(filename string) string {
+	if s.SourceRoot != "" && !filepath.IsAbs(filename) {
+		filename = filepath.Join(s.SourceRoot, filename)
+	}
+	filename = filepath.ToSlash(filepath.Clean(filename))
+	baseDir := filepath.ToSlash(filepath.Clean(s.BaseDir))
+	if path.IsAbs(filename) {
+		if rel, ok := strings.CutPrefix(filename, strings.TrimSuffix(baseDir, "/")+"/"); ok {
+			return rel
+		}
+		return filename
+	}
+
+	segments := strings.Split(filename, "/")
+	for i := len(segments) - 1; i > 0; i-- {
+		if strings.HasSuffix(baseDir, "/"+strings.Join(segments[:i], "/")) {
+			return strings.Join(segments[i:], "/")
+		}
+	}
+	return filename
+}
+
 // Depth returns the directory depth of BaseDir (used for selecting nearest config).
 func (s *ConfigScope) Depth() int {
 	if s == nil || s.BaseDir == "" {
@@ -176,17 +602,8 @@ func (s *ConfigScope) FindMatchingProject(filePath string) *ProjectScope {
 			continue
 		}
 
-		if len(project.Include) > 0 {
-			matched := false
-			for _, pattern := range project.Include {
-				if match, err := doublestar.Match(pattern, relPath); err == nil && match {
-					matched = true
-					break
-				}
-			}
-			if !matched {
-				continue
-			}
+		if !matchesInclude(project.Include, project.IncludeRegexps, relPath, filePath) {
+			continue
 		}
 
 		excluded := false
@@ -211,11 +628,14 @@ func (s *ConfigScope) FindMatchingProject(filePath string) *ProjectScope {
 }
 
 // NewConfigScope creates a ConfigScope with root resolved relative to config directory.
+// An absolute root (e.g. from path.resolve(__dirname, ...)) is used as is.
 func NewConfigScope(configPath string, root string) *ConfigScope {
 	configDir := filepath.Dir(configPath)
 
 	var baseDir string
-	if root != "" {
+	if filepath.IsAbs(root) {
+		baseDir = filepath.Clean(root)
+	} else if root != "" {
 		baseDir = filepath.Clean(filepath.Join(configDir, root))
 	} else {
 		baseDir = configDir
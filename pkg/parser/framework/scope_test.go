@@ -1,6 +1,8 @@
 package framework
 
 import (
+	"reflect"
+	"regexp"
 	"testing"
 )
 
@@ -236,6 +238,36 @@ func TestConfigScope_Contains(t *testing.T) {
 	}
 }
 
+func TestConfigScope_RelPath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		baseDir    string
+		sourceRoot string
+		filename   string
+		want       string
+	}{
+		{"should drop directories of nested config", "/repo/crates/core", "", "crates/core/tests/api.rs", "tests/api.rs"},
+		{"should keep path of root config", "/repo", "", "tests/api.rs", "tests/api.rs"},
+		{"should strip base dir of absolute path", "/repo/crates/core", "", "/repo/crates/core/src/lib.rs", "src/lib.rs"},
+		{"should prefer longest matching directories", "/repo/core/tests", "", "core/tests/tests/api.rs", "tests/api.rs"},
+		{"should resolve against source root", "/orders", "/orders", "orders/orders_test.go", "orders/orders_test.go"},
+		{"should resolve nested config against source root", "/repo/shop", "/repo", "shop/orders/orders_test.go", "orders/orders_test.go"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			scope := &ConfigScope{BaseDir: tt.baseDir, SourceRoot: tt.sourceRoot}
+			if got := scope.RelPath(tt.filename); got != tt.want {
+				t.Errorf("RelPath(%q) = %q, want %q", tt.filename, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestConfigScope_Depth(t *testing.T) {
 	t.Parallel()
 
@@ -298,6 +330,357 @@ func TestConfigScope_Depth(t *testing.T) {
 	}
 }
 
+func TestConfigScope_Collects(t *testing.T) {
+	t.Parallel()
+
+	scope := &ConfigScope{
+		BaseDir:         "/project",
+		CollectPatterns: []string{"**/*.py", "**/*.rst"},
+		Exclude:         []string{"build/**"},
+	}
+	included := &ConfigScope{
+		BaseDir:         "/project",
+		Include:         []string{"**/*.e2e.ts"},
+		IncludeRegexps:  []*regexp.Regexp{regexp.MustCompile(`probes/.*\.probe\.js$`)},
+		IncludeCollects: true,
+	}
+
+	tests := []struct {
+		name     string
+		scope    *ConfigScope
+		filePath string
+		want     bool
+	}{
+		{"should collect module matching pattern", scope, "/project/src/money.py", true},
+		{"should collect text file matching pattern", scope, "/project/docs/guide.rst", true},
+		{"should not collect unmatched file", scope, "/project/README.md", false},
+		{"should not collect file outside base dir", scope, "/other/money.py", false},
+		{"should not collect excluded file", scope, "/project/build/money.py", false},
+		{"should not collect without patterns", &ConfigScope{BaseDir: "/project"}, "/project/money.py", false},
+		{"should not collect for nil scope", nil, "/project/money.py", false},
+		{"should collect included file", included, "/project/app/login.e2e.ts", true},
+		{"should collect file matching include regexp", included, "/project/probes/health.probe.js", true},
+		{"should not collect file outside includes", included, "/project/app/login.ts", false},
+		{"should not collect without includes", &ConfigScope{BaseDir: "/project", IncludeCollects: true}, "/project/money.js", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.scope.Collects(tt.filePath); got != tt.want {
+				t.Errorf("ConfigScope.Collects(%q) = %v, want %v", tt.filePath, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAggregatedProjectScope_FindDecidingConfig(t *testing.T) {
+	t.Parallel()
+
+	ps := NewProjectScope()
+	ps.AddConfig("/project/phpunit.xml", &ConfigScope{
+		BaseDir:           "/project",
+		ExclusivePatterns: []string{"**/*.php"},
+		CollectPatterns:   []string{"tests/**/*Spec.php"},
+		Exclude:           []string{"tests/Legacy/**"},
+	})
+	ps.AddConfig("/project/packages/api/phpunit.xml", &ConfigScope{
+		BaseDir:           "/project/packages/api",
+		ExclusivePatterns: []string{"**/*.php"},
+	})
+	ps.AddConfig("/project/jest.config.js", &ConfigScope{BaseDir: "/project"})
+
+	tests := []struct {
+		name     string
+		filePath string
+		want     string
+		collects bool
+	}{
+		{"should decide and collect matching file", "/project/tests/Unit/MoneySpec.php", "/project", true},
+		{"should decide but not collect conventional name", "/project/tests/Unit/MoneyTest.php", "/project", false},
+		{"should decide but not collect excluded file", "/project/tests/Legacy/OldSpec.php", "/project", false},
+		{"should prefer deepest deciding config", "/project/packages/api/tests/ApiTest.php", "/project/packages/api", false},
+		{"should not decide unmatched extension", "/project/src/app.test.js", "", false},
+		{"should not decide outside base dir", "/other/tests/MoneyTest.php", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ps.FindDecidingConfig(tt.filePath)
+			var baseDir string
+			if got != nil {
+				baseDir = got.BaseDir
+			}
+			if baseDir != tt.want {
+				t.Fatalf("FindDecidingConfig(%q) BaseDir = %q, want %q", tt.filePath, baseDir, tt.want)
+			}
+			if got != nil && got.Collects(tt.filePath) != tt.collects {
+				t.Errorf("Collects(%q) = %v, want %v", tt.filePath, !tt.collects, tt.collects)
+			}
+		})
+	}
+}
+
+func TestConfigScope_Integrates(t *testing.T) {
+	t.Parallel()
+
+	scope := &ConfigScope{
+		BaseDir:             "/project",
+		IntegrationPatterns: []string{"src/test/java/**/*IT.{java,kt}"},
+		Exclude:             []string{"src/test/java/**/Slow*"},
+	}
+
+	tests := []struct {
+		filePath string
+		want     bool
+	}{
+		{"/project/src/test/java/com/example/OrderIT.java", true},
+		{"/project/src/test/java/com/example/SlowOrderIT.kt", true},
+		{"/project/src/test/java/com/example/OrderTest.java", false},
+		{"/other/src/test/java/com/example/OrderIT.java", false},
+	}
+
+	for _, tt := range tests {
+		if got := scope.Integrates(tt.filePath); got != tt.want {
+			t.Errorf("Integrates(%q) = %v, want %v", tt.filePath, got, tt.want)
+		}
+	}
+}
+
+func TestAggregatedProjectScope_FindNearestConfig(t *testing.T) {
+	t.Parallel()
+
+	ps := NewProjectScope()
+	ps.AddConfig("/project/pytest.ini", &ConfigScope{BaseDir: "/project", Framework: "pytest"})
+	ps.AddConfig("/project/tests/conftest.py", &ConfigScope{BaseDir: "/project/tests", Framework: "pytest", Passive: true})
+	ps.AddConfig("/project/tests/unit/conftest.py", &ConfigScope{BaseDir: "/project/tests/unit", Framework: "pytest", Passive: true})
+	ps.AddConfig("/project/apps/web/pytest.ini", &ConfigScope{BaseDir: "/project/apps/web", Framework: "pytest"})
+	ps.AddConfig("/other/tests/conftest.py", &ConfigScope{BaseDir: "/other/tests", Framework: "pytest", Passive: true})
+	ps.AddConfig("/project/jest.config.js", &ConfigScope{BaseDir: "/project", Framework: "jest"})
+
+	tests := []struct {
+		name      string
+		filePath  string
+		framework string
+		want      string
+	}{
+		{"should prefer deepest config", "/project/apps/web/test_app.py", "pytest", "/project/apps/web"},
+		{"should prefer config over deeper passive scope", "/project/tests/unit/test_money.py", "pytest", "/project"},
+		{"should prefer deepest passive scope without config", "/other/tests/test_money.py", "pytest", "/other/tests"},
+		{"should filter by framework", "/project/src/app.test.js", "jest", "/project"},
+		{"should return nil outside every scope", "/elsewhere/test_money.py", "pytest", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ps.FindNearestConfig(tt.filePath, tt.framework)
+			var baseDir string
+			if got != nil {
+				baseDir = got.BaseDir
+			}
+			if baseDir != tt.want {
+				t.Errorf("FindNearestConfig(%q) BaseDir = %q, want %q", tt.filePath, baseDir, tt.want)
+			}
+		})
+	}
+}
+
+func TestAggregatedProjectScope_AddConfigPrecedence(t *testing.T) {
+	t.Parallel()
+
+	ps := NewProjectScope()
+	ps.AddConfig("/project/pyproject.toml", &ConfigScope{BaseDir: "/project", Framework: "pytest", Precedence: 3})
+	ps.AddConfig("/project/pytest.ini", &ConfigScope{BaseDir: "/project", Framework: "pytest", Precedence: 4})
+	ps.AddConfig("/project/setup.cfg", &ConfigScope{BaseDir: "/project", Framework: "pytest", Precedence: 1})
+	ps.AddConfig("/project/conftest.py", &ConfigScope{BaseDir: "/project", Framework: "pytest", Passive: true})
+	ps.AddConfig("/project/apps/web/tox.ini", &ConfigScope{BaseDir: "/project/apps/web", Framework: "pytest", Precedence: 2})
+
+	want := []string{"/project/pytest.ini", "/project/conftest.py", "/project/apps/web/tox.ini"}
+	if !reflect.DeepEqual(ps.ConfigFiles, want) {
+		t.Errorf("ConfigFiles = %v, want %v", ps.ConfigFiles, want)
+	}
+	if _, ok := ps.Configs["/project/pyproject.toml"]; ok {
+		t.Error("expected the lower-ranked pyproject.toml to be dropped")
+	}
+}
+
+func TestAggregatedProjectScope_FindTagFilters(t *testing.T) {
+	t.Parallel()
+
+	ps := NewProjectScope()
+	ps.AddConfig("/repo/ci.runsettings", &ConfigScope{
+		BaseDir:     "/repo",
+		Include:     []string{"**/*.cs"},
+		Passive:     true,
+		ExcludeTags: []string{"Slow"},
+	})
+	ps.AddConfig("/repo/shop/build.gradle", &ConfigScope{
+		BaseDir:           "/repo/shop",
+		ExclusivePatterns: []string{"src/test/**/*.java", "src/it/**/*.java"},
+		IncludeTags:       []string{"fast"},
+		TagFilters:        []TagFilter{{Patterns: []string{"src/test/**/*.java"}, Exclude: []string{"db"}}},
+	})
+	ps.AddConfig("/repo/cart/build.gradle", &ConfigScope{
+		BaseDir:           "/repo/cart",
+		ExclusivePatterns: []string{"src/test/**/*.java"},
+		ExcludeTags:       []string{"flaky"},
+	})
+
+	tests := []struct {
+		name        string
+		filePath    string
+		wantInclude []string
+		wantExclude []string
+	}{
+		{"should apply passive config containing file", "/repo/tests/OrderTests.cs", nil, []string{"Slow"}},
+		{"should apply deciding config", "/repo/shop/src/test/OrderTest.java", []string{"fast"}, []string{"db"}},
+		{"should apply tag filters only to matching files", "/repo/shop/src/it/OrderIT.java", []string{"fast"}, nil},
+		{"should not apply config not deciding file", "/repo/cart/src/main/Cart.java", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			include, exclude := ps.FindTagFilters(tt.filePath)
+			if !reflect.DeepEqual(include, tt.wantInclude) {
+				t.Errorf("FindTagFilters(%q) include = %v, want %v", tt.filePath, include, tt.wantInclude)
+			}
+			if !reflect.DeepEqual(exclude, tt.wantExclude) {
+				t.Errorf("FindTagFilters(%q) exclude = %v, want %v", tt.filePath, exclude, tt.wantExclude)
+			}
+		})
+	}
+}
+
+func TestAggregatedProjectScope_ResolveConfig(t *testing.T) {
+	t.Parallel()
+
+	ps := NewProjectScope()
+	ps.AddConfig("/project/.rspec", &ConfigScope{
+		BaseDir:   "/project",
+		Framework: "rspec",
+		Inherits:  true,
+		Settings:  map[string]interface{}{"excludeTags": []string{"slow"}, "defaultPath": "spec"},
+	})
+	ps.AddConfig("/project/spec/spec_helper.rb", &ConfigScope{
+		BaseDir:   "/project/spec",
+		Framework: "rspec",
+		Inherits:  true,
+		Settings:  map[string]interface{}{"excludeTags": []string{"broken"}, "defaultPath": "checks"},
+	})
+	ps.AddConfig("/project/jest.config.js", &ConfigScope{
+		BaseDir:   "/project",
+		Framework: "jest",
+		Settings:  map[string]interface{}{"testMatch": []string{"**/*.test.js"}},
+	})
+	ps.AddConfig("/project/web/jest.config.js", &ConfigScope{
+		BaseDir:   "/project/web",
+		Framework: "jest",
+		Settings:  map[string]interface{}{},
+	})
+
+	t.Run("should merge enclosing settings into an inheriting config", func(t *testing.T) {
+		t.Parallel()
+
+		got := ps.ResolveConfig("/project/spec/models/user_spec.rb", "rspec")
+		if got == nil || got.BaseDir != "/project/spec" {
+			t.Fatalf("ResolveConfig() = %+v, want the spec_helper.rb scope", got)
+		}
+		if want := []string{"slow", "broken"}; !reflect.DeepEqual(got.Settings["excludeTags"], want) {
+			t.Errorf("excludeTags = %v, want %v", got.Settings["excludeTags"], want)
+		}
+		if got.Settings["defaultPath"] != "checks" {
+			t.Errorf("defaultPath = %v, want the nearest value", got.Settings["defaultPath"])
+		}
+		if tags := ps.Configs["/project/spec/spec_helper.rb"].Settings["excludeTags"]; !reflect.DeepEqual(tags, []string{"broken"}) {
+			t.Errorf("ResolveConfig() modified the nearest config: %v", tags)
+		}
+	})
+
+	t.Run("should return the nearest config when it does not inherit", func(t *testing.T) {
+		t.Parallel()
+
+		got := ps.ResolveConfig("/project/web/app.test.js", "jest")
+		if got != ps.Configs["/project/web/jest.config.js"] {
+			t.Errorf("ResolveConfig() = %+v, want the nested jest config", got)
+		}
+	})
+}
+
+func TestAggregatedProjectScope_FindProject(t *testing.T) {
+	t.Parallel()
+
+	ps := NewProjectScope()
+	ps.AddConfig("/project/vitest.workspace.ts", &ConfigScope{
+		BaseDir:   "/project",
+		Framework: "vitest",
+		Projects: []ProjectScope{
+			{Name: "api", BaseDir: "/project/packages/api"},
+			{Name: "web", BaseDir: "/project/packages/web"},
+			{Name: "e2e", BaseDir: "/project", Include: []string{"e2e/**"}},
+		},
+	})
+	ps.AddConfig("/project/packages/web/vitest.config.ts", &ConfigScope{
+		BaseDir:   "/project/packages/web",
+		Framework: "vitest",
+		Projects:  []ProjectScope{{Name: "web-ui", BaseDir: "/project/packages/web"}},
+	})
+	ps.AddConfig("/project/phpunit.xml", &ConfigScope{
+		BaseDir:   "/project",
+		Framework: "phpunit",
+		Projects:  []ProjectScope{{Name: "Unit", BaseDir: "/project"}},
+	})
+	ps.AddConfig("/project/backend/phpunit.xml", &ConfigScope{
+		BaseDir:        "/project/backend",
+		Framework:      "phpunit",
+		SharedProjects: true,
+		Projects:       []ProjectScope{{Name: "Feature", BaseDir: "/project/backend", Include: []string{"tests/Feature/**"}}},
+	})
+	ps.AddConfig("/project/go.work", &ConfigScope{
+		BaseDir:   "/project",
+		Framework: "go-testing",
+		Passive:   true,
+		Projects:  []ProjectScope{{Name: "example.com/shop", BaseDir: "/project/shop"}},
+	})
+	ps.AddConfig("/project/shop/go.mod", &ConfigScope{BaseDir: "/project/shop", Framework: "go-testing", ProjectBoundary: true})
+	ps.AddConfig("/project/shop/nested/go.mod", &ConfigScope{BaseDir: "/project/shop/nested", Framework: "go-testing", ProjectBoundary: true})
+
+	tests := []struct {
+		name      string
+		filePath  string
+		framework string
+		want      string
+	}{
+		{"should find workspace project", "/project/packages/api/src/a.test.ts", "vitest", "api"},
+		{"should prefer the nested config's own name", "/project/packages/web/src/b.test.ts", "vitest", "web-ui"},
+		{"should apply project include", "/project/e2e/login.test.ts", "vitest", "e2e"},
+		{"should return nil outside every project", "/project/scripts/c.test.ts", "vitest", ""},
+		{"should find shared projects for other frameworks", "/project/backend/tests/Feature/LoginTest.php", "pest", "Feature"},
+		{"should find project at its own boundary", "/project/shop/orders/a_test.go", "go-testing", "example.com/shop"},
+		{"should stop project at nested boundary", "/project/shop/nested/inner/c_test.go", "go-testing", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got string
+			if project := ps.FindProject(tt.filePath, tt.framework); project != nil {
+				got = project.Name
+			}
+			if got != tt.want {
+				t.Errorf("FindProject(%q) = %q, want %q", tt.filePath, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestConfigScope_FindMatchingProject(t *testing.T) {
 	t.Parallel()
 
@@ -473,6 +856,12 @@ func TestNewConfigScope(t *testing.T) {
 			root:       "src",
 			wantBase:   "src",
 		},
+		{
+			name:       "should use absolute root as is",
+			configPath: "/project/apps/web/vitest.config.ts",
+			root:       "/project/apps/web/src/",
+			wantBase:   "/project/apps/web/src",
+		},
 	}
 
 	for _, tt := range tests {
@@ -2,6 +2,7 @@ package parser
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -9,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -20,6 +22,7 @@ import (
 	domain_hints "github.com/specvital/core/pkg/parser/domain_hints"
 	"github.com/specvital/core/pkg/parser/framework"
 	"github.com/specvital/core/pkg/parser/strategies/shared/dotnetast"
+	"github.com/specvital/core/pkg/parser/strategies/shared/gomod"
 	"github.com/specvital/core/pkg/parser/strategies/shared/kotlinast"
 	"github.com/specvital/core/pkg/parser/strategies/shared/swiftast"
 	"github.com/specvital/core/pkg/source"
@@ -278,10 +281,16 @@ func (s *Scanner) discoverConfigFiles(ctx context.Context, src source.Source) []
 		"jest.config.mjs",
 		"jest.config.cjs",
 		"jest.config.json",
+		"package.json",
 		"vitest.config.js",
 		"vitest.config.ts",
 		"vitest.config.mjs",
 		"vitest.config.cjs",
+		"vitest.workspace.ts",
+		"vitest.workspace.mts",
+		"vitest.workspace.js",
+		"vitest.workspace.mjs",
+		"vitest.workspace.json",
 		"playwright.config.js",
 		"playwright.config.ts",
 		"cypress.config.cjs",
@@ -291,10 +300,13 @@ func (s *Scanner) discoverConfigFiles(ctx context.Context, src source.Source) []
 		"cypress.config.ts",
 		"pytest.ini",
 		"pyproject.toml",
+		"tox.ini",
+		"setup.cfg",
 		"conftest.py",
 		".rspec",
 		"spec_helper.rb",
 		"rails_helper.rb",
+		"Rakefile",
 		"phpunit.xml",
 		"phpunit.xml.dist",
 		"phpunit.dist.xml",
@@ -306,6 +318,32 @@ func (s *Scanner) discoverConfigFiles(ctx context.Context, src source.Source) []
 		".mocharc.yaml",
 		".mocharc.yml",
 		"mocha.opts",
+		"cucumber.js",
+		"cucumber.cjs",
+		"cucumber.mjs",
+		"cucumber.json",
+		"cucumber.yaml",
+		"cucumber.yml",
+		"cucumber.properties",
+		"behave.ini",
+		".behaverc",
+		"reqnroll.json",
+		"pom.xml",
+		"build.gradle",
+		"build.gradle.kts",
+		"Cargo.toml",
+		"go.mod",
+		"go.work",
+		".busted",
+		"Pest.php",
+		"composer.json",
+		"codeception.yml",
+		"codeception.dist.yml",
+		"__init__.robot",
+		"*.csproj",
+		"*.fsproj",
+		"*.sln",
+		"*.runsettings",
 	}
 
 	rootPath := src.Root()
@@ -334,7 +372,7 @@ func (s *Scanner) discoverConfigFiles(ctx context.Context, src source.Source) []
 
 		filename := filepath.Base(path)
 		for _, pattern := range patterns {
-			if filename == pattern {
+			if matched, _ := filepath.Match(pattern, filename); matched {
 				relPath, err := filepath.Rel(rootPath, path)
 				if err == nil {
 					configFiles = append(configFiles, relPath)
@@ -369,6 +407,7 @@ func (s *Scanner) parseConfigFiles(ctx context.Context, src source.Source, files
 
 		filename := filepath.Base(file)
 		parsed := false
+		declined := false
 
 		for _, def := range s.registry.All() {
 			if def.ConfigParser == nil {
@@ -393,6 +432,12 @@ func (s *Scanner) parseConfigFiles(ctx context.Context, src source.Source, files
 				// Use absolute path for config parsing to ensure correct BaseDir resolution
 				absConfigPath := filepath.Join(src.Root(), file)
 				configScope, err := def.ConfigParser.Parse(ctx, absConfigPath, content)
+				if err == nil && configScope == nil {
+					// Shared build files (pom.xml, build.gradle) only configure a framework
+					// when they declare it; a nil scope lets the next framework try.
+					declined = true
+					continue
+				}
 				if err != nil {
 					*errors = append(*errors, ScanError{
 						Err:   err,
@@ -400,6 +445,8 @@ func (s *Scanner) parseConfigFiles(ctx context.Context, src source.Source, files
 						Phase: "config-parse",
 					})
 				} else {
+					configScope.SourceRoot = src.Root()
+					expandProjectPatterns(ctx, src, configScope)
 					scope.AddConfig(absConfigPath, configScope)
 					parsed = true
 				}
@@ -407,7 +454,7 @@ func (s *Scanner) parseConfigFiles(ctx context.Context, src source.Source, files
 			}
 		}
 
-		if !parsed {
+		if !parsed && !declined {
 			*errors = append(*errors, ScanError{
 				Err:   fmt.Errorf("no matching framework config parser"),
 				Path:  file,
@@ -419,6 +466,96 @@ func (s *Scanner) parseConfigFiles(ctx context.Context, src source.Source, files
 	return scope
 }
 
+// expandProjectPatterns resolves the ProjectPatterns of a config into projects, one per
+// matching directory or config file. A project is named after its package.json name,
+// the way Vitest names workspace projects, or its go.mod module path, or else its
+// directory.
+func expandProjectPatterns(ctx context.Context, src source.Source, scope *framework.ConfigScope) {
+	if len(scope.ProjectPatterns) == 0 {
+		return
+	}
+
+	var patterns, negated []string
+	for _, pattern := range scope.ProjectPatterns {
+		if p, ok := strings.CutPrefix(pattern, "!"); ok {
+			negated = append(negated, p)
+		} else {
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, project := range scope.Projects {
+		seen[filepath.Clean(project.BaseDir)] = true
+	}
+
+	fsys := os.DirFS(scope.BaseDir)
+	for _, pattern := range patterns {
+		matches, err := doublestar.Glob(fsys, pattern)
+		if err != nil {
+			continue
+		}
+		for _, match := range matches {
+			if matchesAny(negated, match) {
+				continue
+			}
+			dir := filepath.Join(scope.BaseDir, filepath.FromSlash(match))
+			if info, err := os.Stat(dir); err != nil {
+				continue
+			} else if !info.IsDir() {
+				dir = filepath.Dir(dir)
+			}
+			if seen[dir] {
+				continue
+			}
+			seen[dir] = true
+
+			scope.Projects = append(scope.Projects, framework.ProjectScope{
+				Name:    packageName(ctx, src, dir),
+				BaseDir: dir,
+			})
+		}
+	}
+}
+
+func matchesAny(patterns []string, path string) bool {
+	for _, pattern := range patterns {
+		if match, err := doublestar.Match(pattern, path); err == nil && match {
+			return true
+		}
+	}
+	return false
+}
+
+// packageName returns the name in dir/package.json, or the module path in dir/go.mod,
+// or else the directory name.
+func packageName(ctx context.Context, src source.Source, dir string) string {
+	if content, ok := readDirFile(ctx, src, dir, "package.json"); ok {
+		var pkg struct {
+			Name string `json:"name"`
+		}
+		if json.Unmarshal(content, &pkg) == nil && pkg.Name != "" {
+			return pkg.Name
+		}
+	}
+	if content, ok := readDirFile(ctx, src, dir, gomod.ModFile); ok {
+		if module := gomod.ModulePath(content); module != "" {
+			return module
+		}
+	}
+	return filepath.Base(dir)
+}
+
+// readDirFile reads the file with the given name in dir, an absolute directory of src.
+func readDirFile(ctx context.Context, src source.Source, dir, name string) ([]byte, bool) {
+	relPath, err := filepath.Rel(src.Root(), filepath.Join(dir, name))
+	if err != nil {
+		return nil, false
+	}
+	content, err := readFileFromSource(ctx, src, relPath)
+	return content, err == nil
+}
+
 // discoverTestFiles walks the source root to find test file candidates.
 // Returns relative paths from the source root for consistent Source.Open() usage.
 func (s *Scanner) discoverTestFiles(ctx context.Context, src source.Source) ([]string, []error) {
@@ -465,7 +602,7 @@ func (s *Scanner) discoverTestFiles(ctx context.Context, src source.Source) ([]s
 
 		// Use relative path for test file detection to avoid false positives
 		// from parent directory names (e.g., /tests/integration/testdata/cache/)
-		if !isTestFileCandidate(relPath) {
+		if !s.isTestFile(relPath, path) {
 			return nil
 		}
 
@@ -599,7 +736,13 @@ func (s *Scanner) parseFile(ctx context.Context, src source.Source, path string)
 		}, string(detectionResult.Source)
 	}
 
-	testFile, err := def.Parser.Parse(ctx, content, path)
+	var testFile *domain.TestFile
+	scoped, ok := def.Parser.(framework.ScopedParser)
+	if scope := s.projectScope.ResolveConfig(absPath, def.Name); ok && scope != nil {
+		testFile, err = scoped.ParseWithScope(ctx, content, path, scope)
+	} else {
+		testFile, err = def.Parser.Parse(ctx, content, path)
+	}
 	if err != nil {
 		return nil, &ScanError{
 			Err:   fmt.Errorf("parse: %w", err),
@@ -608,6 +751,22 @@ func (s *Scanner) parseFile(ctx context.Context, src source.Source, path string)
 		}, string(detectionResult.Source)
 	}
 
+	// Modules collected only through config (pytest --doctest-modules), sources that may
+	// hold inline tests and the helpers of test projects are not test files unless they
+	// define tests.
+	if testFile == nil || (testFile.CountTests() == 0 &&
+		(!isTestFileCandidate(path) || isInlineTestSource(path) || s.isProjectMemberSource(path, absPath))) {
+		return nil, nil, string(detectionResult.Source)
+	}
+
+	if project := s.projectScope.FindProject(absPath, def.Name); project != nil {
+		testFile.Project = project.Name
+	}
+
+	integration := s.projectScope.FindDecidingConfig(absPath).Integrates(absPath)
+	includeTags, excludeTags := s.projectScope.FindTagFilters(absPath)
+	applyRunnerSetup(testFile, integration, includeTags, excludeTags)
+
 	if s.options.ExtractDomainHints {
 		if extractor := domain_hints.GetExtractor(testFile.Language); extractor != nil {
 			testFile.DomainHints = extractor.Extract(ctx, content)
@@ -617,6 +776,66 @@ func (s *Scanner) parseFile(ctx context.Context, src source.Source, path string)
 	return testFile, nil, string(detectionResult.Source)
 }
 
+// applyRunnerSetup applies the runner setup of the configs of a file, such as a Maven
+// or Gradle build running every JVM framework or a .runsettings filter, to its tests:
+// integration runners mark their kind, and tag filters exclude the active tests they skip.
+func applyRunnerSetup(testFile *domain.TestFile, integration bool, includeTags, excludeTags []string) {
+	if !integration && len(includeTags) == 0 && len(excludeTags) == 0 {
+		return
+	}
+
+	apply := func(test *domain.Test) {
+		if integration && test.Kind == "" {
+			test.Kind = domain.TestKindIntegration
+		}
+		if test.Status == domain.TestStatusActive && !runsWithTags(test.Tags, includeTags, excludeTags) {
+			test.Status = domain.TestStatusExcluded
+		}
+	}
+	var walk func(suites []domain.TestSuite)
+	walk = func(suites []domain.TestSuite) {
+		for i := range suites {
+			for j := range suites[i].Tests {
+				apply(&suites[i].Tests[j])
+			}
+			walk(suites[i].Suites)
+		}
+	}
+
+	for i := range testFile.Tests {
+		apply(&testFile.Tests[i])
+	}
+	walk(testFile.Suites)
+}
+
+// runsWithTags reports whether a test with the given tags passes the tag filters.
+func runsWithTags(tags, includeTags, excludeTags []string) bool {
+	for _, tag := range tags {
+		if slices.Contains(excludeTags, tag) {
+			return false
+		}
+	}
+	if len(includeTags) == 0 {
+		return true
+	}
+	for _, tag := range tags {
+		if slices.Contains(includeTags, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// isTestFile reports whether a discovered file should be parsed. A config that
+// decides the file (PHPUnit testsuites) replaces naming conventions; otherwise the
+// conventions apply, extended by the files a config collects.
+func (s *Scanner) isTestFile(relPath, absPath string) bool {
+	if scope := s.projectScope.FindDecidingConfig(absPath); scope != nil {
+		return scope.Collects(absPath)
+	}
+	return isTestFileCandidate(relPath) || s.projectScope.FindCollectingConfig(absPath) != nil
+}
+
 // readFileFromSource reads a file from source using relative path.
 // The relPath must be relative to src.Root().
 func readFileFromSource(ctx context.Context, src source.Source, relPath string) ([]byte, error) {
@@ -673,6 +892,8 @@ func isTestFileCandidate(path string) bool {
 		return isJavaTestFile(path)
 	case ".kt", ".kts":
 		return isKotlinTestFile(path)
+	case ".groovy":
+		return isGroovyTestFile(path)
 	case ".py":
 		return isPythonTestFile(path)
 	case ".cs":
@@ -681,12 +902,31 @@ func isTestFileCandidate(path string) bool {
 		return isRubyTestFile(path)
 	case ".rs":
 		return isRustTestFile(path)
+	case ".scala":
+		return isScalaTestFile(path)
+	case ".c":
+		return isCTestFile(path)
 	case ".cc", ".cpp", ".cxx":
 		return isCppTestFile(path)
+	case ".exs":
+		return isElixirTestFile(path)
+	case ".lua":
+		return isLuaTestFile(path)
 	case ".php":
 		return isPHPTestFile(path)
 	case ".swift":
 		return isSwiftTestFile(path)
+	case ".feature":
+		// Every Gherkin feature file is an executable specification.
+		return true
+	case ".bats":
+		// Bats only runs .bats files, so the extension alone marks a test file.
+		return true
+	case ".hcl":
+		return isHCLTestFile(path)
+	case ".robot":
+		// __init__.robot only configures its directory suite and is read as a config file.
+		return filepath.Base(path) != "__init__.robot"
 	default:
 		return false
 	}
@@ -697,6 +937,24 @@ func isGoTestFile(path string) bool {
 	return strings.HasSuffix(base, "_test.go")
 }
 
+// isLuaTestFile matches busted's default pattern (_spec) and the common _test variant.
+func isLuaTestFile(path string) bool {
+	base := filepath.Base(path)
+	return strings.HasSuffix(base, "_spec.lua") || strings.HasSuffix(base, "_test.lua")
+}
+
+// isHCLTestFile matches Terraform and OpenTofu test files; other .hcl files are configuration.
+func isHCLTestFile(path string) bool {
+	base := filepath.Base(path)
+	return strings.HasSuffix(base, ".tftest.hcl") || strings.HasSuffix(base, ".tofutest.hcl")
+}
+
+// isElixirTestFile matches ExUnit's default test_pattern: *_test.exs.
+func isElixirTestFile(path string) bool {
+	base := filepath.Base(path)
+	return strings.HasSuffix(base, "_test.exs")
+}
+
 func isJavaTestFile(path string) bool {
 	normalizedPath := filepath.ToSlash(path)
 
@@ -731,6 +989,48 @@ func isKotlinTestFile(path string) bool {
 	return kotlinast.IsKotlinTestFile(path)
 }
 
+func isGroovyTestFile(path string) bool {
+	base := filepath.Base(path)
+	name := strings.TrimSuffix(base, filepath.Ext(base))
+
+	// Spock conventions: *Spec, *Specification, *Test, *Tests
+	if strings.HasSuffix(name, "Spec") || strings.HasSuffix(name, "Specification") ||
+		strings.HasSuffix(name, "Test") || strings.HasSuffix(name, "Tests") {
+		return true
+	}
+
+	normalizedPath := filepath.ToSlash(path)
+
+	// Maven/Gradle test source roots: src/test/groovy, src/integrationTest/groovy
+	if strings.Contains(normalizedPath, "/test/groovy/") || strings.HasPrefix(normalizedPath, "test/groovy/") ||
+		strings.Contains(normalizedPath, "Test/groovy/") {
+		return true
+	}
+
+	return false
+}
+
+func isScalaTestFile(path string) bool {
+	base := filepath.Base(path)
+	name := strings.TrimSuffix(base, filepath.Ext(base))
+
+	// ScalaTest/MUnit/specs2 conventions: *Spec, *Test, *Tests, *Suite
+	if strings.HasSuffix(name, "Spec") || strings.HasSuffix(name, "Test") ||
+		strings.HasSuffix(name, "Tests") || strings.HasSuffix(name, "Suite") {
+		return true
+	}
+
+	normalizedPath := filepath.ToSlash(path)
+
+	// sbt/Maven/Gradle test source roots: src/test/scala, src/it/scala
+	if strings.Contains(normalizedPath, "/test/scala/") || strings.HasPrefix(normalizedPath, "test/scala/") ||
+		strings.Contains(normalizedPath, "/it/scala/") {
+		return true
+	}
+
+	return false
+}
+
 func isJSTestFile(path string) bool {
 	base := filepath.Base(path)
 	lowerBase := strings.ToLower(base)
@@ -891,6 +1191,27 @@ func isRustTestFile(path string) bool {
 	return false
 }
 
+// isProjectMemberSource reports whether path is a candidate only because a test project
+// collects every C# file it holds (a .csproj that references a test framework): such
+// a file is a test file only if it defines tests or is named as one.
+func (s *Scanner) isProjectMemberSource(path, absPath string) bool {
+	if !strings.EqualFold(filepath.Ext(path), ".cs") || dotnetast.IsCSharpTestFileName(path) {
+		return false
+	}
+	return s.projectScope.FindDecidingConfig(absPath) != nil
+}
+
+// isInlineTestSource reports whether path is a candidate only because its language
+// places unit tests inline with the code, as Rust does in #[cfg(test)] modules under
+// src/: such a file is a test file only if it defines tests.
+func isInlineTestSource(path string) bool {
+	if filepath.Ext(path) != ".rs" || strings.HasSuffix(filepath.Base(path), "_test.rs") {
+		return false
+	}
+	normalizedPath := filepath.ToSlash(path)
+	return !strings.Contains(normalizedPath, "/tests/") && !strings.HasPrefix(normalizedPath, "tests/")
+}
+
 func isCppTestFile(path string) bool {
 	base := filepath.Base(path)
 	baseLower := strings.ToLower(base)
@@ -904,6 +1225,11 @@ func isCppTestFile(path string) bool {
 		return true
 	}
 
+	// Catch2/doctest/Boost.Test conventions: test_*, *_tests, *.test
+	if strings.HasPrefix(name, "test_") || strings.HasSuffix(name, "_tests") || strings.HasSuffix(name, ".test") {
+		return true
+	}
+
 	// *Test pattern (e.g., DatabaseTest.cc) - uppercase T avoids false positives like "contest.cc"
 	baseOriginal := filepath.Base(path)
 	nameOriginal := strings.TrimSuffix(baseOriginal, filepath.Ext(baseOriginal))
@@ -924,16 +1250,62 @@ func isCppTestFile(path string) bool {
 	return false
 }
 
+func isCTestFile(path string) bool {
+	base := filepath.Base(path)
+	name := strings.TrimSuffix(base, filepath.Ext(base))
+	nameLower := strings.ToLower(name)
+
+	// Unity's generate_test_runner.rb output (e.g., test_stack_Runner.c) only calls into the tests
+	if strings.HasSuffix(name, "_Runner") {
+		return false
+	}
+
+	// Unity/CMocka/CUnit conventions: test_*, *_test, *_tests
+	if strings.HasPrefix(nameLower, "test_") || strings.HasSuffix(nameLower, "_test") || strings.HasSuffix(nameLower, "_tests") {
+		return true
+	}
+
+	// Check convention: check_*
+	if strings.HasPrefix(nameLower, "check_") {
+		return true
+	}
+
+	// Test* / *Test patterns (e.g., TestStack.c) - case-sensitive to avoid "contest.c"
+	if (strings.HasPrefix(name, "Test") || strings.HasSuffix(name, "Test")) && len(name) > 4 {
+		return true
+	}
+
+	normalizedPath := filepath.ToSlash(path)
+
+	// test/ or tests/ directory
+	if strings.Contains(normalizedPath, "/test/") || strings.Contains(normalizedPath, "/tests/") {
+		return true
+	}
+	if strings.HasPrefix(normalizedPath, "test/") || strings.HasPrefix(normalizedPath, "tests/") {
+		return true
+	}
+
+	return false
+}
+
 func isPHPTestFile(path string) bool {
 	base := filepath.Base(path)
 	name := strings.TrimSuffix(base, ".php")
 
+	// Pest.php configures Pest for the tests/ directory it sits in; it holds no tests.
+	if base == "Pest.php" {
+		return false
+	}
 	if strings.HasSuffix(name, "Test") || strings.HasSuffix(name, "Tests") {
 		return true
 	}
 	if strings.HasPrefix(name, "Test") {
 		return true
 	}
+	// Codeception scenario classes and files.
+	if strings.HasSuffix(name, "Cest") || strings.HasSuffix(name, "Cept") {
+		return true
+	}
 
 	normalizedPath := filepath.ToSlash(path)
 
@@ -6,21 +6,51 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/specvital/core/pkg/domain"
 	"github.com/specvital/core/pkg/parser"
 	"github.com/specvital/core/pkg/source"
 
 	// Import frameworks to register them via init()
+	_ "github.com/specvital/core/pkg/parser/strategies/bats"
+	_ "github.com/specvital/core/pkg/parser/strategies/boosttest"
+	_ "github.com/specvital/core/pkg/parser/strategies/busted"
 	_ "github.com/specvital/core/pkg/parser/strategies/cargotest"
+	_ "github.com/specvital/core/pkg/parser/strategies/catch2"
+	_ "github.com/specvital/core/pkg/parser/strategies/check"
+	_ "github.com/specvital/core/pkg/parser/strategies/cmocka"
+	_ "github.com/specvital/core/pkg/parser/strategies/codeception"
+	_ "github.com/specvital/core/pkg/parser/strategies/cunit"
+	_ "github.com/specvital/core/pkg/parser/strategies/doctest"
+	_ "github.com/specvital/core/pkg/parser/strategies/exunit"
+	_ "github.com/specvital/core/pkg/parser/strategies/gherkin"
 	_ "github.com/specvital/core/pkg/parser/strategies/gotesting"
 	_ "github.com/specvital/core/pkg/parser/strategies/gtest"
 	_ "github.com/specvital/core/pkg/parser/strategies/jest"
+	_ "github.com/specvital/core/pkg/parser/strategies/junit5"
+	_ "github.com/specvital/core/pkg/parser/strategies/minitest"
 	_ "github.com/specvital/core/pkg/parser/strategies/mstest"
+	_ "github.com/specvital/core/pkg/parser/strategies/munit"
+	_ "github.com/specvital/core/pkg/parser/strategies/nunit"
+	_ "github.com/specvital/core/pkg/parser/strategies/pest"
 	_ "github.com/specvital/core/pkg/parser/strategies/phpunit"
+	_ "github.com/specvital/core/pkg/parser/strategies/pytest"
+	_ "github.com/specvital/core/pkg/parser/strategies/quick"
+	_ "github.com/specvital/core/pkg/parser/strategies/robot"
+	_ "github.com/specvital/core/pkg/parser/strategies/rspec"
+	_ "github.com/specvital/core/pkg/parser/strategies/scalatest"
+	_ "github.com/specvital/core/pkg/parser/strategies/specs2"
+	_ "github.com/specvital/core/pkg/parser/strategies/spock"
+	_ "github.com/specvital/core/pkg/parser/strategies/terraformtest"
+	_ "github.com/specvital/core/pkg/parser/strategies/unity"
+	_ "github.com/specvital/core/pkg/parser/strategies/xctest"
+	_ "github.com/specvital/core/pkg/parser/strategies/xunit"
 )
 
 func TestScan(t *testing.T) {
@@ -577,6 +607,1650 @@ TEST(CxxTest, Works) {
 	})
 }
 
+func TestScan_CppFrameworks(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"test_vector.cpp": `
+#include <catch2/catch_test_macros.hpp>
+
+TEST_CASE("vector", "[container]") {
+    SECTION("push_back") {}
+    SECTION("pop_back") {}
+}
+`,
+		"math_tests.cpp": `
+#include <doctest/doctest.h>
+
+TEST_SUITE("math") {
+    TEST_CASE("addition") {}
+}
+`,
+		"parser.test.cc": `
+#include <boost/test/unit_test.hpp>
+
+BOOST_AUTO_TEST_SUITE(parser)
+BOOST_AUTO_TEST_CASE(parses_empty_input) {}
+BOOST_AUTO_TEST_SUITE_END()
+`,
+		"legacy_test.cpp": `
+#include "catch.hpp"
+
+TEST_CASE("legacy single header") {}
+`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+
+	if len(result.Inventory.Files) != len(files) {
+		t.Fatalf("expected %d files, got %d", len(files), len(result.Inventory.Files))
+	}
+
+	want := map[string]string{
+		"test_vector.cpp": "catch2",
+		"math_tests.cpp":  "doctest",
+		"parser.test.cc":  "boost-test",
+		"legacy_test.cpp": "catch2",
+	}
+	for _, file := range result.Inventory.Files {
+		name := filepath.Base(file.Path)
+		if file.Framework != want[name] {
+			t.Errorf("%s: expected framework %q, got %q", name, want[name], file.Framework)
+		}
+		if file.CountTests() == 0 {
+			t.Errorf("%s: expected tests, got none", name)
+		}
+	}
+}
+
+func TestScan_CFrameworks(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"test_stack.c": `
+#include "unity.h"
+#include "stack.h"
+
+void test_push(void) {}
+void test_pop(void) {}
+`,
+		"test_parser.c": `
+#include <cmocka.h>
+
+static void parse_empty(void **state) {}
+
+int main(void) {
+    const struct CMUnitTest tests[] = {
+        cmocka_unit_test(parse_empty),
+    };
+    return cmocka_run_group_tests(tests, NULL, NULL);
+}
+`,
+		"check_money.c": `
+#include <check.h>
+
+START_TEST(test_money_create) {}
+END_TEST
+`,
+		"math_tests.c": `
+#include <CUnit/Basic.h>
+
+void test_max(void) {}
+
+int main(void) {
+    CU_pSuite s = CU_add_suite("math", NULL, NULL);
+    CU_add_test(s, "max", test_max);
+    return 0;
+}
+`,
+		"test_stack_Runner.c": `
+#include "unity.h"
+
+int main(void) { return UNITY_END(); }
+`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+
+	want := map[string]string{
+		"test_stack.c":  "unity",
+		"test_parser.c": "cmocka",
+		"check_money.c": "check",
+		"math_tests.c":  "cunit",
+	}
+	if len(result.Inventory.Files) != len(want) {
+		t.Fatalf("expected %d files, got %d", len(want), len(result.Inventory.Files))
+	}
+	for _, file := range result.Inventory.Files {
+		name := filepath.Base(file.Path)
+		if file.Framework != want[name] {
+			t.Errorf("%s: expected framework %q, got %q", name, want[name], file.Framework)
+		}
+		if file.CountTests() == 0 {
+			t.Errorf("%s: expected tests, got none", name)
+		}
+	}
+}
+
+func TestScan_ScalaFrameworks(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"src/test/scala/com/example/SetSuite.scala": `
+package com.example
+
+import org.scalatest.funsuite.AnyFunSuite
+
+class SetSuite extends AnyFunSuite {
+  test("an empty Set should have size 0") {}
+}
+`,
+		"src/test/scala/com/example/StackSpec.scala": `
+import org.scalatest.flatspec.AnyFlatSpec
+
+class StackSpec extends AnyFlatSpec {
+  "A Stack" should "pop values" in {}
+}
+`,
+		"src/test/scala/com/example/ParserSuite.scala": `
+import munit.FunSuite
+
+class ParserSuite extends FunSuite {
+  test("parses numbers") {}
+}
+`,
+		"src/test/scala/com/example/HelloSpec.scala": `
+import org.specs2.mutable.Specification
+
+class HelloSpec extends Specification {
+  "Hello" should {
+    "have 5 characters" in { "Hello" must haveSize(5) }
+  }
+}
+`,
+		"src/main/scala/com/example/Set.scala": `
+package com.example
+
+class Set
+`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+
+	want := map[string]string{
+		"SetSuite.scala":    "scalatest",
+		"StackSpec.scala":   "scalatest",
+		"ParserSuite.scala": "munit",
+		"HelloSpec.scala":   "specs2",
+	}
+	if len(result.Inventory.Files) != len(want) {
+		t.Fatalf("expected %d files, got %d", len(want), len(result.Inventory.Files))
+	}
+	for _, file := range result.Inventory.Files {
+		name := filepath.Base(file.Path)
+		if file.Framework != want[name] {
+			t.Errorf("%s: expected framework %q, got %q", name, want[name], file.Framework)
+		}
+		if file.CountTests() == 0 {
+			t.Errorf("%s: expected tests, got none", name)
+		}
+	}
+}
+
+func TestScan_Elixir(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"test/my_app/user_test.exs": `
+defmodule MyApp.UserTest do
+  use ExUnit.Case, async: true
+
+  doctest MyApp.User
+
+  describe "changeset/2" do
+    test "requires email" do
+      assert true
+    end
+  end
+end
+`,
+		"test/test_helper.exs": `
+ExUnit.start()
+`,
+		"lib/my_app/user.ex": `
+defmodule MyApp.User do
+end
+`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+
+	if len(result.Inventory.Files) != 1 {
+		t.Fatalf("expected 1 file, got %d", len(result.Inventory.Files))
+	}
+	file := result.Inventory.Files[0]
+	if file.Framework != "exunit" {
+		t.Errorf("expected framework exunit, got %q", file.Framework)
+	}
+	if file.Language != "elixir" {
+		t.Errorf("expected language elixir, got %q", file.Language)
+	}
+	if file.CountTests() != 2 {
+		t.Errorf("expected 2 tests, got %d", file.CountTests())
+	}
+}
+
+func TestScan_Spock(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"src/test/groovy/com/example/MathSpec.groovy": `
+package com.example
+
+import spock.lang.Specification
+
+class MathSpec extends Specification {
+  def "adds numbers"() {
+    expect:
+    a + b == c
+
+    where:
+    a | b || c
+    1 | 2 || 3
+    2 | 2 || 4
+  }
+
+  def "subtracts numbers"() {
+    expect:
+    3 - 1 == 2
+  }
+}
+`,
+		"src/main/groovy/com/example/Math.groovy": `
+package com.example
+
+class Math {
+  def add(a, b) { a + b }
+}
+`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+
+	if len(result.Inventory.Files) != 1 {
+		t.Fatalf("expected 1 file, got %d", len(result.Inventory.Files))
+	}
+	file := result.Inventory.Files[0]
+	if file.Framework != "spock" {
+		t.Errorf("expected framework spock, got %q", file.Framework)
+	}
+	if file.CountTests() != 3 {
+		t.Errorf("expected 3 tests, got %d", file.CountTests())
+	}
+}
+
+func TestScan_Gherkin(t *testing.T) {
+	feature := `
+Feature: Login
+  Scenario: Successful login
+    Given a registered user
+
+  Scenario Outline: Login as <role>
+    Given a <role>
+
+    Examples:
+      | role  |
+      | admin |
+      | guest |
+`
+	files := map[string]string{
+		"web/cucumber.js":                               "module.exports = { default: { paths: ['features/**/*.feature'] } };\n",
+		"web/features/login.feature":                    feature,
+		"api/behave.ini":                                "[behave]\nformat = progress\n",
+		"api/features/login.feature":                    feature,
+		"jvm/pom.xml":                                   "<project><dependencies><dependency><groupId>io.cucumber</groupId></dependency></dependencies></project>\n",
+		"jvm/src/test/resources/features/login.feature": feature,
+		"plain/pom.xml":                                 "<project><dependencies><dependency><groupId>junit</groupId></dependency></dependencies></project>\n",
+		"plain/features/login.feature":                  feature,
+	}
+	tmpDir := t.TempDir()
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+	if len(result.Errors) != 0 {
+		t.Errorf("expected no scan errors, got %v", result.Errors)
+	}
+
+	want := map[string]string{
+		"web/features/login.feature":                    "cucumber-js",
+		"api/features/login.feature":                    "behave",
+		"jvm/src/test/resources/features/login.feature": "cucumber-jvm",
+		"plain/features/login.feature":                  "cucumber",
+	}
+	if len(result.Inventory.Files) != len(want) {
+		t.Fatalf("expected %d files, got %d", len(want), len(result.Inventory.Files))
+	}
+	for _, file := range result.Inventory.Files {
+		if file.Framework != want[file.Path] {
+			t.Errorf("%s: expected framework %q, got %q", file.Path, want[file.Path], file.Framework)
+		}
+		if file.Language != "gherkin" {
+			t.Errorf("%s: expected language gherkin, got %q", file.Path, file.Language)
+		}
+		if file.CountTests() != 3 {
+			t.Errorf("%s: expected 3 tests, got %d", file.Path, file.CountTests())
+		}
+	}
+}
+
+func TestScan_Bats(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"test/deploy.bats": `#!/usr/bin/env bats
+
+load test_helper/common
+
+@test "deploys the release" {
+  run ./bin/deploy --dry-run
+  [ "$status" -eq 0 ]
+}
+
+@test "rolls back" {
+  skip "flaky on CI"
+  run ./bin/rollback
+}
+`,
+		"test/test_helper/common.bash": `
+setup() {
+  export PATH="$BATS_TEST_DIRNAME/../bin:$PATH"
+}
+`,
+		"bin/deploy": "#!/usr/bin/env bash\necho deploying\n",
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+
+	if len(result.Inventory.Files) != 1 {
+		t.Fatalf("expected 1 file, got %d", len(result.Inventory.Files))
+	}
+	file := result.Inventory.Files[0]
+	if file.Framework != "bats" {
+		t.Errorf("expected framework bats, got %q", file.Framework)
+	}
+	if file.Language != "shell" {
+		t.Errorf("expected language shell, got %q", file.Language)
+	}
+	if file.CountTests() != 2 {
+		t.Errorf("expected 2 tests, got %d", file.CountTests())
+	}
+	if file.DomainHints == nil || len(file.DomainHints.Imports) != 1 || file.DomainHints.Imports[0] != "test_helper/common" {
+		t.Errorf("expected test_helper/common import hint, got %+v", file.DomainHints)
+	}
+}
+
+func TestScan_Busted(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		".busted": `return {
+  default = { ROOT = {"spec"}, pattern = "_spec" },
+}
+`,
+		"spec/calc_spec.lua": `
+local calc = require("app.calc")
+
+describe("calc", function()
+  it("adds", function()
+    assert.are.equal(4, calc.add(2, 2))
+  end)
+
+  pending("subtracts")
+end)
+`,
+		"lua/app/calc.lua": `
+local M = {}
+function M.add(a, b) return a + b end
+return M
+`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+
+	if result.Stats.ConfigsFound != 1 {
+		t.Errorf("expected .busted to be parsed, got %d configs", result.Stats.ConfigsFound)
+	}
+	if len(result.Inventory.Files) != 1 {
+		t.Fatalf("expected 1 file, got %d", len(result.Inventory.Files))
+	}
+	file := result.Inventory.Files[0]
+	if file.Framework != "busted" {
+		t.Errorf("expected framework busted, got %q", file.Framework)
+	}
+	if file.Language != "lua" {
+		t.Errorf("expected language lua, got %q", file.Language)
+	}
+	if file.CountTests() != 2 {
+		t.Errorf("expected 2 tests, got %d", file.CountTests())
+	}
+}
+
+func TestScan_BustedCustomPattern(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	test := `
+describe("calc", function()
+  it("adds", function()
+    assert.are.equal(4, 2 + 2)
+  end)
+end)
+`
+	files := map[string]string{
+		".busted": `return {
+  default = { ROOT = {"tests"}, pattern = "_check" },
+}
+`,
+		"tests/calc_check.lua": test,
+		"tests/calc_spec.lua":  test,
+		"spec/legacy_spec.lua": test,
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+
+	var got []string
+	for _, file := range result.Inventory.Files {
+		got = append(got, filepath.ToSlash(file.Path))
+	}
+	sort.Strings(got)
+
+	// busted runs only the files of its ROOT matching its pattern, whatever their names.
+	expected := []string{"tests/calc_check.lua"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected files %v, got %v", expected, got)
+	}
+}
+
+func TestScan_PytestDoctest(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"pytest.ini": `[pytest]
+addopts =
+    --doctest-modules
+    --doctest-glob=*.rst
+`,
+		"src/money.py": `"""Money arithmetic.
+
+>>> Money(1) + Money(2)
+Money(3)
+"""
+
+
+def split(amount, n):
+    """
+    >>> split(4, 2)
+    [2, 2]
+    """
+    return [amount // n] * n
+`,
+		"src/plain.py": `def add(a, b):
+    """Add two numbers."""
+    return a + b
+`,
+		"docs/guide.rst": `Guide
+=====
+
+    >>> from money import split
+    >>> split(6, 3)
+    [2, 2, 2]
+`,
+		"docs/notes.rst": "Nothing to run here.\n",
+		"tests/test_money.py": `from hypothesis import given, strategies as st
+
+@given(st.integers(min_value=1))
+def test_split_sums(n):
+    assert sum(split(n, 1)) == n
+`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+
+	counts := make(map[string]int)
+	for _, file := range result.Inventory.Files {
+		if file.Framework != "pytest" {
+			t.Errorf("%s: expected framework pytest, got %q", file.Path, file.Framework)
+		}
+		counts[filepath.ToSlash(file.Path)] = file.CountTests()
+	}
+
+	expected := map[string]int{
+		"docs/guide.rst":      1,
+		"src/money.py":        2,
+		"tests/test_money.py": 1,
+	}
+	if len(counts) != len(expected) {
+		t.Fatalf("expected files %v, got %v", expected, counts)
+	}
+	for path, count := range expected {
+		if counts[path] != count {
+			t.Errorf("%s: expected %d tests, got %d", path, count, counts[path])
+		}
+	}
+
+	for _, file := range result.Inventory.Files {
+		if file.Path == filepath.FromSlash("tests/test_money.py") && file.Tests[0].Kind != domain.TestKindProperty {
+			t.Errorf("expected @given test to be property-based, got %q", file.Tests[0].Kind)
+		}
+	}
+}
+
+func TestScan_PytestCollectionOptions(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"setup.cfg": `[metadata]
+name = money
+
+[tool:pytest]
+testpaths = checks
+python_files = check_*.py
+python_classes = Check
+python_functions = check_ test_*
+norecursedirs = fixtures
+`,
+		"checks/conftest.py": "import pytest\n",
+		"checks/check_money.py": `class CheckMoney:
+    def check_add(self):
+        assert True
+
+    def helper(self):
+        pass
+
+
+class TestLegacy:
+    def check_ignored(self):
+        pass
+
+
+def check_split():
+    assert True
+
+
+def test_split_sums():
+    assert True
+`,
+		"checks/test_legacy.py": `def test_old():
+    assert True
+`,
+		"checks/fixtures/check_fixture.py": `def check_fixture():
+    pass
+`,
+		"pyproject.toml": `[project]
+name = "money"
+`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+	for _, scanErr := range result.Errors {
+		t.Errorf("unexpected scan error: %v", scanErr)
+	}
+
+	counts := make(map[string]int)
+	for _, file := range result.Inventory.Files {
+		counts[filepath.ToSlash(file.Path)] = file.CountTests()
+	}
+
+	// test_legacy.py does not match python_files and fixtures/ is in norecursedirs.
+	expected := map[string]int{
+		"checks/check_money.py": 3,
+	}
+	if len(counts) != len(expected) {
+		t.Fatalf("expected files %v, got %v", expected, counts)
+	}
+	for path, count := range expected {
+		if counts[path] != count {
+			t.Errorf("%s: expected %d tests, got %d", path, count, counts[path])
+		}
+	}
+}
+
+func TestScan_PytestConfigPrecedence(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"pyproject.toml": `[tool.pytest.ini_options]
+python_files = ["spec_*.py"]
+`,
+		"pytest.ini": `[pytest]
+python_files = check_*.py
+`,
+		"tox.ini": `[pytest]
+python_files = tox_*.py
+`,
+		"tests/check_money.py": `def test_add():
+    assert True
+`,
+		"tests/spec_money.py": `def test_split():
+    assert True
+`,
+		"tests/tox_money.py": `def test_round():
+    assert True
+`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+	for _, scanErr := range result.Errors {
+		t.Errorf("unexpected scan error: %v", scanErr)
+	}
+
+	var paths []string
+	for _, file := range result.Inventory.Files {
+		paths = append(paths, filepath.ToSlash(file.Path))
+	}
+
+	// pytest reads pytest.ini over pyproject.toml and tox.ini in the same directory.
+	expected := []string{"tests/check_money.py"}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("expected files %v, got %v", expected, paths)
+	}
+}
+
+func TestScan_RubyConfig(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		".rspec": "--require spec_helper\n--tag ~slow\n",
+		"spec/spec_helper.rb": `RSpec.configure do |config|
+  config.filter_run_when_matching :focus
+  config.filter_run_excluding type: :feature
+end
+`,
+		"spec/models/user_spec.rb": `RSpec.describe User do
+  it "saves" do
+  end
+
+  it "imports the archive", :slow do
+  end
+
+  fit "validates" do
+  end
+
+  describe "browser", type: :feature do
+    it "logs in" do
+    end
+  end
+end
+`,
+		"Rakefile": `require "rake/testtask"
+
+Rake::TestTask.new(:test) do |t|
+  t.pattern = "test/**/*_test.rb"
+end
+`,
+		"test/test_helper.rb": "require \"minitest/autorun\"\n",
+		"test/models/user_test.rb": `require "test_helper"
+
+class UserTest < Minitest::Test
+  def test_saves
+    assert true
+  end
+end
+`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+	for _, scanErr := range result.Errors {
+		t.Errorf("unexpected scan error: %v", scanErr)
+	}
+
+	frameworks := make(map[string]string)
+	statuses := make(map[string]domain.TestStatus)
+	for _, file := range result.Inventory.Files {
+		frameworks[filepath.ToSlash(file.Path)] = file.Framework
+		var walk func(suites []domain.TestSuite)
+		walk = func(suites []domain.TestSuite) {
+			for _, suite := range suites {
+				for _, test := range suite.Tests {
+					statuses[test.Name] = test.Status
+				}
+				walk(suite.Suites)
+			}
+		}
+		walk(file.Suites)
+	}
+
+	// test_helper.rb is left out by the TestTask pattern.
+	expectedFrameworks := map[string]string{
+		"spec/models/user_spec.rb": "rspec",
+		"test/models/user_test.rb": "minitest",
+	}
+	if !reflect.DeepEqual(frameworks, expectedFrameworks) {
+		t.Errorf("expected files %v, got %v", expectedFrameworks, frameworks)
+	}
+
+	// --tag ~slow in .rspec and the spec_helper.rb filters apply together.
+	expectedStatuses := map[string]domain.TestStatus{
+		"saves":               domain.TestStatusActive,
+		"imports the archive": domain.TestStatusExcluded,
+		"validates":           domain.TestStatusFocused,
+		"logs in":             domain.TestStatusExcluded,
+	}
+	for name, want := range expectedStatuses {
+		if statuses[name] != want {
+			t.Errorf("%q: expected status %q, got %q", name, want, statuses[name])
+		}
+	}
+}
+
+func TestScan_VitestWorkspace(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"vitest.workspace.ts": `export default ['packages/*', '!packages/legacy'];
+`,
+		"packages/api/package.json": `{"name": "@acme/api"}`,
+		"packages/api/src/api.test.ts": `import { describe, it, expect } from 'vitest';
+
+describe('api', () => {
+  it('works', () => {
+    expect(true).toBe(true);
+  });
+});
+`,
+		"packages/web/vitest.config.ts": "export default { test: { name: 'web-ui', environment: 'jsdom' } };\n",
+		"packages/web/src/app.test.ts": `import { describe, it, expect } from 'vitest';
+
+describe('app', () => {
+  it('works', () => {
+    expect(true).toBe(true);
+  });
+});
+`,
+		"packages/legacy/old.test.ts": `import { describe, it, expect } from 'vitest';
+
+describe('old', () => {
+  it('works', () => {
+    expect(true).toBe(true);
+  });
+});
+`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+	for _, scanErr := range result.Errors {
+		t.Errorf("unexpected scan error: %v", scanErr)
+	}
+
+	projects := make(map[string]string)
+	for _, file := range result.Inventory.Files {
+		projects[filepath.ToSlash(file.Path)] = file.Project
+	}
+
+	// Projects are named by package.json, then by test.name of their own config;
+	// packages/legacy is negated in the workspace.
+	expected := map[string]string{
+		"packages/api/src/api.test.ts": "@acme/api",
+		"packages/web/src/app.test.ts": "web-ui",
+		"packages/legacy/old.test.ts":  "",
+	}
+	if !reflect.DeepEqual(projects, expected) {
+		t.Errorf("expected projects %v, got %v", expected, projects)
+	}
+}
+
+func TestScan_JestProjects(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"package.json":               `{"name": "acme", "private": true, "jest": {"projects": ["<rootDir>/packages/*"]}}`,
+		"packages/core/package.json": `{"name": "@acme/core", "jest": {"displayName": "core-unit"}}`,
+		"packages/core/src/core.test.js": `const { describe, it, expect } = require('@jest/globals');
+
+describe('core', () => {
+  it('works', () => {
+    expect(jest.fn()).toBeDefined();
+  });
+});
+`,
+		"packages/cli/package.json": `{"name": "@acme/cli"}`,
+		"packages/cli/cli.test.js": `const { describe, it, expect } = require('@jest/globals');
+
+describe('cli', () => {
+  it('works', () => {
+    expect(jest.fn()).toBeDefined();
+  });
+});
+`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+	for _, scanErr := range result.Errors {
+		t.Errorf("unexpected scan error: %v", scanErr)
+	}
+
+	projects := make(map[string]string)
+	for _, file := range result.Inventory.Files {
+		if file.Framework != "jest" {
+			t.Errorf("%s: expected framework 'jest', got %q", file.Path, file.Framework)
+		}
+		projects[filepath.ToSlash(file.Path)] = file.Project
+	}
+
+	// core names itself with displayName; cli has no Jest config and takes its package name.
+	expected := map[string]string{
+		"packages/core/src/core.test.js": "core-unit",
+		"packages/cli/cli.test.js":       "@acme/cli",
+	}
+	if !reflect.DeepEqual(projects, expected) {
+		t.Errorf("expected projects %v, got %v", expected, projects)
+	}
+}
+
+func TestScan_JSCustomTestGlobs(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"web/vitest.config.ts": `import { defineConfig } from 'vitest/config';
+
+export default defineConfig({
+  test: { include: ['**/*.e2e.ts'] },
+});
+`,
+		"lint/vitest.workspace.ts": `export default [
+  { test: { name: 'lint', include: ['tools/**/*.lint.ts'] } },
+];
+`,
+		"lint/tools/lint/rule.lint.ts": `import { describe, it, expect } from 'vitest';
+
+describe('rule', () => {
+  it('reports', () => {
+    expect(true).toBe(true);
+  });
+});
+`,
+		"web/app/login.e2e.ts": `import { describe, it, expect } from 'vitest';
+
+describe('login', () => {
+  it('signs in', () => {
+    expect(true).toBe(true);
+  });
+});
+`,
+		"api/jest.config.js": `module.exports = {
+  testMatch: ['<rootDir>/tools/**/*.check.js'],
+  testRegex: 'probes/.*\\.probe\\.js$',
+};
+`,
+		"api/tools/lint.check.js": `describe('lint', () => {
+  it('passes', () => {
+    expect(true).toBe(true);
+  });
+});
+`,
+		"api/probes/health.probe.js": `describe('health', () => {
+  it('responds', () => {
+    expect(true).toBe(true);
+  });
+});
+`,
+		"api/tools/helpers.js": `module.exports = {};
+`,
+		"cli/jest.config.js": `module.exports = {
+  projects: [
+    { displayName: 'lint', testMatch: ['<rootDir>/tools/**/*.check.js'] },
+    { displayName: 'unit' },
+  ],
+};
+`,
+		"cli/tools/lint.check.js": `describe('lint', () => {
+  it('passes', () => {
+    expect(true).toBe(true);
+  });
+});
+`,
+		"cli/src/args.test.js": `describe('args', () => {
+  it('parses', () => {
+    expect(true).toBe(true);
+  });
+});
+`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+	for _, scanErr := range result.Errors {
+		t.Errorf("unexpected scan error: %v", scanErr)
+	}
+
+	frameworks := make(map[string]string)
+	for _, file := range result.Inventory.Files {
+		frameworks[filepath.ToSlash(file.Path)] = file.Framework
+	}
+
+	// The configs name these files as tests, though no naming convention does.
+	expected := map[string]string{
+		"web/app/login.e2e.ts":         "vitest",
+		"lint/tools/lint/rule.lint.ts": "vitest",
+		"api/tools/lint.check.js":      "jest",
+		"api/probes/health.probe.js":   "jest",
+		"cli/tools/lint.check.js":      "jest",
+		"cli/src/args.test.js":         "jest",
+	}
+	if !reflect.DeepEqual(frameworks, expected) {
+		t.Errorf("expected files %v, got %v", expected, frameworks)
+	}
+}
+
+func TestScan_JVMBuild(t *testing.T) {
+	javaTest := func(class string, body string) string {
+		return "package com.example;\n\nimport org.junit.jupiter.api.*;\n\nclass " + class + " {\n" + body + "}\n"
+	}
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"shop/pom.xml": `<project>
+  <build>
+    <plugins>
+      <plugin>
+        <artifactId>maven-surefire-plugin</artifactId>
+        <configuration><excludes><exclude>**/*IT.java</exclude></excludes></configuration>
+      </plugin>
+      <plugin><artifactId>maven-failsafe-plugin</artifactId></plugin>
+    </plugins>
+  </build>
+</project>
+`,
+		"shop/src/test/java/com/example/OrderTest.java": javaTest("OrderTest", "    @Test\n    void places() {}\n"),
+		"shop/src/test/java/com/example/OrderIT.java":   javaTest("OrderIT", "    @Test\n    void persists() {}\n"),
+		"shop/src/test/java/com/example/OrderSpec.java": javaTest("OrderSpec", "    @Test\n    void ignored() {}\n"),
+		"cart/build.gradle": `sourceSets {
+    integrationTest {
+        java.srcDirs = ['src/it/java']
+    }
+}
+
+test {
+    useJUnitPlatform {
+        excludeTags 'slow'
+    }
+}
+`,
+		"cart/src/test/java/com/example/CartTest.java":   javaTest("CartTest", "    @Test\n    void adds() {}\n\n    @Test\n    @Tag(\"slow\")\n    void recalculates() {}\n"),
+		"cart/src/it/java/com/example/CheckoutFlow.java": javaTest("CheckoutFlow", "    @Test\n    void checksOut() {}\n\n    @Test\n    @Tag(\"slow\")\n    void settles() {}\n"),
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+	for _, scanErr := range result.Errors {
+		t.Errorf("unexpected scan error: %v", scanErr)
+	}
+
+	got := make(map[string][]string)
+	for _, file := range result.Inventory.Files {
+		for _, suite := range file.Suites {
+			for _, test := range suite.Tests {
+				got[filepath.ToSlash(file.Path)] = append(got[filepath.ToSlash(file.Path)], test.Name+":"+string(test.Status)+":"+string(test.Kind))
+			}
+		}
+	}
+
+	// OrderSpec matches no Surefire include; CheckoutFlow is only a test through its source
+	// set, whose tests the test task's tag filter does not run.
+	expected := map[string][]string{
+		"shop/src/test/java/com/example/OrderTest.java":  {"places:active:"},
+		"shop/src/test/java/com/example/OrderIT.java":    {"persists:active:integration"},
+		"cart/src/test/java/com/example/CartTest.java":   {"adds:active:", "recalculates:excluded:"},
+		"cart/src/it/java/com/example/CheckoutFlow.java": {"checksOut:active:integration", "settles:active:integration"},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected tests %v, got %v", expected, got)
+	}
+}
+
+func TestScan_DotNetProjects(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"Shop.sln": `Microsoft Visual Studio Solution File, Format Version 12.00
+Project("{9A19103F-16F7-4668-BE54-9A1E7A4F7556}") = "Shop", "src\\Shop\\Shop.csproj", "{11111111-1111-1111-1111-111111111111}"
+EndProject
+Project("{9A19103F-16F7-4668-BE54-9A1E7A4F7556}") = "Shop.Verification", "verification\\Shop.Verification\\Shop.Verification.csproj", "{22222222-2222-2222-2222-222222222222}"
+EndProject
+`,
+		"ci.runsettings": `<RunSettings>
+  <RunConfiguration><TestCaseFilter>Category!=Slow</TestCaseFilter></RunConfiguration>
+</RunSettings>
+`,
+		"src/Shop/Shop.csproj": `<Project Sdk="Microsoft.NET.Sdk"></Project>`,
+		"src/Shop/Tests/SelfCheckTests.cs": `using NUnit.Framework;
+
+public class SelfCheckTests
+{
+    [Test]
+    public void Runs() { }
+}
+`,
+		"verification/Shop.Verification/Shop.Verification.csproj": `<Project Sdk="Microsoft.NET.Sdk">
+  <ItemGroup>
+    <PackageReference Include="Microsoft.NET.Test.Sdk" Version="17.8.0" />
+    <PackageReference Include="xunit" Version="2.6.2" />
+    <Using Include="Xunit" />
+  </ItemGroup>
+</Project>
+`,
+		"verification/Shop.Verification/Orders/OrderFlow.cs": `namespace Shop.Verification.Orders;
+
+public class OrderFlow
+{
+    [Fact]
+    public void Places() { }
+
+    [Fact]
+    [Trait("Category", "Slow")]
+    public void Recalculates() { }
+}
+`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+	for _, scanErr := range result.Errors {
+		t.Errorf("unexpected scan error: %v", scanErr)
+	}
+
+	got := make(map[string][]string)
+	for _, file := range result.Inventory.Files {
+		path := filepath.ToSlash(file.Path)
+		for _, suite := range file.Suites {
+			for _, test := range suite.Tests {
+				got[path] = append(got[path], file.Framework+":"+file.Project+":"+test.Name+":"+string(test.Status))
+			}
+		}
+	}
+
+	// SelfCheckTests belongs to a production project; OrderFlow is only a test through
+	// its project, which also picks its framework.
+	expected := map[string][]string{
+		"verification/Shop.Verification/Orders/OrderFlow.cs": {
+			"xunit:Shop.Verification:Places:active",
+			"xunit:Shop.Verification:Recalculates:excluded",
+		},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected tests %v, got %v", expected, got)
+	}
+}
+
+func TestScan_DotNetProjectHelpers(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"tests/Shop.Tests/Shop.Tests.csproj": `<Project Sdk="Microsoft.NET.Sdk">
+  <ItemGroup>
+    <PackageReference Include="Microsoft.NET.Test.Sdk" Version="17.8.0" />
+    <PackageReference Include="xunit" Version="2.6.2" />
+  </ItemGroup>
+</Project>
+`,
+		"tests/Shop.Tests/GlobalUsings.cs": `global using Xunit;
+`,
+		"tests/Shop.Tests/Helpers.cs": `namespace Shop.Tests;
+
+public static class Helpers
+{
+    public static decimal Total(params decimal[] prices) => prices.Sum();
+}
+`,
+		"tests/Shop.Tests/Fixtures/DbFixture.cs": `namespace Shop.Tests.Fixtures;
+
+public class DbFixture : IDisposable
+{
+    public void Dispose() { }
+}
+`,
+		"tests/Shop.Tests/OrderTests.cs": `namespace Shop.Tests;
+
+public class OrderTests
+{
+    [Fact]
+    public void Totals() { }
+}
+`,
+		"tests/Shop.Tests/RefundTests.cs": `namespace Shop.Tests;
+
+public class RefundTests
+{
+}
+`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+	for _, scanErr := range result.Errors {
+		t.Errorf("unexpected scan error: %v", scanErr)
+	}
+
+	var paths []string
+	for _, file := range result.Inventory.Files {
+		paths = append(paths, filepath.ToSlash(file.Path))
+	}
+	sort.Strings(paths)
+
+	// Helpers without tests are not test files just because their project is a test
+	// project; a file named as a test still is.
+	expected := []string{"tests/Shop.Tests/OrderTests.cs", "tests/Shop.Tests/RefundTests.cs"}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("expected files %v, got %v", expected, paths)
+	}
+}
+
+func TestScan_CargoWorkspace(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"Cargo.toml": `[workspace]
+members = ["crates/*"]
+`,
+		"crates/core/Cargo.toml": `[package]
+name = "shop-core"
+
+[dev-dependencies]
+libtest-mimic = "0.7"
+
+[[test]]
+name = "fixtures"
+harness = false
+`,
+		"crates/core/src/lib.rs": `pub fn add(a: i32, b: i32) -> i32 { a + b }
+
+#[cfg(test)]
+mod tests {
+    #[test]
+    fn adds() {}
+}
+`,
+		"crates/core/tests/fixtures.rs": `use libtest_mimic::{Arguments, Trial};
+
+fn main() {
+    let tests = vec![Trial::test("parses_orders", parses_orders)];
+    libtest_mimic::run(&Arguments::from_args(), tests).exit();
+}
+`,
+		"crates/core/src/util.rs": `pub fn double(x: i32) -> i32 { x * 2 }
+`,
+		"crates/core/examples/demo.rs": `#[test]
+fn demo() {}
+`,
+		"tools/src/gen.rs": `#[test]
+fn generates() {}
+`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+	for _, scanErr := range result.Errors {
+		t.Errorf("unexpected scan error: %v", scanErr)
+	}
+
+	got := make(map[string][]string)
+	for _, file := range result.Inventory.Files {
+		path := filepath.ToSlash(file.Path)
+		got[path] = nil
+		for _, suite := range file.Suites {
+			for _, test := range suite.Tests {
+				got[path] = append(got[path], file.Project+":"+suite.Name+"/"+test.Name)
+			}
+		}
+	}
+
+	// Examples are no test target, tools/ belongs to no workspace member, and sources
+	// without inline tests are no test files.
+	expected := map[string][]string{
+		"crates/core/src/lib.rs":        {"shop-core:tests/adds"},
+		"crates/core/tests/fixtures.rs": {"shop-core:fixtures/parses_orders"},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected tests %v, got %v", expected, got)
+	}
+}
+
+func TestScan_GoWorkspace(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"go.work": `go 1.24
+
+use (
+	./shop
+	./tools
+)
+`,
+		"shop/go.mod": "module example.com/shop\n\ngo 1.24\n",
+		"shop/shop_test.go": `package shop
+
+import "testing"
+
+func TestOpen(t *testing.T) {}
+`,
+		"shop/orders/orders_test.go": `package orders_test
+
+import "testing"
+
+func TestCheckout(t *testing.T) {}
+`,
+		"shop/plugins/go.mod": "module example.com/shop/plugins/v2\n",
+		"shop/plugins/stripe/stripe_test.go": `package stripe
+
+import "testing"
+
+func TestCharge(t *testing.T) {}
+`,
+		"tools/go.mod": "module example.com/tools\n",
+		"tools/lint/lint_test.go": `package lint
+
+import "testing"
+
+func TestLint(t *testing.T) {}
+`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+	for _, scanErr := range result.Errors {
+		t.Errorf("unexpected scan error: %v", scanErr)
+	}
+
+	type goFile struct {
+		project  string
+		pkg      string
+		external bool
+	}
+	got := make(map[string]goFile)
+	for _, file := range result.Inventory.Files {
+		got[filepath.ToSlash(file.Path)] = goFile{file.Project, file.Package, file.ExternalPackage}
+	}
+
+	// The nested plugins module is a module of its own: its tests get its import path
+	// and, as it is no workspace member, no project.
+	expected := map[string]goFile{
+		"shop/shop_test.go":                  {"example.com/shop", "example.com/shop", false},
+		"shop/orders/orders_test.go":         {"example.com/shop", "example.com/shop/orders", true},
+		"shop/plugins/stripe/stripe_test.go": {"", "example.com/shop/plugins/v2/stripe", false},
+		"tools/lint/lint_test.go":            {"example.com/tools", "example.com/tools/lint", false},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected files %v, got %v", expected, got)
+	}
+}
+
+func TestScan_Robot(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"atest/__init__.robot": `*** Settings ***
+Name         Acceptance
+Test Tags    qa
+`,
+		"atest/login.robot": `*** Settings ***
+Resource    resources/common.robot
+
+*** Test Cases ***
+Valid Login
+    Open Login Page
+
+Flaky Login
+    [Tags]    robot:skip
+    Open Login Page
+`,
+		"atest/resources/common.robot": `*** Keywords ***
+Open Login Page
+    Go To    ${URL}
+`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+
+	if len(result.Inventory.Files) != 1 {
+		t.Fatalf("expected 1 file, got %d", len(result.Inventory.Files))
+	}
+	file := result.Inventory.Files[0]
+	if file.Framework != "robot-framework" {
+		t.Errorf("expected framework robot-framework, got %q", file.Framework)
+	}
+	if len(file.Suites) != 1 || file.Suites[0].Name != "Acceptance" {
+		t.Fatalf("expected directory suite named by __init__.robot, got %+v", file.Suites)
+	}
+	initLocation := domain.Location{File: "atest/__init__.robot", StartLine: 1, EndLine: 3}
+	if file.Suites[0].Location != initLocation {
+		t.Errorf("expected directory suite located in %+v, got %+v", initLocation, file.Suites[0].Location)
+	}
+	tests := file.Suites[0].Suites[0].Tests
+	if len(tests) != 2 {
+		t.Fatalf("expected 2 tests, got %d", len(tests))
+	}
+	if len(tests[0].Tags) != 1 || tests[0].Tags[0] != "qa" {
+		t.Errorf("expected inherited qa tag, got %v", tests[0].Tags)
+	}
+	if tests[1].Status != domain.TestStatusSkipped {
+		t.Errorf("expected robot:skip test to be skipped, got %s", tests[1].Status)
+	}
+}
+
+func TestScan_TerraformTest(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"infra/main.tf": `resource "aws_s3_bucket" "bucket" {
+  bucket = "${var.bucket_prefix}-bucket"
+}
+`,
+		"infra/tests/bucket.tftest.hcl": `run "setup" {
+  module {
+    source = "./tests/setup"
+  }
+}
+
+run "valid_name" {
+  command = plan
+
+  assert {
+    condition     = aws_s3_bucket.bucket.bucket == "test-bucket"
+    error_message = "wrong name"
+  }
+}
+
+run "invalid_name" {
+  command = plan
+  expect_failures = [var.bucket_prefix]
+}
+`,
+		"infra/terragrunt.hcl": `terraform {
+  source = "./modules/app"
+}
+`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+
+	if len(result.Inventory.Files) != 1 {
+		t.Fatalf("expected 1 file, got %d", len(result.Inventory.Files))
+	}
+	file := result.Inventory.Files[0]
+	if file.Framework != "terraform-test" {
+		t.Errorf("expected framework terraform-test, got %q", file.Framework)
+	}
+	if file.Language != "hcl" {
+		t.Errorf("expected language hcl, got %q", file.Language)
+	}
+	if file.CountTests() != 3 {
+		t.Errorf("expected 3 runs, got %d", file.CountTests())
+	}
+	if file.DomainHints == nil || len(file.DomainHints.Imports) != 1 || file.DomainHints.Imports[0] != "./tests/setup" {
+		t.Errorf("expected module source as import hint, got %+v", file.DomainHints)
+	}
+}
+
+func TestScan_Quick(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"AppTests/DolphinSpec.swift": `import Quick
+import Nimble
+@testable import App
+
+final class DolphinSpec: QuickSpec {
+    override class func spec() {
+        describe("a dolphin") {
+            it("is friendly") {
+                expect(Dolphin().isFriendly).to(beTrue())
+            }
+
+            context("when clicked") {
+                fit("emits a click") { }
+                xit("is loud") { }
+            }
+        }
+    }
+}
+`,
+		"AppTests/DolphinTests.swift": `import XCTest
+import Nimble
+@testable import App
+
+final class DolphinTests: XCTestCase {
+    func testSwims() {
+        expect(Dolphin().canSwim).to(beTrue())
+    }
+}
+`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+
+	if len(result.Inventory.Files) != 2 {
+		t.Fatalf("expected 2 files, got %d", len(result.Inventory.Files))
+	}
+	for _, file := range result.Inventory.Files {
+		switch filepath.Base(file.Path) {
+		case "DolphinSpec.swift":
+			if file.Framework != "quick" {
+				t.Errorf("expected framework quick, got %q", file.Framework)
+			}
+			if file.CountTests() != 3 {
+				t.Errorf("expected 3 tests, got %d", file.CountTests())
+			}
+		case "DolphinTests.swift":
+			if file.Framework != "xctest" {
+				t.Errorf("expected framework xctest for Nimble with XCTest, got %q", file.Framework)
+			}
+		}
+	}
+}
+
+func TestScan_Pest(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"app/composer.json": `{"require-dev": {"pestphp/pest": "^3.0"}}`,
+		"app/tests/Pest.php": `<?php
+
+pest()->extend(Tests\TestCase::class)->in('Feature');
+`,
+		"app/tests/Feature/ExampleTest.php": `<?php
+
+namespace Tests\Feature;
+
+use Tests\TestCase;
+
+class ExampleTest extends TestCase
+{
+    public function test_the_application_returns_a_successful_response(): void
+    {
+        $this->get('/')->assertStatus(200);
+    }
+}
+`,
+		"app/tests/Unit/SumTest.php": `<?php
+
+it('adds numbers', function (int $a, int $b) {
+    expect($a + $b)->toBeInt();
+})->with([[1, 2], [3, 4]]);
+
+test('is pending');
+`,
+		"legacy/composer.json": `{"require-dev": {"phpunit/phpunit": "^11.0"}}`,
+		"legacy/tests/UserTest.php": `<?php
+
+namespace Tests;
+
+class UserTest extends TestCase
+{
+    public function testCreate()
+    {
+        $this->assertTrue(true);
+    }
+}
+`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+	if len(result.Errors) != 0 {
+		t.Errorf("expected no scan errors, got %v", result.Errors)
+	}
+
+	byPath := make(map[string]string)
+	counts := make(map[string]int)
+	for _, file := range result.Inventory.Files {
+		byPath[filepath.ToSlash(file.Path)] = file.Framework
+		counts[filepath.ToSlash(file.Path)] = file.CountTests()
+	}
+
+	expected := map[string]string{
+		"app/tests/Feature/ExampleTest.php": "pest",
+		"app/tests/Unit/SumTest.php":        "pest",
+		"legacy/tests/UserTest.php":         "phpunit",
+	}
+	for path, want := range expected {
+		if got := byPath[path]; got != want {
+			t.Errorf("%s: expected framework %q, got %q", path, want, got)
+		}
+	}
+	if _, ok := byPath["app/tests/Pest.php"]; ok {
+		t.Error("expected the Pest.php bootstrap file not to be a test file")
+	}
+	if counts["app/tests/Unit/SumTest.php"] != 3 {
+		t.Errorf("expected 3 Pest tests, got %d", counts["app/tests/Unit/SumTest.php"])
+	}
+	if counts["app/tests/Feature/ExampleTest.php"] != 1 {
+		t.Errorf("expected 1 class-based test, got %d", counts["app/tests/Feature/ExampleTest.php"])
+	}
+}
+
+func TestScan_PestPHPUnitSuites(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"composer.json": `{"require-dev": {"pestphp/pest": "^3.0"}}`,
+		"phpunit.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<phpunit bootstrap="vendor/autoload.php">
+    <testsuites>
+        <testsuite name="Feature">
+            <directory>tests/Feature</directory>
+        </testsuite>
+        <testsuite name="Unit">
+            <directory>tests/Unit</directory>
+        </testsuite>
+    </testsuites>
+</phpunit>
+`,
+		"tests/Feature/LoginTest.php": `<?php
+
+it('logs in', function () {
+    $this->post('/login')->assertRedirect('/home');
+});
+`,
+		"tests/Unit/SumTest.php": `<?php
+
+test('adds numbers', function () {
+    expect(1 + 2)->toBe(3);
+});
+`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+	for _, scanErr := range result.Errors {
+		t.Errorf("unexpected scan error: %v", scanErr)
+	}
+
+	got := make(map[string]string)
+	for _, file := range result.Inventory.Files {
+		got[filepath.ToSlash(file.Path)] = file.Framework + ":" + file.Project
+	}
+
+	// Pest runs the phpunit.xml testsuites, so they are the projects of its files.
+	expected := map[string]string{
+		"tests/Feature/LoginTest.php": "pest:Feature",
+		"tests/Unit/SumTest.php":      "pest:Unit",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected files %v, got %v", expected, got)
+	}
+}
+
+func TestScan_Codeception(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"codeception.yml": `namespace: Tests
+paths:
+    tests: tests
+    output: tests/_output
+`,
+		"tests/Acceptance/LoginCest.php": `<?php
+
+namespace Tests\Acceptance;
+
+use Tests\Support\AcceptanceTester;
+
+class LoginCest
+{
+    public function _before(AcceptanceTester $I) {}
+
+    public function tryToLogin(AcceptanceTester $I)
+    {
+        $I->amOnPage('/login');
+    }
+
+    public function tryToLogout(AcceptanceTester $I) {}
+}
+`,
+		"tests/Acceptance/SigninCept.php": `<?php
+$I = new AcceptanceTester($scenario);
+$I->wantTo('sign in');
+`,
+		"tests/Unit/UserTest.php": `<?php
+
+namespace Tests\Unit;
+
+class UserTest extends \Codeception\Test\Unit
+{
+    public function testValidation() {}
+}
+`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+
+	if len(result.Inventory.Files) != 3 {
+		t.Fatalf("expected 3 files, got %d", len(result.Inventory.Files))
+	}
+	total := 0
+	for _, file := range result.Inventory.Files {
+		if file.Framework != "codeception" {
+			t.Errorf("%s: expected framework codeception, got %q", file.Path, file.Framework)
+		}
+		total += file.CountTests()
+	}
+	if total != 4 {
+		t.Errorf("expected 4 tests, got %d", total)
+	}
+}
+
 func TestScan_PHPUnit(t *testing.T) {
 	t.Run("should scan PHP PHPUnit files", func(t *testing.T) {
 		tmpDir := t.TempDir()
@@ -805,6 +2479,89 @@ class NotATest
 	})
 }
 
+func TestScan_PHPUnitConfig(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"phpunit.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<phpunit bootstrap="vendor/autoload.php">
+    <testsuites>
+        <testsuite name="Unit">
+            <directory suffix="Spec.php">tests/Unit</directory>
+            <exclude>tests/Unit/Fixtures</exclude>
+        </testsuite>
+    </testsuites>
+</phpunit>
+`,
+		"tests/Unit/MoneySpec.php": `<?php
+use PHPUnit\\Framework\\TestCase;
+
+class MoneySpec extends TestCase
+{
+    public function testWorks(): void
+    {
+        $this->assertTrue(true);
+    }
+}
+`,
+		"tests/Unit/LegacyTest.php": `<?php
+use PHPUnit\\Framework\\TestCase;
+
+class LegacyTest extends TestCase
+{
+    public function testWorks(): void
+    {
+        $this->assertTrue(true);
+    }
+}
+`,
+		"tests/Unit/Fixtures/FakeSpec.php": `<?php
+use PHPUnit\\Framework\\TestCase;
+
+class FakeSpec extends TestCase
+{
+    public function testWorks(): void
+    {
+        $this->assertTrue(true);
+    }
+}
+`,
+		"tests/Integration/DatabaseTest.php": `<?php
+use PHPUnit\\Framework\\TestCase;
+
+class DatabaseTest extends TestCase
+{
+    public function testWorks(): void
+    {
+        $this->assertTrue(true);
+    }
+}
+`,
+	}
+	writeFiles(t, tmpDir, files)
+
+	result := scanDir(t, tmpDir)
+	for _, scanErr := range result.Errors {
+		t.Errorf("unexpected scan error: %v", scanErr)
+	}
+
+	var paths []string
+	for _, file := range result.Inventory.Files {
+		if file.Framework != "phpunit" {
+			t.Errorf("%s: expected framework 'phpunit', got %q", file.Path, file.Framework)
+		}
+		paths = append(paths, filepath.ToSlash(file.Path))
+	}
+	sort.Strings(paths)
+
+	// The Unit suite collects *Spec.php only and excludes Fixtures; files outside
+	// the suite directories keep the naming conventions.
+	expected := []string{"tests/Integration/DatabaseTest.php", "tests/Unit/MoneySpec.php"}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("expected files %v, got %v", expected, paths)
+	}
+}
+
 func TestScan_SymlinkSkipping(t *testing.T) {
 	t.Run("should skip symlinked test files", func(t *testing.T) {
 		tmpDir := t.TempDir()
@@ -1313,3 +3070,35 @@ func TestSomething(t *testing.T) {
 		}
 	})
 }
+
+// writeFiles writes files, keyed by slash-separated paths relative to dir.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create dir for %s: %v", name, err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+}
+
+// scanDir scans dir with the given options and fails the test if the scan fails.
+func scanDir(t *testing.T, dir string, opts ...parser.ScanOption) *parser.ScanResult {
+	t.Helper()
+
+	src, err := source.NewLocalSource(dir)
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+	defer src.Close()
+
+	result, err := parser.Scan(context.Background(), src, opts...)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return result
+}
@@ -3,8 +3,19 @@
 package all
 
 import (
+	_ "github.com/specvital/core/pkg/parser/strategies/bats"
+	_ "github.com/specvital/core/pkg/parser/strategies/boosttest"
+	_ "github.com/specvital/core/pkg/parser/strategies/busted"
 	_ "github.com/specvital/core/pkg/parser/strategies/cargotest"
+	_ "github.com/specvital/core/pkg/parser/strategies/catch2"
+	_ "github.com/specvital/core/pkg/parser/strategies/check"
+	_ "github.com/specvital/core/pkg/parser/strategies/cmocka"
+	_ "github.com/specvital/core/pkg/parser/strategies/codeception"
+	_ "github.com/specvital/core/pkg/parser/strategies/cunit"
 	_ "github.com/specvital/core/pkg/parser/strategies/cypress"
+	_ "github.com/specvital/core/pkg/parser/strategies/doctest"
+	_ "github.com/specvital/core/pkg/parser/strategies/exunit"
+	_ "github.com/specvital/core/pkg/parser/strategies/gherkin"
 	_ "github.com/specvital/core/pkg/parser/strategies/gotesting"
 	_ "github.com/specvital/core/pkg/parser/strategies/gtest"
 	_ "github.com/specvital/core/pkg/parser/strategies/jest"
@@ -14,14 +25,23 @@ import (
 	_ "github.com/specvital/core/pkg/parser/strategies/minitest"
 	_ "github.com/specvital/core/pkg/parser/strategies/mocha"
 	_ "github.com/specvital/core/pkg/parser/strategies/mstest"
+	_ "github.com/specvital/core/pkg/parser/strategies/munit"
 	_ "github.com/specvital/core/pkg/parser/strategies/nunit"
+	_ "github.com/specvital/core/pkg/parser/strategies/pest"
 	_ "github.com/specvital/core/pkg/parser/strategies/phpunit"
 	_ "github.com/specvital/core/pkg/parser/strategies/playwright"
 	_ "github.com/specvital/core/pkg/parser/strategies/pytest"
+	_ "github.com/specvital/core/pkg/parser/strategies/quick"
+	_ "github.com/specvital/core/pkg/parser/strategies/robot"
 	_ "github.com/specvital/core/pkg/parser/strategies/rspec"
+	_ "github.com/specvital/core/pkg/parser/strategies/scalatest"
+	_ "github.com/specvital/core/pkg/parser/strategies/specs2"
+	_ "github.com/specvital/core/pkg/parser/strategies/spock"
 	_ "github.com/specvital/core/pkg/parser/strategies/swift-testing"
+	_ "github.com/specvital/core/pkg/parser/strategies/terraformtest"
 	_ "github.com/specvital/core/pkg/parser/strategies/testng"
 	_ "github.com/specvital/core/pkg/parser/strategies/unittest"
+	_ "github.com/specvital/core/pkg/parser/strategies/unity"
 	_ "github.com/specvital/core/pkg/parser/strategies/vitest"
 	_ "github.com/specvital/core/pkg/parser/strategies/xctest"
 	_ "github.com/specvital/core/pkg/parser/strategies/xunit"
@@ -0,0 +1,207 @@
+// Package bats implements Bats (Bash Automated Testing System) support for shell test files.
+package bats
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+	"github.com/specvital/core/pkg/parser/framework"
+	"github.com/specvital/core/pkg/parser/strategies/shared/batsfile"
+)
+
+const frameworkName = framework.FrameworkBats
+
+// Bash AST node types.
+const (
+	nodeCommand            = "command"
+	nodeCommandName        = "command_name"
+	nodeComment            = "comment"
+	nodeCompoundStatement  = "compound_statement"
+	nodeFunctionDefinition = "function_definition"
+	nodeWord               = "word"
+)
+
+const (
+	keywordSkip = "skip"
+	tagFocus    = "bats:focus"
+)
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageShell},
+		Matchers: []framework.Matcher{
+			&BatsFileMatcher{},
+			&BatsContentMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &BatsParser{},
+		Priority:     framework.PriorityGeneric,
+	}
+}
+
+// BatsFileMatcher matches *.bats files, which only Bats can run.
+type BatsFileMatcher struct{}
+
+func (m *BatsFileMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileName {
+		return framework.NoMatch()
+	}
+
+	if strings.HasSuffix(signal.Value, ".bats") {
+		return framework.DefiniteMatch("Bats test file extension: *.bats")
+	}
+
+	return framework.NoMatch()
+}
+
+// BatsContentMatcher matches Bats-specific patterns in file content.
+type BatsContentMatcher struct{}
+
+var batsPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`^#!.*\bbats\b`), "bats shebang"},
+	{regexp.MustCompile(`(?m)^\s*@test\s+\S.*\{\s*$`), "@test block"},
+}
+
+func (m *BatsContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range batsPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found Bats pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+// BatsParser extracts @test blocks from Bats files. Bats has no grouping, so every
+// test is a top-level test of the file. A test whose body starts with skip is skipped;
+// setup/teardown hooks and helper functions are ignored.
+//
+// The file is preprocessed the way Bats does it, so that the blocks parse as Bash
+// functions; the blocks themselves are found line by line, so a test whose body the
+// grammar misreads is still reported.
+type BatsParser struct{}
+
+func (p *BatsParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	rewritten, blocks := batsfile.Preprocess(source)
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageShell, rewritten)
+	if err != nil {
+		return nil, fmt.Errorf("bats parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	file := &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageShell,
+		Framework: frameworkName,
+	}
+
+	bodies := testBodies(tree.RootNode(), rewritten)
+	for _, block := range blocks {
+		file.Tests = append(file.Tests, newTest(block, bodies[block.Function], rewritten, filename))
+	}
+
+	return file, nil
+}
+
+// testBodies returns the bodies of the functions @test blocks were rewritten to, by
+// function name.
+func testBodies(root *sitter.Node, source []byte) map[string]*sitter.Node {
+	bodies := make(map[string]*sitter.Node)
+	parser.WalkTree(root, func(node *sitter.Node) bool {
+		if node.Type() != nodeFunctionDefinition {
+			return true
+		}
+		name := node.ChildByFieldName("name")
+		if name == nil || name.Type() != nodeWord {
+			return true
+		}
+		if function := parser.GetNodeText(name, source); strings.HasPrefix(function, batsfile.FunctionPrefix) {
+			if body := node.ChildByFieldName("body"); body != nil && body.Type() == nodeCompoundStatement {
+				bodies[function] = body
+			}
+		}
+		return true
+	})
+	return bodies
+}
+
+func newTest(block batsfile.TestBlock, body *sitter.Node, source []byte, filename string) domain.Test {
+	test := domain.Test{
+		Name:   block.Name,
+		Status: domain.TestStatusActive,
+		Location: domain.Location{
+			File:      filename,
+			StartLine: block.Line,
+			EndLine:   block.EndLine,
+			StartCol:  block.Column,
+			EndCol:    block.EndColumn,
+		},
+		Tags: block.Tags,
+	}
+	switch {
+	case body != nil && firstCommand(body, source) == keywordSkip:
+		test.Status = domain.TestStatusSkipped
+		test.Modifier = keywordSkip
+	case containsTag(block.Tags, tagFocus):
+		test.Status = domain.TestStatusFocused
+		test.Modifier = tagFocus
+	}
+	return test
+}
+
+// firstCommand returns the name of the first statement of a body if it is a simple
+// command, or "" otherwise.
+func firstCommand(body *sitter.Node, source []byte) string {
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		stmt := body.NamedChild(i)
+		if stmt.Type() == nodeComment {
+			continue
+		}
+		return commandName(stmt, source)
+	}
+	return ""
+}
+
+// commandName returns the name of a simple command, or "" for other statements.
+func commandName(node *sitter.Node, source []byte) string {
+	if node.Type() != nodeCommand {
+		return ""
+	}
+	name := node.ChildByFieldName("name")
+	if name == nil || name.Type() != nodeCommandName {
+		return ""
+	}
+	return parser.GetNodeText(name, source)
+}
+
+func containsTag(tags []string, want string) bool {
+	for _, tag := range tags {
+		if tag == want {
+			return true
+		}
+	}
+	return false
+}
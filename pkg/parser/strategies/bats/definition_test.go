@@ -0,0 +1,230 @@
+package bats
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+func TestBatsParser_Parse(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		checkFunc func(t *testing.T, file *domain.TestFile)
+	}{
+		{
+			name: "tests, hooks and skip",
+			source: `#!/usr/bin/env bats
+
+setup_file() {
+  export FOO=1
+}
+
+teardown() {
+  rm -rf "$BATS_TMPDIR/out"
+}
+
+@test "addition using bc" {
+  result="$(echo 2+2 | bc)"
+  [ "$result" -eq 4 ]
+}
+
+@test 'not ready yet' {
+  # pending the new CLI
+  skip "not ready"
+  run deploy --dry-run
+}
+
+@test "skips later" {
+  run deploy
+  skip
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 0 {
+					t.Errorf("expected no suites, got %d", len(file.Suites))
+				}
+				if len(file.Tests) != 3 {
+					t.Fatalf("expected 3 tests, got %d", len(file.Tests))
+				}
+				first := file.Tests[0]
+				if first.Name != "addition using bc" || first.Status != domain.TestStatusActive {
+					t.Errorf("unexpected first test %q (%s)", first.Name, first.Status)
+				}
+				if first.Location.StartLine != 11 || first.Location.EndLine != 14 {
+					t.Errorf("expected lines 11-14, got %d-%d", first.Location.StartLine, first.Location.EndLine)
+				}
+				second := file.Tests[1]
+				if second.Name != "not ready yet" || second.Status != domain.TestStatusSkipped || second.Modifier != "skip" {
+					t.Errorf("expected skipped test, got %q (%s/%s)", second.Name, second.Status, second.Modifier)
+				}
+				if file.Tests[2].Status != domain.TestStatusActive {
+					t.Errorf("expected skip after other statements to stay active, got %q", file.Tests[2].Status)
+				}
+			},
+		},
+		{
+			name: "tags and focus",
+			source: `# bats file_tags=infra
+
+# bats test_tags=smoke, fast
+@test "smoke" { run ./bin/app status; }
+
+# bats test_tags=bats:focus
+@test "focused" {
+  true
+}
+
+@test "one-liner skip" { skip; }
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 3 {
+					t.Fatalf("expected 3 tests, got %d", len(file.Tests))
+				}
+				smoke := file.Tests[0]
+				if len(smoke.Tags) != 3 || smoke.Tags[0] != "infra" || smoke.Tags[2] != "fast" {
+					t.Errorf("expected tags [infra smoke fast], got %v", smoke.Tags)
+				}
+				if smoke.Location.StartLine != 4 || smoke.Location.EndLine != 4 {
+					t.Errorf("expected single-line test at line 4, got %d-%d", smoke.Location.StartLine, smoke.Location.EndLine)
+				}
+				if file.Tests[1].Status != domain.TestStatusFocused {
+					t.Errorf("expected bats:focus as focused, got %q", file.Tests[1].Status)
+				}
+				if tags := file.Tests[2].Tags; len(tags) != 1 || tags[0] != "infra" {
+					t.Errorf("expected test tags not to leak, got %v", tags)
+				}
+				if file.Tests[2].Status != domain.TestStatusSkipped {
+					t.Errorf("expected single-line skip, got %q", file.Tests[2].Status)
+				}
+			},
+		},
+		{
+			name: "bodies the bash grammar cannot read as commands",
+			source: `#!/usr/bin/env bats
+
+@test "first passes" {
+  run ./bin/app
+  [ "$status" -eq 0 ]
+}
+
+@test "second is skipped" {
+  skip "flaky on CI"
+  [ -n "$HOME" ]
+}
+
+@test "grouped body" {
+  { echo hi; } > out.txt
+  if [ -f out.txt ]; then
+    rm out.txt
+  fi
+}
+
+@test "handles { braces }" { [ 1 -eq 1 ]; }
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				want := []struct {
+					name               string
+					status             domain.TestStatus
+					startLine, endLine int
+				}{
+					{"first passes", domain.TestStatusActive, 3, 6},
+					{"second is skipped", domain.TestStatusSkipped, 8, 11},
+					{"grouped body", domain.TestStatusActive, 13, 18},
+					{"handles { braces }", domain.TestStatusActive, 20, 20},
+				}
+				if len(file.Tests) != len(want) {
+					t.Fatalf("expected %d tests, got %d", len(want), len(file.Tests))
+				}
+				for i, w := range want {
+					got := file.Tests[i]
+					if got.Name != w.name || got.Status != w.status {
+						t.Errorf("test %d: expected %q (%s), got %q (%s)", i, w.name, w.status, got.Name, got.Status)
+					}
+					if got.Location.StartLine != w.startLine || got.Location.EndLine != w.endLine {
+						t.Errorf("test %q: expected lines %d-%d, got %d-%d", w.name, w.startLine, w.endLine, got.Location.StartLine, got.Location.EndLine)
+					}
+				}
+			},
+		},
+	}
+
+	parser := &BatsParser{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := parser.Parse(ctx, []byte(tt.source), "deploy.bats")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if file.Framework != frameworkName {
+				t.Errorf("expected framework %q, got %q", frameworkName, file.Framework)
+			}
+
+			if file.Language != domain.LanguageShell {
+				t.Errorf("expected language Shell, got %q", file.Language)
+			}
+
+			if tt.checkFunc != nil {
+				tt.checkFunc(t, file)
+			}
+		})
+	}
+}
+
+func TestBatsFileMatcher_Match(t *testing.T) {
+	tests := []struct {
+		filename  string
+		wantMatch bool
+	}{
+		{"test/deploy.bats", true},
+		{"test/test_helper/common.bash", false},
+		{"scripts/deploy.sh", false},
+	}
+
+	matcher := &BatsFileMatcher{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			signal := framework.Signal{Type: framework.SignalFileName, Value: tt.filename}
+			result := matcher.Match(ctx, signal)
+			if (result.Confidence > 0) != tt.wantMatch {
+				t.Errorf("Match() confidence = %v, want match = %v", result.Confidence, tt.wantMatch)
+			}
+		})
+	}
+}
+
+func TestBatsContentMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantMatch bool
+	}{
+		{"shebang", "#!/usr/bin/env bats\n", true},
+		{"test block", `@test "works" {`, true},
+		{"plain script", "#!/usr/bin/env bash\necho hi", false},
+	}
+
+	matcher := &BatsContentMatcher{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := framework.Signal{
+				Type:    framework.SignalFileContent,
+				Value:   tt.content,
+				Context: []byte(tt.content),
+			}
+			result := matcher.Match(ctx, signal)
+			if (result.Confidence > 0) != tt.wantMatch {
+				t.Errorf("Match() confidence = %v, want match = %v", result.Confidence, tt.wantMatch)
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+// Package boosttest implements Boost.Test framework support for C++ test files.
+package boosttest
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+	"github.com/specvital/core/pkg/parser/framework"
+	"github.com/specvital/core/pkg/parser/framework/matchers"
+	"github.com/specvital/core/pkg/parser/strategies/shared/cppast"
+)
+
+const frameworkName = framework.FrameworkBoostTest
+
+const (
+	macroAutoTestSuite                = "BOOST_AUTO_TEST_SUITE"
+	macroFixtureTestSuite             = "BOOST_FIXTURE_TEST_SUITE"
+	macroAutoTestSuiteEnd             = "BOOST_AUTO_TEST_SUITE_END"
+	macroTestDecorator                = "BOOST_TEST_DECORATOR"
+	macroAutoTestCaseExpectedFailures = "BOOST_AUTO_TEST_CASE_EXPECTED_FAILURES"
+)
+
+// testCaseMacro describes where a test case macro keeps its name and decorator arguments.
+// A negative decoratorArg means the macro takes no inline decorators.
+type testCaseMacro struct {
+	nameArg      int
+	decoratorArg int
+}
+
+// testCaseMacros lists the test case macros. Data-driven and template test cases
+// count as one test since their samples and type lists are resolved at runtime.
+var testCaseMacros = map[string]testCaseMacro{
+	"BOOST_AUTO_TEST_CASE":             {nameArg: 0, decoratorArg: 1},
+	"BOOST_FIXTURE_TEST_CASE":          {nameArg: 0, decoratorArg: 2},
+	"BOOST_DATA_TEST_CASE":             {nameArg: 0, decoratorArg: -1},
+	"BOOST_DATA_TEST_CASE_F":           {nameArg: 1, decoratorArg: -1},
+	"BOOST_AUTO_TEST_CASE_TEMPLATE":    {nameArg: 0, decoratorArg: -1},
+	"BOOST_FIXTURE_TEST_CASE_TEMPLATE": {nameArg: 0, decoratorArg: -1},
+}
+
+// suiteDecoratorArgs maps suite macros to the index of their first decorator argument.
+var suiteDecoratorArgs = map[string]int{
+	macroAutoTestSuite:    1,
+	macroFixtureTestSuite: 2,
+}
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageCpp},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher("boost/test/"),
+			&BoostTestContentMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &BoostTestParser{},
+		Priority:     framework.PriorityGeneric,
+	}
+}
+
+// BoostTestContentMatcher matches Boost.Test-specific patterns in file content.
+type BoostTestContentMatcher struct{}
+
+var boostTestPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`#include\s*[<"]boost/test/`), "#include <boost/test/...>"},
+	{regexp.MustCompile(`#define\s+BOOST_TEST_MODULE\b`), "BOOST_TEST_MODULE definition"},
+	{regexp.MustCompile(`\bBOOST_(?:AUTO|FIXTURE|DATA)_TEST_CASE\w*\s*\(`), "BOOST_AUTO_TEST_CASE() macro"},
+	{regexp.MustCompile(`\bBOOST_(?:AUTO|FIXTURE)_TEST_SUITE\s*\(`), "BOOST_AUTO_TEST_SUITE() macro"},
+}
+
+func (m *BoostTestContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range boostTestPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found Boost.Test pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+// BoostTestParser extracts test definitions from C++ Boost.Test files.
+type BoostTestParser struct{}
+
+// suiteScope is an open BOOST_AUTO_TEST_SUITE block.
+type suiteScope struct {
+	suite *domain.TestSuite
+	attrs cppast.CaseAttributes
+}
+
+func (p *BoostTestParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageCpp, source)
+	if err != nil {
+		return nil, fmt.Errorf("boost-test parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	file := &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageCpp,
+		Framework: frameworkName,
+	}
+
+	root := &domain.TestSuite{}
+	var stack []*suiteScope
+	current := func() (*domain.TestSuite, cppast.CaseAttributes) {
+		if len(stack) == 0 {
+			return root, cppast.CaseAttributes{Status: domain.TestStatusActive}
+		}
+		top := stack[len(stack)-1]
+		return top.suite, top.attrs
+	}
+	closeSuite := func() {
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		target, _ := current()
+		target.Suites = append(target.Suites, *top.suite)
+	}
+
+	// BOOST_TEST_DECORATOR and BOOST_AUTO_TEST_CASE_EXPECTED_FAILURES apply to the
+	// test unit declared after them.
+	var pendingDecorators []*sitter.Node
+	expectedFailures := make(map[string]bool)
+
+	for _, m := range cppast.Macros(tree.RootNode(), source) {
+		target, inherited := current()
+
+		switch m.Name {
+		case macroTestDecorator:
+			pendingDecorators = append(pendingDecorators, m.Args...)
+			continue
+
+		case macroAutoTestCaseExpectedFailures:
+			expectedFailures[m.ArgText(0, source)] = true
+			continue
+
+		case macroAutoTestSuite, macroFixtureTestSuite:
+			decorators := append(pendingDecorators, argsFrom(m, suiteDecoratorArgs[m.Name])...)
+			pendingDecorators = nil
+			attrs := applyDecorators(inherited, decorators, source)
+			stack = append(stack, &suiteScope{
+				suite: &domain.TestSuite{
+					Name:     m.ArgText(0, source),
+					Status:   attrs.Status,
+					Modifier: attrs.Modifier,
+					Location: m.Location(filename),
+				},
+				attrs: attrs,
+			})
+			continue
+
+		case macroAutoTestSuiteEnd:
+			if len(stack) > 0 {
+				closeSuite()
+			}
+			continue
+		}
+
+		spec, ok := testCaseMacros[m.Name]
+		if !ok {
+			continue
+		}
+
+		name := m.ArgText(spec.nameArg, source)
+		decorators := append(pendingDecorators, argsFrom(m, spec.decoratorArg)...)
+		pendingDecorators = nil
+		attrs := applyDecorators(inherited, decorators, source)
+		if expectedFailures[name] && attrs.Status == domain.TestStatusActive {
+			attrs.Status = domain.TestStatusXfail
+			attrs.Modifier = "expected_failures"
+		}
+
+		target.Tests = append(target.Tests, domain.Test{
+			Name:     name,
+			Status:   attrs.Status,
+			Modifier: attrs.Modifier,
+			Tags:     attrs.Tags,
+			Location: m.Location(filename),
+		})
+	}
+
+	// Unterminated suites extend to the end of the file
+	for len(stack) > 0 {
+		closeSuite()
+	}
+
+	file.Tests = root.Tests
+	file.Suites = root.Suites
+	return file, nil
+}
+
+// argsFrom returns the macro arguments starting at index from, or nil if from is negative.
+func argsFrom(m cppast.Macro, from int) []*sitter.Node {
+	if from < 0 || from >= len(m.Args) {
+		return nil
+	}
+	return m.Args[from:]
+}
+
+// applyDecorators derives test unit attributes from decorator expressions such as
+// * utf::disabled() * utf::label("slow"). Labels accumulate onto the inherited ones.
+func applyDecorators(inherited cppast.CaseAttributes, decorators []*sitter.Node, source []byte) cppast.CaseAttributes {
+	attrs := inherited
+	attrs.Tags = append([]string(nil), inherited.Tags...)
+
+	for _, decorator := range decorators {
+		parser.WalkTree(decorator, func(node *sitter.Node) bool {
+			// BOOST_TEST_DECORATOR arguments parse as declarators rather than calls
+			if node.Type() != cppast.NodeCallExpression && node.Type() != cppast.NodeFunctionDeclarator {
+				return true
+			}
+			switch decoratorName(node, source) {
+			case "disabled":
+				attrs.Status = domain.TestStatusSkipped
+				attrs.Modifier = "disabled"
+			case "expected_failures":
+				if attrs.Status != domain.TestStatusSkipped {
+					attrs.Status = domain.TestStatusXfail
+					attrs.Modifier = "expected_failures"
+				}
+			case "label":
+				if args := node.ChildByFieldName("arguments"); args != nil {
+					for i := 0; i < int(args.NamedChildCount()); i++ {
+						if label, ok := cppast.StringValue(args.NamedChild(i), source); ok {
+							attrs.Tags = append(attrs.Tags, label)
+						}
+					}
+				}
+			}
+			return true
+		})
+	}
+
+	if len(attrs.Tags) == 0 {
+		attrs.Tags = nil
+	}
+	return attrs
+}
+
+// decoratorName returns the unqualified function name of a decorator call (utf::label -> label).
+func decoratorName(call *sitter.Node, source []byte) string {
+	fn := call.ChildByFieldName("function")
+	if fn == nil {
+		fn = call.ChildByFieldName("declarator")
+	}
+	if fn == nil {
+		return ""
+	}
+	// boost::unit_test::label nests qualified identifiers
+	for fn.Type() == "qualified_identifier" {
+		name := fn.ChildByFieldName("name")
+		if name == nil {
+			break
+		}
+		fn = name
+	}
+	return parser.GetNodeText(fn, source)
+}
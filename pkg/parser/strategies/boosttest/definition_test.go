@@ -0,0 +1,238 @@
+package boosttest
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+func TestBoostTestParser_Parse(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		checkFunc func(t *testing.T, file *domain.TestFile)
+	}{
+		{
+			name: "basic BOOST_AUTO_TEST_CASE",
+			source: `
+#define BOOST_TEST_MODULE example
+#include <boost/test/included/unit_test.hpp>
+
+BOOST_AUTO_TEST_CASE(free_test_function)
+{
+    BOOST_TEST(true);
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 1 {
+					t.Fatalf("expected 1 test, got %d", len(file.Tests))
+				}
+				if file.Tests[0].Name != "free_test_function" {
+					t.Errorf("expected name 'free_test_function', got %q", file.Tests[0].Name)
+				}
+				if file.Tests[0].Status != domain.TestStatusActive {
+					t.Errorf("expected status active, got %q", file.Tests[0].Status)
+				}
+			},
+		},
+		{
+			name: "nested suites",
+			source: `
+#include <boost/test/unit_test.hpp>
+
+BOOST_AUTO_TEST_SUITE(outer)
+
+BOOST_AUTO_TEST_CASE(first) {}
+
+BOOST_FIXTURE_TEST_SUITE(inner, Fixture)
+BOOST_AUTO_TEST_CASE(second) {}
+BOOST_FIXTURE_TEST_CASE(third, OtherFixture) {}
+BOOST_AUTO_TEST_SUITE_END()
+
+BOOST_AUTO_TEST_SUITE_END()
+
+BOOST_AUTO_TEST_CASE(top_level) {}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 1 || file.Tests[0].Name != "top_level" {
+					t.Errorf("expected top-level test, got %+v", file.Tests)
+				}
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				outer := file.Suites[0]
+				if outer.Name != "outer" || len(outer.Tests) != 1 {
+					t.Errorf("expected 'outer' with 1 test, got %q with %d", outer.Name, len(outer.Tests))
+				}
+				if len(outer.Suites) != 1 || outer.Suites[0].Name != "inner" {
+					t.Fatalf("expected nested suite 'inner', got %+v", outer.Suites)
+				}
+				if len(outer.Suites[0].Tests) != 2 {
+					t.Errorf("expected 2 tests in inner, got %d", len(outer.Suites[0].Tests))
+				}
+			},
+		},
+		{
+			name: "decorators",
+			source: `
+#include <boost/test/unit_test.hpp>
+namespace utf = boost::unit_test;
+
+BOOST_AUTO_TEST_CASE(disabled_test, * utf::disabled()) {}
+BOOST_AUTO_TEST_CASE(xfail_test, * utf::expected_failures(2)) {}
+BOOST_AUTO_TEST_CASE(labeled, * utf::label("slow") * boost::unit_test::label("db")) {}
+
+BOOST_TEST_DECORATOR(* utf::disabled())
+BOOST_AUTO_TEST_CASE(decorated) {}
+
+BOOST_AUTO_TEST_CASE_EXPECTED_FAILURES(legacy_xfail, 1)
+BOOST_AUTO_TEST_CASE(legacy_xfail) {}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 5 {
+					t.Fatalf("expected 5 tests, got %d", len(file.Tests))
+				}
+				want := []struct {
+					name     string
+					status   domain.TestStatus
+					modifier string
+				}{
+					{"disabled_test", domain.TestStatusSkipped, "disabled"},
+					{"xfail_test", domain.TestStatusXfail, "expected_failures"},
+					{"labeled", domain.TestStatusActive, ""},
+					{"decorated", domain.TestStatusSkipped, "disabled"},
+					{"legacy_xfail", domain.TestStatusXfail, "expected_failures"},
+				}
+				for i, w := range want {
+					got := file.Tests[i]
+					if got.Name != w.name || got.Status != w.status || got.Modifier != w.modifier {
+						t.Errorf("test %d: expected %q %q/%q, got %q %q/%q",
+							i, w.name, w.status, w.modifier, got.Name, got.Status, got.Modifier)
+					}
+				}
+				if !reflect.DeepEqual(file.Tests[2].Tags, []string{"slow", "db"}) {
+					t.Errorf("expected tags [slow db], got %v", file.Tests[2].Tags)
+				}
+			},
+		},
+		{
+			name: "disabled suite propagates to tests",
+			source: `
+#include <boost/test/unit_test.hpp>
+
+BOOST_AUTO_TEST_SUITE(slow_suite, * boost::unit_test::disabled() * boost::unit_test::label("slow"))
+BOOST_AUTO_TEST_CASE(a) {}
+BOOST_AUTO_TEST_CASE(b) {}
+BOOST_AUTO_TEST_SUITE_END()
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				suite := file.Suites[0]
+				if suite.Status != domain.TestStatusSkipped {
+					t.Errorf("expected suite skipped, got %q", suite.Status)
+				}
+				if len(suite.Tests) != 2 {
+					t.Fatalf("expected 2 tests, got %d", len(suite.Tests))
+				}
+				for _, test := range suite.Tests {
+					if test.Status != domain.TestStatusSkipped {
+						t.Errorf("expected %q skipped, got %q", test.Name, test.Status)
+					}
+					if !reflect.DeepEqual(test.Tags, []string{"slow"}) {
+						t.Errorf("expected %q to inherit label, got %v", test.Name, test.Tags)
+					}
+				}
+			},
+		},
+		{
+			name: "data-driven and template test cases count as one",
+			source: `
+#include <boost/test/unit_test.hpp>
+#include <boost/test/data/test_case.hpp>
+
+namespace data = boost::unit_test::data;
+
+BOOST_DATA_TEST_CASE(data_test, data::xrange(5), x) {
+    BOOST_TEST(x < 5);
+}
+
+BOOST_DATA_TEST_CASE_F(Fixture, data_fixture_test, data::make({1, 2}), x) {}
+
+typedef boost::mpl::list<int, long> test_types;
+BOOST_AUTO_TEST_CASE_TEMPLATE(template_test, T, test_types) {}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 3 {
+					t.Fatalf("expected 3 tests, got %d", len(file.Tests))
+				}
+				names := []string{file.Tests[0].Name, file.Tests[1].Name, file.Tests[2].Name}
+				want := []string{"data_test", "data_fixture_test", "template_test"}
+				if !reflect.DeepEqual(names, want) {
+					t.Errorf("expected %v, got %v", want, names)
+				}
+			},
+		},
+	}
+
+	parser := &BoostTestParser{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := parser.Parse(ctx, []byte(tt.source), "test.cpp")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if file.Framework != frameworkName {
+				t.Errorf("expected framework %q, got %q", frameworkName, file.Framework)
+			}
+
+			if file.Language != domain.LanguageCpp {
+				t.Errorf("expected language Cpp, got %q", file.Language)
+			}
+
+			if tt.checkFunc != nil {
+				tt.checkFunc(t, file)
+			}
+		})
+	}
+}
+
+func TestBoostTestContentMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantMatch bool
+	}{
+		{"boost test include", "#include <boost/test/unit_test.hpp>", true},
+		{"test module define", "#define BOOST_TEST_MODULE example", true},
+		{"auto test case", "BOOST_AUTO_TEST_CASE(name) {}", true},
+		{"data test case", "BOOST_DATA_TEST_CASE(name, data, x) {}", true},
+		{"auto test suite", "BOOST_AUTO_TEST_SUITE(name)", true},
+		{"gtest macro", "TEST(Suite, Test) {}", false},
+		{"plain cpp code", "int main() { return 0; }", false},
+	}
+
+	matcher := &BoostTestContentMatcher{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := framework.Signal{
+				Type:    framework.SignalFileContent,
+				Value:   tt.content,
+				Context: []byte(tt.content),
+			}
+			result := matcher.Match(ctx, signal)
+			if (result.Confidence > 0) != tt.wantMatch {
+				t.Errorf("Match() confidence = %v, want match = %v", result.Confidence, tt.wantMatch)
+			}
+		})
+	}
+}
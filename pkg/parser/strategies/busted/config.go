@@ -0,0 +1,225 @@
+package busted
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+// Lua AST node types used by .busted files.
+const (
+	nodeField            = "field"
+	nodeFieldList        = "fieldlist"
+	nodeModuleReturn     = "module_return_statement"
+	nodeTableConstructor = "tableconstructor"
+)
+
+// .busted options and the defaults busted uses when they are absent.
+const (
+	configKeyRoot    = "ROOT"
+	configKeyPattern = "pattern"
+	defaultRoot      = "spec"
+	defaultPattern   = "_spec"
+	luaExt           = ".lua"
+)
+
+// BustedConfigParser reads a .busted file: a Lua script returning one table per task
+// (_all, default, and named --run tasks). The ROOT directories of every task become the
+// scope roots and their Lua filename patterns become include globs; the config then
+// decides the project's Lua files, collecting those the patterns match under a root.
+type BustedConfigParser struct{}
+
+func (p *BustedConfigParser) Parse(ctx context.Context, configPath string, content []byte) (*framework.ConfigScope, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageLua, content)
+	if err != nil {
+		return nil, fmt.Errorf("busted config: failed to parse %s: %w", configPath, err)
+	}
+	defer tree.Close()
+
+	scope := framework.NewConfigScope(configPath, "")
+	scope.Framework = frameworkName
+
+	var roots, patterns []string
+	if tasks := returnedTable(tree.RootNode()); tasks != nil {
+		for _, task := range tableFields(tasks) {
+			if task.value.Type() != nodeTableConstructor {
+				continue
+			}
+			for _, option := range tableFields(task.value) {
+				switch option.key(content) {
+				case configKeyRoot:
+					roots = append(roots, stringValues(option.value, content)...)
+				case configKeyPattern:
+					patterns = append(patterns, stringValues(option.value, content)...)
+				}
+			}
+		}
+	}
+
+	if len(roots) == 0 {
+		roots = []string{defaultRoot}
+	}
+	roots = uniqueStrings(roots)
+	for _, root := range roots {
+		scope.Roots = append(scope.Roots, filepath.Join(scope.BaseDir, filepath.FromSlash(root)))
+	}
+
+	if len(patterns) == 0 {
+		patterns = []string{defaultPattern}
+	}
+	for _, pattern := range uniqueStrings(patterns) {
+		glob, ok := luaPatternToGlob(pattern)
+		if !ok {
+			// A pattern that cannot be expressed as a glob leaves file selection to detection.
+			scope.Include = nil
+			return scope, nil
+		}
+		scope.Include = append(scope.Include, glob)
+	}
+
+	// busted runs the Lua files of its roots matching the pattern, whatever the language's
+	// naming says, and no other file.
+	for _, root := range roots {
+		for _, glob := range scope.Include {
+			scope.CollectPatterns = append(scope.CollectPatterns, path.Join(filepath.ToSlash(root), luaSources(glob)))
+		}
+	}
+	scope.ExclusivePatterns = []string{"**/*" + luaExt}
+
+	return scope, nil
+}
+
+// luaSources limits an unanchored glob to Lua files: "**/*_spec*" becomes "**/*_spec*.lua".
+func luaSources(glob string) string {
+	if strings.HasSuffix(glob, "*") {
+		return glob + luaExt
+	}
+	return glob
+}
+
+// tableField is a key = value entry of a Lua table constructor.
+type tableField struct {
+	name  *sitter.Node
+	value *sitter.Node
+}
+
+// key returns the field name for name = value and ["name"] = value entries.
+func (f tableField) key(source []byte) string {
+	if f.name == nil {
+		return ""
+	}
+	if content := parser.FindChildByType(f.name, nodeStringContent); content != nil {
+		return parser.GetNodeText(content, source)
+	}
+	return strings.TrimSpace(parser.GetNodeText(f.name, source))
+}
+
+func returnedTable(root *sitter.Node) *sitter.Node {
+	ret := parser.FindChildByType(root, nodeModuleReturn)
+	if ret == nil {
+		return nil
+	}
+	return parser.FindChildByType(ret, nodeTableConstructor)
+}
+
+func tableFields(table *sitter.Node) []tableField {
+	list := parser.FindChildByType(table, nodeFieldList)
+	if list == nil {
+		return nil
+	}
+	var fields []tableField
+	for i := 0; i < int(list.NamedChildCount()); i++ {
+		field := list.NamedChild(i)
+		if field.Type() != nodeField {
+			continue
+		}
+		value := field.ChildByFieldName("value")
+		if value == nil {
+			continue
+		}
+		name := field.ChildByFieldName("name")
+		if name == nil {
+			name = field.ChildByFieldName("key")
+		}
+		fields = append(fields, tableField{name: name, value: value})
+	}
+	return fields
+}
+
+// stringValues reads a string or a list of strings: "spec" or {"spec/unit", "spec/integration"}.
+func stringValues(value *sitter.Node, source []byte) []string {
+	switch value.Type() {
+	case nodeString:
+		if content := parser.FindChildByType(value, nodeStringContent); content != nil {
+			return []string{parser.GetNodeText(content, source)}
+		}
+	case nodeTableConstructor:
+		var values []string
+		for _, field := range tableFields(value) {
+			values = append(values, stringValues(field.value, source)...)
+		}
+		return values
+	}
+	return nil
+}
+
+// luaPatternToGlob converts a busted filename pattern, which is matched anywhere in the
+// file's basename, to a glob relative to a root: "_spec" becomes "**/*_spec*".
+// Only literals, %-escapes, "." and the ^/$ anchors can be converted.
+func luaPatternToGlob(pattern string) (string, bool) {
+	var glob strings.Builder
+	glob.WriteString("**/")
+
+	if p, ok := strings.CutPrefix(pattern, "^"); ok {
+		pattern = p
+	} else {
+		glob.WriteString("*")
+	}
+	anchoredEnd := false
+	if p, ok := strings.CutSuffix(pattern, "$"); ok && !strings.HasSuffix(p, "%") {
+		pattern = p
+		anchoredEnd = true
+	}
+
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '%':
+			i++
+			if i >= len(pattern) || strings.IndexByte("*?[]{}", pattern[i]) >= 0 {
+				return "", false
+			}
+			glob.WriteByte(pattern[i])
+		case c == '.':
+			glob.WriteString("?")
+		case strings.IndexByte("^$[]*+-?()", c) >= 0:
+			return "", false
+		default:
+			glob.WriteByte(c)
+		}
+	}
+
+	if !anchoredEnd {
+		glob.WriteString("*")
+	}
+	return glob.String(), true
+}
+
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	var result []string
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	return result
+}
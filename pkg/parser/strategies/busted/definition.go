@@ -0,0 +1,85 @@
+// Package busted implements busted framework support for Lua spec files.
+package busted
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/framework"
+	"github.com/specvital/core/pkg/parser/framework/matchers"
+)
+
+const frameworkName = framework.FrameworkBusted
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageLua},
+		Matchers: []framework.Matcher{
+			matchers.NewConfigMatcher(".busted"),
+			&BustedFileMatcher{},
+			&BustedContentMatcher{},
+		},
+		ConfigParser: &BustedConfigParser{},
+		Parser:       &BustedParser{},
+		Priority:     framework.PriorityGeneric,
+	}
+}
+
+// BustedFileMatcher matches *_spec.lua files, busted's default test pattern.
+type BustedFileMatcher struct{}
+
+func (m *BustedFileMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileName {
+		return framework.NoMatch()
+	}
+
+	if strings.HasSuffix(signal.Value, "_spec.lua") {
+		return framework.DefiniteMatch("busted test file naming convention: *_spec.lua")
+	}
+
+	return framework.NoMatch()
+}
+
+// BustedContentMatcher matches busted-specific patterns in file content.
+type BustedContentMatcher struct{}
+
+var bustedPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`\brequire\s*\(?\s*["']busted`), "require busted"},
+	{regexp.MustCompile(`(?m)^\s*(?:describe|context|insulate|expose)\s*\(\s*["'\[]`), "describe block"},
+	{regexp.MustCompile(`(?m)^\s*(?:it|spec|test)\s*\(\s*["'\[][^\n]*,\s*function\s*\(`), "it block"},
+}
+
+func (m *BustedContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range bustedPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found busted pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+type BustedParser struct{}
+
+func (p *BustedParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	return parseSpec(ctx, source, filename)
+}
@@ -0,0 +1,117 @@
+package busted
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+func TestBustedConfigParser_Parse(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		wantRoots   []string
+		wantInclude []string
+		wantCollect []string
+	}{
+		{
+			name: "tasks with ROOT lists and pattern",
+			content: `return {
+  _all = { coverage = false, lpath = "lua/?.lua" },
+  default = {
+    ROOT = {"spec/unit", "spec/integration"},
+    pattern = "_spec",
+  },
+  ["integration"] = { ROOT = "spec/integration" },
+}`,
+			wantRoots:   []string{"/project/spec/unit", "/project/spec/integration"},
+			wantInclude: []string{"**/*_spec*"},
+			wantCollect: []string{"spec/unit/**/*_spec*.lua", "spec/integration/**/*_spec*.lua"},
+		},
+		{
+			name:        "defaults",
+			content:     `return { default = { verbose = true } }`,
+			wantRoots:   []string{"/project/spec"},
+			wantInclude: []string{"**/*_spec*"},
+			wantCollect: []string{"spec/**/*_spec*.lua"},
+		},
+		{
+			name:        "anchored pattern",
+			content:     `return { default = { ROOT = "test", pattern = "^test_" } }`,
+			wantRoots:   []string{"/project/test"},
+			wantInclude: []string{"**/test_*"},
+			wantCollect: []string{"test/**/test_*.lua"},
+		},
+		{
+			name:      "pattern without glob form",
+			content:   `return { default = { pattern = "_spec%w+" } }`,
+			wantRoots: []string{"/project/spec"},
+		},
+	}
+
+	parser := &BustedConfigParser{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope, err := parser.Parse(ctx, filepath.FromSlash("/project/.busted"), []byte(tt.content))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if scope.Framework != frameworkName {
+				t.Errorf("expected framework %q, got %q", frameworkName, scope.Framework)
+			}
+
+			var wantRoots []string
+			for _, root := range tt.wantRoots {
+				wantRoots = append(wantRoots, filepath.FromSlash(root))
+			}
+			if !reflect.DeepEqual(scope.Roots, wantRoots) {
+				t.Errorf("Roots = %v, want %v", scope.Roots, wantRoots)
+			}
+			if !reflect.DeepEqual(scope.Include, tt.wantInclude) {
+				t.Errorf("Include = %v, want %v", scope.Include, tt.wantInclude)
+			}
+			if !reflect.DeepEqual(scope.CollectPatterns, tt.wantCollect) {
+				t.Errorf("CollectPatterns = %v, want %v", scope.CollectPatterns, tt.wantCollect)
+			}
+			if decides := len(scope.ExclusivePatterns) > 0; decides != (tt.wantCollect != nil) {
+				t.Errorf("ExclusivePatterns = %v, want deciding = %v", scope.ExclusivePatterns, tt.wantCollect != nil)
+			}
+		})
+	}
+}
+
+func TestBustedContentMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantMatch bool
+	}{
+		{"require busted", `require("busted.runner")()`, true},
+		{"describe block", `describe("calc", function()`, true},
+		{"insulate block", `insulate("sandbox", function()`, true},
+		{"it block", `it("adds", function()`, true},
+		{"plain module", "local M = {}\nreturn M", false},
+	}
+
+	matcher := &BustedContentMatcher{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := framework.Signal{
+				Type:    framework.SignalFileContent,
+				Value:   tt.content,
+				Context: []byte(tt.content),
+			}
+			result := matcher.Match(ctx, signal)
+			if (result.Confidence > 0) != tt.wantMatch {
+				t.Errorf("Match() confidence = %v, want match = %v", result.Confidence, tt.wantMatch)
+			}
+		})
+	}
+}
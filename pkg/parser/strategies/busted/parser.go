@@ -0,0 +1,260 @@
+package busted
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+)
+
+// Lua AST node types.
+const (
+	nodeFunction          = "function"
+	nodeFunctionArguments = "function_arguments"
+	nodeFunctionBody      = "function_body"
+	nodeFunctionCall      = "function_call"
+	nodeIdentifier        = "identifier"
+	nodeString            = "string"
+	nodeStringArgument    = "string_argument"
+	nodeStringContent     = "string_content"
+)
+
+// busted block functions.
+var (
+	suiteBlocks = map[string]bool{"describe": true, "context": true}
+	// insulate and expose sandbox the global environment; a named block is reported like describe.
+	sandboxBlocks = map[string]bool{"insulate": true, "expose": true}
+	testBlocks    = map[string]bool{"it": true, "spec": true, "test": true}
+	hookBlocks    = map[string]bool{
+		"setup": true, "teardown": true, "lazy_setup": true, "lazy_teardown": true,
+		"strict_setup": true, "strict_teardown": true,
+		"before_each": true, "after_each": true, "finally": true,
+	}
+)
+
+const blockPending = "pending"
+
+// Tags are written into descriptions: describe("api #slow", ...).
+var tagPattern = regexp.MustCompile(`#([\w-]+)`)
+
+// parseSpec extracts busted blocks. describe/context and named insulate/expose blocks
+// become suites, it/spec/test become tests. pending("name"), an it without a function and
+// an it whose body calls pending() are reported as todo.
+func parseSpec(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageLua, source)
+	if err != nil {
+		return nil, fmt.Errorf("busted parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	w := &walker{source: source, filename: filename}
+	var root domain.TestSuite
+	w.walk(tree.RootNode(), &root, nil, 0)
+
+	return &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageLua,
+		Framework: frameworkName,
+		Suites:    root.Suites,
+		Tests:     root.Tests,
+	}, nil
+}
+
+type walker struct {
+	source   []byte
+	filename string
+}
+
+func (w *walker) walk(node *sitter.Node, parent *domain.TestSuite, tags []string, depth int) {
+	if depth > parser.MaxTreeDepth {
+		return
+	}
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() != nodeFunctionCall {
+			w.walk(child, parent, tags, depth+1)
+			continue
+		}
+
+		name := w.callName(child)
+		args := callArguments(child)
+		switch {
+		case suiteBlocks[name]:
+			w.suite(child, args, parent, tags, depth)
+		case sandboxBlocks[name]:
+			if _, ok := w.description(args); ok {
+				w.suite(child, args, parent, tags, depth)
+			} else if body := functionBody(args); body != nil {
+				w.walk(body, parent, tags, depth+1)
+			}
+		case testBlocks[name]:
+			w.test(child, args, parent, tags)
+		case name == blockPending:
+			if description, ok := w.description(args); ok {
+				parent.Tests = append(parent.Tests, domain.Test{
+					Name:     description,
+					Status:   domain.TestStatusTodo,
+					Modifier: blockPending,
+					Location: w.location(child),
+					Tags:     withTags(tags, description),
+				})
+			}
+		case hookBlocks[name]:
+			// Hooks run around tests and declare none.
+		default:
+			w.walk(child, parent, tags, depth+1)
+		}
+	}
+}
+
+func (w *walker) suite(node *sitter.Node, args []*sitter.Node, parent *domain.TestSuite, tags []string, depth int) {
+	description, _ := w.description(args)
+	tags = withTags(tags, description)
+
+	suite := domain.TestSuite{
+		Name:     description,
+		Status:   domain.TestStatusActive,
+		Location: w.location(node),
+	}
+	if body := functionBody(args); body != nil {
+		w.walk(body, &suite, tags, depth+1)
+	}
+	parent.Suites = append(parent.Suites, suite)
+}
+
+func (w *walker) test(node *sitter.Node, args []*sitter.Node, parent *domain.TestSuite, tags []string) {
+	description, ok := w.description(args)
+	if !ok {
+		return
+	}
+
+	test := domain.Test{
+		Name:     description,
+		Status:   domain.TestStatusActive,
+		Location: w.location(node),
+		Tags:     withTags(tags, description),
+	}
+	if fn := functionArgument(args); fn == nil || w.callsPending(fn) {
+		test.Status = domain.TestStatusTodo
+		test.Modifier = blockPending
+	}
+	parent.Tests = append(parent.Tests, test)
+}
+
+// callsPending reports whether a test body calls pending() at its top level.
+func (w *walker) callsPending(fn *sitter.Node) bool {
+	body := parser.FindChildByType(fn, nodeFunctionBody)
+	if body == nil {
+		return false
+	}
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		stmt := body.NamedChild(i)
+		if stmt.Type() == nodeFunctionCall && w.callName(stmt) == blockPending {
+			return true
+		}
+	}
+	return false
+}
+
+// callName returns the callee of a plain call such as describe(...); dotted and method
+// calls return "". The grammar includes leading whitespace in identifiers, so text is trimmed.
+func (w *walker) callName(call *sitter.Node) string {
+	var prefix *sitter.Node
+	for i := 0; i < int(call.ChildCount()); i++ {
+		if call.FieldNameForChild(i) != "prefix" {
+			continue
+		}
+		if prefix != nil {
+			return ""
+		}
+		prefix = call.Child(i)
+	}
+	if prefix == nil || prefix.Type() != nodeIdentifier {
+		return ""
+	}
+	return strings.TrimSpace(parser.GetNodeText(prefix, w.source))
+}
+
+// description returns the first argument when it is a description rather than a function.
+func (w *walker) description(args []*sitter.Node) (string, bool) {
+	if len(args) == 0 || args[0].Type() == nodeFunction {
+		return "", false
+	}
+	arg := args[0]
+	if arg.Type() == nodeString || arg.Type() == nodeStringArgument {
+		if content := parser.FindChildByType(arg, nodeStringContent); content != nil {
+			return parser.GetNodeText(content, w.source), true
+		}
+		return "", true
+	}
+	// Computed descriptions such as "adds " .. n are kept as written.
+	return strings.TrimSpace(parser.GetNodeText(arg, w.source)), true
+}
+
+// location returns the node location, skipping the leading whitespace the grammar
+// attaches to the first token of a statement.
+func (w *walker) location(node *sitter.Node) domain.Location {
+	loc := parser.GetLocation(node, w.filename)
+	text := parser.GetNodeText(node, w.source)
+	lead := text[:len(text)-len(strings.TrimLeft(text, " \t\r\n"))]
+	if newlines := strings.Count(lead, "\n"); newlines > 0 {
+		loc.StartLine += newlines
+		loc.StartCol = len(lead) - strings.LastIndex(lead, "\n") - 1
+	} else {
+		loc.StartCol += len(lead)
+	}
+	return loc
+}
+
+// callArguments returns the arguments of a call: f(a, b), f "a" or f { ... }.
+func callArguments(call *sitter.Node) []*sitter.Node {
+	args := call.ChildByFieldName("args")
+	if args == nil {
+		return nil
+	}
+	if args.Type() != nodeFunctionArguments {
+		return []*sitter.Node{args}
+	}
+	result := make([]*sitter.Node, 0, args.NamedChildCount())
+	for i := 0; i < int(args.NamedChildCount()); i++ {
+		result = append(result, args.NamedChild(i))
+	}
+	return result
+}
+
+func functionArgument(args []*sitter.Node) *sitter.Node {
+	for _, arg := range args {
+		if arg.Type() == nodeFunction {
+			return arg
+		}
+	}
+	return nil
+}
+
+func functionBody(args []*sitter.Node) *sitter.Node {
+	fn := functionArgument(args)
+	if fn == nil {
+		return nil
+	}
+	return parser.FindChildByType(fn, nodeFunctionBody)
+}
+
+// withTags returns the inherited tags followed by the #tags of a description.
+func withTags(inherited []string, description string) []string {
+	matches := tagPattern.FindAllStringSubmatch(description, -1)
+	if len(matches) == 0 {
+		return inherited
+	}
+	tags := make([]string, 0, len(inherited)+len(matches))
+	tags = append(tags, inherited...)
+	for _, m := range matches {
+		tags = append(tags, m[1])
+	}
+	return tags
+}
@@ -0,0 +1,142 @@
+package busted
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specvital/core/pkg/domain"
+)
+
+func TestBustedParser_Parse(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		checkFunc func(t *testing.T, file *domain.TestFile)
+	}{
+		{
+			name: "nested describe and context blocks",
+			source: `local calc = require("app.calc")
+
+describe("calc", function()
+  before_each(function()
+    it("not a test", function() end)
+  end)
+
+  it("adds", function()
+    assert.are.equal(4, calc.add(2, 2))
+  end)
+
+  context("division", function()
+    spec("divides", function() end)
+    test("by zero", function() end)
+  end)
+end)
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				calc := file.Suites[0]
+				if calc.Name != "calc" || calc.Location.StartLine != 3 {
+					t.Errorf("unexpected suite %q at line %d", calc.Name, calc.Location.StartLine)
+				}
+				if len(calc.Tests) != 1 || calc.Tests[0].Name != "adds" {
+					t.Fatalf("expected test adds, got %+v", calc.Tests)
+				}
+				if calc.Tests[0].Location.StartLine != 8 || calc.Tests[0].Location.StartCol != 2 {
+					t.Errorf("expected adds at 8:2, got %d:%d", calc.Tests[0].Location.StartLine, calc.Tests[0].Location.StartCol)
+				}
+				if len(calc.Suites) != 1 || len(calc.Suites[0].Tests) != 2 {
+					t.Fatalf("expected nested suite with 2 tests, got %+v", calc.Suites)
+				}
+				if file.CountTests() != 3 {
+					t.Errorf("expected 3 tests, got %d", file.CountTests())
+				}
+			},
+		},
+		{
+			name: "pending tests",
+			source: `describe("todo", function()
+  pending("subtracts")
+  pending "multiplies"
+  it("divides")
+  it("rounds", function()
+    pending("needs spec")
+  end)
+  it("works", function() end)
+end)
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				tests := file.Suites[0].Tests
+				if len(tests) != 5 {
+					t.Fatalf("expected 5 tests, got %d", len(tests))
+				}
+				for _, test := range tests[:4] {
+					if test.Status != domain.TestStatusTodo || test.Modifier != "pending" {
+						t.Errorf("expected %q to be todo, got %q/%q", test.Name, test.Status, test.Modifier)
+					}
+				}
+				if tests[4].Status != domain.TestStatusActive {
+					t.Errorf("expected works to be active, got %q", tests[4].Status)
+				}
+			},
+		},
+		{
+			name: "insulate, expose and tags",
+			source: `insulate("sandboxed #slow", function()
+  it("isolated #db", function() end)
+end)
+
+expose(function()
+  it("exposed", function() end)
+end)
+
+for _, n in ipairs({1, 2, 3}) do
+  it("handles " .. n, function() end)
+end
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 || file.Suites[0].Name != "sandboxed #slow" {
+					t.Fatalf("expected named insulate suite, got %+v", file.Suites)
+				}
+				tags := file.Suites[0].Tests[0].Tags
+				if len(tags) != 2 || tags[0] != "slow" || tags[1] != "db" {
+					t.Errorf("expected tags [slow db], got %v", tags)
+				}
+				if len(file.Tests) != 2 {
+					t.Fatalf("expected 2 top-level tests, got %d", len(file.Tests))
+				}
+				if file.Tests[0].Name != "exposed" {
+					t.Errorf("expected unnamed expose to be flattened, got %q", file.Tests[0].Name)
+				}
+				if file.Tests[1].Name != `"handles " .. n` {
+					t.Errorf("expected computed name kept as written, got %q", file.Tests[1].Name)
+				}
+			},
+		},
+	}
+
+	parser := &BustedParser{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := parser.Parse(ctx, []byte(tt.source), "calc_spec.lua")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if file.Framework != frameworkName {
+				t.Errorf("expected framework %q, got %q", frameworkName, file.Framework)
+			}
+
+			if file.Language != domain.LanguageLua {
+				t.Errorf("expected language Lua, got %q", file.Language)
+			}
+
+			if tt.checkFunc != nil {
+				tt.checkFunc(t, file)
+			}
+		})
+	}
+}
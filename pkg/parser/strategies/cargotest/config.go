@@ -0,0 +1,251 @@
+package cargotest
+
+import (
+	"context"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/specvital/core/pkg/parser/framework"
+	"github.com/specvital/core/pkg/parser/strategies/shared/configutil"
+)
+
+// Settings stored on the Cargo ConfigScope.
+const (
+	// settingTestTargets holds the []testTarget declared by [[test]] tables.
+	settingTestTargets = "testTargets"
+	// settingAutotests holds whether Cargo discovers the tests/ targets (bool).
+	settingAutotests = "autotests"
+	// settingDevDependencies holds the names of the crate's dev-dependencies ([]string).
+	settingDevDependencies = "devDependencies"
+)
+
+const cargoManifestFile = "Cargo.toml"
+
+// harnessCrates are the crates that run tests of harness = false targets without #[test].
+var harnessCrates = []string{"libtest-mimic", "datatest-stable"}
+
+// testTarget is an integration test target of a package, declared by a [[test]] table.
+type testTarget struct {
+	Name string
+	// Path is the target's crate root relative to the package, or "" for the default
+	// tests/<name>.rs or tests/<name>/main.rs.
+	Path string
+	// Harness is false when the target brings its own main instead of libtest.
+	Harness bool
+}
+
+// moduleDir returns the directory holding the submodules of the target's crate root.
+func (t testTarget) moduleDir() string {
+	switch {
+	case t.Path == "":
+		return "tests/" + t.Name
+	case path.Base(t.Path) == "main.rs":
+		return path.Dir(t.Path)
+	}
+	return strings.TrimSuffix(t.Path, ".rs")
+}
+
+// contains reports whether the file at relPath, relative to the package, belongs to
+// the target's crate.
+func (t testTarget) contains(relPath string) bool {
+	if relPath == t.Path || t.Path == "" && relPath == "tests/"+t.Name+".rs" {
+		return true
+	}
+	return strings.HasPrefix(relPath, t.moduleDir()+"/")
+}
+
+// cargoManifest holds the parts of a Cargo.toml that shape test runs.
+type cargoManifest struct {
+	packageName     string
+	autotests       bool
+	workspace       bool
+	members         []string
+	exclude         []string
+	tests           []testTarget
+	devDependencies []string
+}
+
+// CargoConfigParser reads Cargo.toml package and workspace manifests.
+//
+// A package decides the Rust files below it: the unit tests of src/, the tests/
+// targets Cargo discovers unless autotests = false, and the [[test]] targets are
+// collected, while examples, benches, build scripts and fixtures are not tests. The
+// package is the project of its tests, and its [[test]] targets and dev-dependencies
+// let the parser name targets and recognize custom harnesses. A workspace names its
+// members as projects; the Rust files outside any member are not tests.
+type CargoConfigParser struct{}
+
+func (p *CargoConfigParser) Parse(ctx context.Context, configPath string, content []byte) (*framework.ConfigScope, error) {
+	manifest := readManifest(content)
+
+	scope := framework.NewConfigScope(configPath, "")
+	scope.Framework = frameworkName
+	scope.ExclusivePatterns = []string{"**/*.rs"}
+	scope.Exclude = []string{"target/**"}
+
+	if manifest.workspace {
+		scope.ProjectPatterns = append(scope.ProjectPatterns, manifest.members...)
+		for _, exclude := range manifest.exclude {
+			scope.ProjectPatterns = append(scope.ProjectPatterns, "!"+exclude)
+		}
+	}
+	if manifest.packageName == "" {
+		return scope, nil
+	}
+
+	scope.Projects = []framework.ProjectScope{{Name: manifest.packageName, BaseDir: scope.BaseDir}}
+	scope.CollectPatterns = []string{"src/**/*.rs"}
+	if manifest.autotests {
+		scope.CollectPatterns = append(scope.CollectPatterns, "tests/**/*.rs")
+	}
+	for _, target := range manifest.tests {
+		if target.Path != "" {
+			scope.CollectPatterns = append(scope.CollectPatterns, target.Path)
+		} else {
+			scope.CollectPatterns = append(scope.CollectPatterns, "tests/"+target.Name+".rs")
+		}
+		scope.CollectPatterns = append(scope.CollectPatterns, target.moduleDir()+"/**/*.rs")
+	}
+
+	scope.Settings[settingTestTargets] = manifest.tests
+	scope.Settings[settingAutotests] = manifest.autotests
+	scope.Settings[settingDevDependencies] = manifest.devDependencies
+	return scope, nil
+}
+
+// readManifest reads a Cargo.toml line by line: the [package] name and autotests, the
+// [workspace] members and exclude, the [[test]] tables and the names of the
+// [dev-dependencies], including target-specific ones. Cargo.toml keeps these to
+// strings, booleans and arrays of strings, so no full TOML parser is needed.
+func readManifest(content []byte) cargoManifest {
+	manifest := cargoManifest{autotests: true}
+	var table string
+	var target *testTarget
+
+	lines := strings.Split(string(content), "\n")
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(stripComment(lines[i]))
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if target != nil {
+				manifest.tests = append(manifest.tests, *target)
+				target = nil
+			}
+			table = tableName(line)
+			switch {
+			case line == "[[test]]":
+				target = &testTarget{Harness: true}
+			case table == "workspace" || strings.HasPrefix(table, "workspace."):
+				manifest.workspace = true
+			}
+			if dep, ok := devDependencyTable(table); ok && dep != "" {
+				manifest.addDevDependency(dep)
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		key = strings.Trim(strings.TrimSpace(key), `"'`)
+		value = strings.TrimSpace(value)
+		// Arrays may span lines.
+		for strings.HasPrefix(value, "[") && strings.Count(value, "[") > strings.Count(value, "]") && i+1 < len(lines) {
+			i++
+			value += " " + strings.TrimSpace(stripComment(lines[i]))
+		}
+
+		switch {
+		case target != nil:
+			switch key {
+			case "name":
+				target.Name = stringValue(value)
+			case "path":
+				target.Path = path.Clean(strings.TrimPrefix(filepath.ToSlash(stringValue(value)), "./"))
+			case "harness":
+				target.Harness = value != "false"
+			}
+		case table == "package":
+			switch key {
+			case "name":
+				manifest.packageName = stringValue(value)
+			case "autotests":
+				manifest.autotests = value != "false"
+			}
+		case table == "workspace":
+			switch key {
+			case "members":
+				manifest.members = configutil.ExtractQuotedStrings([]byte(value))
+			case "exclude":
+				manifest.exclude = configutil.ExtractQuotedStrings([]byte(value))
+			}
+		default:
+			if dep, ok := devDependencyTable(table); ok && dep == "" {
+				name, _, _ := strings.Cut(key, ".")
+				manifest.addDevDependency(strings.Trim(name, `"'`))
+			}
+		}
+	}
+	if target != nil {
+		manifest.tests = append(manifest.tests, *target)
+	}
+	return manifest
+}
+
+func (m *cargoManifest) addDevDependency(name string) {
+	if name != "" && !slices.Contains(m.devDependencies, name) {
+		m.devDependencies = append(m.devDependencies, name)
+	}
+}
+
+// devDependencyTable reports whether table lists dev-dependencies ([dev-dependencies],
+// [target.'cfg(unix)'.dev-dependencies]) or is one of them ([dev-dependencies.serde]),
+// whose name it returns.
+func devDependencyTable(table string) (dependency string, ok bool) {
+	for _, section := range []string{"dev-dependencies", "dev_dependencies"} {
+		if table == section || strings.HasSuffix(table, "."+section) {
+			return "", true
+		}
+		if i := strings.Index(table, section+"."); i == 0 || i > 0 && table[i-1] == '.' {
+			return strings.Trim(table[i+len(section)+1:], `"'`), true
+		}
+	}
+	return "", false
+}
+
+// tableName returns the name of a [table] or [[array]] header line.
+func tableName(line string) string {
+	return strings.TrimSpace(strings.Trim(line, "[]"))
+}
+
+// stringValue returns the string of a quoted value.
+func stringValue(value string) string {
+	if values := configutil.ExtractQuotedStrings([]byte(value)); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// stripComment removes a trailing # comment outside of quotes.
+func stripComment(line string) string {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#':
+			return line[:i]
+		}
+	}
+	return line
+}
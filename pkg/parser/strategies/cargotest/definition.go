@@ -4,6 +4,7 @@ package cargotest
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"regexp"
 	"strings"
 
@@ -29,6 +30,8 @@ const (
 	nodeModItem          = "mod_item"
 	nodeIdentifier       = "identifier"
 	nodeScopedIdentifier = "scoped_identifier"
+	nodeStringContent    = "string_content"
+	nodeStringLiteral    = "string_literal"
 	nodeTokenTree        = "token_tree"
 )
 
@@ -41,11 +44,15 @@ func NewDefinition() *framework.Definition {
 		Name:      frameworkName,
 		Languages: []domain.Language{domain.LanguageRust},
 		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher(
+				"rstest", "rstest::", "proptest", "proptest::", "test_case", "test_case::",
+				"libtest_mimic", "libtest_mimic::", "datatest_stable", "datatest_stable::",
+			),
 			&CargoTestFileMatcher{},
-			matchers.NewConfigMatcher("Cargo.toml"),
+			matchers.NewConfigMatcher(cargoManifestFile),
 			&CargoTestContentMatcher{},
 		},
-		ConfigParser: nil,
+		ConfigParser: &CargoConfigParser{},
 		Parser:       &CargoTestParser{},
 		Priority:     framework.PriorityGeneric,
 	}
@@ -85,6 +92,11 @@ var cargoTestPatterns = []struct {
 	{regexp.MustCompile(`#\[cfg\(test\)\]`), "#[cfg(test)] attribute"},
 	{regexp.MustCompile(`#\[ignore\]`), "#[ignore] attribute"},
 	{regexp.MustCompile(`#\[should_panic`), "#[should_panic] attribute"},
+	{regexp.MustCompile(`#\[rstest\]`), "#[rstest] attribute"},
+	{regexp.MustCompile(`#\[test_case\(`), "#[test_case] attribute"},
+	{regexp.MustCompile(`#\[\w+::test\b`), "async runtime #[...::test] attribute"},
+	{regexp.MustCompile(`#\[datatest::(?:files|data)\b`), "#[datatest::files] attribute"},
+	{regexp.MustCompile(`proptest!\s*\{`), "proptest! block"},
 	{regexp.MustCompile(`\w*test\w*!\s*\(`), "macro-based test pattern"},
 }
 
@@ -111,6 +123,16 @@ func (m *CargoTestContentMatcher) Match(ctx context.Context, signal framework.Si
 type CargoTestParser struct{}
 
 func (p *CargoTestParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	return p.parse(ctx, source, filename, nil)
+}
+
+// ParseWithScope names integration test suites after the [[test]] targets of the
+// package's Cargo.toml and recognizes the custom harnesses of harness = false targets.
+func (p *CargoTestParser) ParseWithScope(ctx context.Context, source []byte, filename string, scope *framework.ConfigScope) (*domain.TestFile, error) {
+	return p.parse(ctx, source, filename, scope)
+}
+
+func (p *CargoTestParser) parse(ctx context.Context, source []byte, filename string, scope *framework.ConfigScope) (*domain.TestFile, error) {
 	tree, err := parser.ParseWithPool(ctx, domain.LanguageRust, source)
 	if err != nil {
 		return nil, fmt.Errorf("cargo-test parser: failed to parse %s: %w", filename, err)
@@ -124,15 +146,53 @@ func (p *CargoTestParser) Parse(ctx context.Context, source []byte, filename str
 		Framework: frameworkName,
 	}
 
+	target, harness := testTargetOf(filename, scope)
+
 	// Use WalkTree for depth-protected traversal (prevents stack overflow)
-	parseRustAST(root, source, filename, file)
+	parseRustAST(root, source, filename, file, usesCustomHarness(source, harness, scope))
+
+	if target != "" && (len(file.Tests) > 0 || len(file.Suites) > 0) {
+		file.Suites = []domain.TestSuite{{
+			Name:     target,
+			Status:   domain.TestStatusActive,
+			Location: parser.GetLocation(root, filename),
+			Suites:   file.Suites,
+			Tests:    file.Tests,
+		}}
+		file.Tests = nil
+	}
+
 	return file, nil
 }
 
+// integrationTestCrate returns the crate name for files in a Cargo integration test
+// directory. Each tests/<name>.rs file and each tests/<name>/ directory (main.rs plus
+// submodules) compiles to its own crate. Returns "" for files outside tests/ or for
+// tests/ directories nested under src/ (unit test modules).
+func integrationTestCrate(filename string) string {
+	segments := strings.Split(filepath.ToSlash(filename), "/")
+
+	for i, segment := range segments[:len(segments)-1] {
+		switch segment {
+		case "src":
+			return ""
+		case "tests":
+			crate := segments[i+1]
+			if i+1 == len(segments)-1 {
+				crate = strings.TrimSuffix(crate, ".rs")
+			}
+			return crate
+		}
+	}
+	return ""
+}
+
 // parseRustAST traverses the AST using depth-protected WalkTree.
 // It handles test modules and test functions at the top level and within #[cfg(test)] modules.
 // Uses 2-pass approach: first collects test-generating macro definitions, then processes tests.
-func parseRustAST(root *sitter.Node, source []byte, filename string, file *domain.TestFile) {
+// With customHarness, the trials of libtest-mimic and the harness! of datatest-stable are
+// tests too.
+func parseRustAST(root *sitter.Node, source []byte, filename string, file *domain.TestFile, customHarness bool) {
 	// Track test modules by node start byte position to associate tests with their parent suite
 	testModules := make(map[uint32]*domain.TestSuite)
 
@@ -160,7 +220,8 @@ func parseRustAST(root *sitter.Node, source []byte, filename string, file *domai
 		case nodeFunctionItem:
 			attrs := collectAttributes(node, source)
 			if !attrs.isTest {
-				return false // Skip non-test functions
+				// Skip non-test functions, except for the main of a custom harness
+				return customHarness
 			}
 
 			name := extractFunctionName(node, source)
@@ -168,10 +229,20 @@ func parseRustAST(root *sitter.Node, source []byte, filename string, file *domai
 				return false
 			}
 
-			test := buildTest(name, attrs, node, filename)
-
-			// Find parent test module, if any
 			parentSuite := findParentTestSuite(node, testModules)
+
+			// Parameterized tests (#[rstest] + #[case], #[test_case]) expand into a suite per function
+			if len(attrs.cases) > 0 {
+				suite := buildCaseSuite(name, attrs, node, filename)
+				if parentSuite != nil {
+					parentSuite.Suites = append(parentSuite.Suites, suite)
+				} else {
+					file.Suites = append(file.Suites, suite)
+				}
+				return false
+			}
+
+			test := buildTest(name, attrs, node, filename)
 			if parentSuite != nil {
 				parentSuite.Tests = append(parentSuite.Tests, test)
 			} else {
@@ -179,7 +250,37 @@ func parseRustAST(root *sitter.Node, source []byte, filename string, file *domai
 			}
 			return false // No need to traverse into function body
 
+		case nodeCallExpression:
+			if customHarness {
+				if test, ok := trialCallTest(node, source, filename); ok {
+					addTests(findParentTestSuite(node, testModules), file, test)
+				}
+			}
+			return true
+
+		case nodeTokenTree:
+			if customHarness {
+				addTests(findParentTestSuite(node, testModules), file, trialTokenTests(node, source, filename)...)
+			}
+			return true
+
 		case nodeMacroInvocation:
+			if customHarness && isHarnessMacro(node, source) {
+				addTests(findParentTestSuite(node, testModules), file, extractHarnessTests(node, source, filename)...)
+				return false
+			}
+
+			if isProptestBlock(node, source) {
+				tests := extractProptestFunctions(node, source, filename)
+				parentSuite := findParentTestSuite(node, testModules)
+				if parentSuite != nil {
+					parentSuite.Tests = append(parentSuite.Tests, tests...)
+				} else {
+					file.Tests = append(file.Tests, tests...)
+				}
+				return false
+			}
+
 			macroName, testName := extractMacroTest(node, source)
 			if macroName == "" || testName == "" {
 				return true // Continue traversal
@@ -217,6 +318,15 @@ func parseRustAST(root *sitter.Node, source []byte, filename string, file *domai
 	}
 }
 
+// addTests adds tests to the parent test module, or else to the file.
+func addTests(parentSuite *domain.TestSuite, file *domain.TestFile, tests ...domain.Test) {
+	if parentSuite != nil {
+		parentSuite.Tests = append(parentSuite.Tests, tests...)
+	} else {
+		file.Tests = append(file.Tests, tests...)
+	}
+}
+
 // collectTestMacroDefinitions finds macro_rules! definitions that generate #[test] functions.
 // Returns a map of macro names that should be treated as test macros.
 func collectTestMacroDefinitions(root *sitter.Node, source []byte) map[string]bool {
@@ -339,6 +449,37 @@ func findParentTestSuite(node *sitter.Node, testModules map[uint32]*domain.TestS
 
 // buildTest creates a Test from function attributes.
 func buildTest(name string, attrs testAttributes, node *sitter.Node, filename string) domain.Test {
+	status, modifier := attrs.statusAndModifier()
+
+	return domain.Test{
+		Name:     name,
+		Status:   status,
+		Modifier: modifier,
+		Location: parser.GetLocation(node, filename),
+	}
+}
+
+// buildCaseSuite creates a suite for a parameterized test function with one test per case.
+func buildCaseSuite(name string, attrs testAttributes, node *sitter.Node, filename string) domain.TestSuite {
+	status, modifier := attrs.statusAndModifier()
+
+	suite := domain.TestSuite{
+		Name:     name,
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(node, filename),
+	}
+	for _, c := range attrs.cases {
+		suite.Tests = append(suite.Tests, domain.Test{
+			Name:     c.name,
+			Status:   status,
+			Modifier: modifier,
+			Location: parser.GetLocation(c.node, filename),
+		})
+	}
+	return suite
+}
+
+func (attrs testAttributes) statusAndModifier() (domain.TestStatus, string) {
 	status := domain.TestStatusActive
 	modifier := ""
 
@@ -355,18 +496,20 @@ func buildTest(name string, attrs testAttributes, node *sitter.Node, filename st
 		}
 	}
 
-	return domain.Test{
-		Name:     name,
-		Status:   status,
-		Modifier: modifier,
-		Location: parser.GetLocation(node, filename),
-	}
+	return status, modifier
 }
 
 type testAttributes struct {
 	isTest      bool
 	isIgnore    bool
 	shouldPanic string // Full attribute text (e.g., "#[should_panic(expected = \"...\")]")
+	cases       []testCase
+}
+
+// testCase is a single case of a parameterized test (#[case(...)] or #[test_case(...)]).
+type testCase struct {
+	name string
+	node *sitter.Node
 }
 
 // getPrecedingAttributes returns attribute_item nodes immediately preceding the given node.
@@ -400,24 +543,208 @@ func getPrecedingAttributes(node *sitter.Node) []*sitter.Node {
 	return attrs
 }
 
+// collectAttributes recognizes test attributes on a function:
+//   - #[test] and async runtime variants (#[tokio::test], #[async_std::test], ...)
+//   - #[datatest::files(...)] and #[datatest::data(...)]
+//   - #[rstest] with #[case(...)] / #[case::description(...)] expansion
+//   - #[test_case(...)] from the test-case crate
+//   - #[ignore] and #[should_panic] modifiers
 func collectAttributes(funcNode *sitter.Node, source []byte) testAttributes {
 	attrs := testAttributes{}
-
-	for _, attrNode := range getPrecedingAttributes(funcNode) {
-		attrName := extractAttributeName(attrNode, source)
-		switch attrName {
-		case "test":
+	isRstest := false
+	var rstestCases []*sitter.Node
+
+	preceding := getPrecedingAttributes(funcNode)
+	// getPrecedingAttributes walks backwards; iterate in source order for case numbering
+	for i := len(preceding) - 1; i >= 0; i-- {
+		attrNode := preceding[i]
+		path := extractAttributePath(attrNode, source)
+		switch {
+		case path == "test" || strings.HasSuffix(path, "::test"):
+			attrs.isTest = true
+		case path == "datatest::files" || path == "datatest::data":
+			// Nightly datatest runs a test per matching file or data item.
 			attrs.isTest = true
-		case "ignore":
+		case path == "rstest":
+			isRstest = true
+		case path == "case" || strings.HasPrefix(path, "case::"):
+			rstestCases = append(rstestCases, attrNode)
+		case path == "test_case" || strings.HasSuffix(path, "::test_case"):
+			attrs.isTest = true
+			attrs.cases = append(attrs.cases, testCase{
+				name: extractTestCaseName(attrNode, source),
+				node: attrNode,
+			})
+		case path == "ignore":
 			attrs.isIgnore = true
-		case "should_panic":
+		case path == "should_panic":
 			attrs.shouldPanic = parser.GetNodeText(attrNode, source)
 		}
 	}
 
+	if isRstest {
+		attrs.isTest = true
+		for i, caseNode := range rstestCases {
+			name := fmt.Sprintf("case_%d", i+1)
+			if desc := strings.TrimPrefix(extractAttributePath(caseNode, source), "case::"); desc != "case" {
+				name += "_" + desc
+			}
+			attrs.cases = append(attrs.cases, testCase{name: name, node: caseNode})
+		}
+	}
+
 	return attrs
 }
 
+// extractAttributePath returns the attribute path (e.g., "test", "tokio::test", "case::named").
+func extractAttributePath(attrItem *sitter.Node, source []byte) string {
+	attr := parser.FindChildByType(attrItem, nodeAttribute)
+	if attr == nil {
+		return ""
+	}
+
+	for i := 0; i < int(attr.ChildCount()); i++ {
+		child := attr.Child(i)
+		switch child.Type() {
+		case nodeIdentifier, nodeScopedIdentifier:
+			return strings.Join(strings.Fields(parser.GetNodeText(child, source)), "")
+		}
+	}
+
+	return extractAttributeName(attrItem, source)
+}
+
+// extractTestCaseName returns the description of #[test_case(args ; "description")],
+// falling back to the argument text when no description is given.
+func extractTestCaseName(attrItem *sitter.Node, source []byte) string {
+	attr := parser.FindChildByType(attrItem, nodeAttribute)
+	if attr == nil {
+		return ""
+	}
+	args := attr.ChildByFieldName("arguments")
+	if args == nil {
+		return ""
+	}
+
+	afterSemicolon := false
+	for i := 0; i < int(args.ChildCount()); i++ {
+		child := args.Child(i)
+		if child.Type() == ";" {
+			afterSemicolon = true
+			continue
+		}
+		if afterSemicolon && child.Type() == nodeStringLiteral {
+			if content := parser.FindChildByType(child, nodeStringContent); content != nil {
+				return parser.GetNodeText(content, source)
+			}
+			return strings.Trim(parser.GetNodeText(child, source), `"`)
+		}
+	}
+
+	text := parser.GetNodeText(args, source)
+	text = strings.TrimSuffix(strings.TrimPrefix(text, "("), ")")
+	return strings.TrimSpace(text)
+}
+
+// isProptestBlock reports whether node is a proptest! { ... } invocation.
+func isProptestBlock(node *sitter.Node, source []byte) bool {
+	macroField := node.ChildByFieldName("macro")
+	return macroField != nil && extractMacroName(macroField, source) == "proptest"
+}
+
+// extractProptestFunctions extracts #[test] functions declared inside a proptest! block,
+// as property tests.
+// Functions are plain tokens inside the macro, so the token stream is scanned for
+// attribute groups (# followed by [...]) preceding fn <name>.
+func extractProptestFunctions(node *sitter.Node, source []byte, filename string) []domain.Test {
+	tokenTree := parser.FindChildByType(node, nodeTokenTree)
+	if tokenTree == nil {
+		return nil
+	}
+
+	var tests []domain.Test
+	var pendingAttrs []string
+	var start *sitter.Node
+
+	count := int(tokenTree.ChildCount())
+	for i := 0; i < count; i++ {
+		child := tokenTree.Child(i)
+		text := parser.GetNodeText(child, source)
+
+		switch {
+		case text == "#" && i+1 < count && tokenTree.Child(i+1).Type() == nodeTokenTree:
+			if start == nil {
+				start = child
+			}
+			group := tokenTree.Child(i + 1)
+			if ident := parser.FindChildByType(group, nodeIdentifier); ident != nil {
+				pendingAttrs = append(pendingAttrs, parser.GetNodeText(ident, source))
+			}
+			i++
+
+		case text == "fn" && i+1 < count && tokenTree.Child(i+1).Type() == nodeIdentifier:
+			if start == nil {
+				start = child
+			}
+			nameNode := tokenTree.Child(i + 1)
+			end := nameNode
+			// Skip parameter list and return type up to the body
+			for j := i + 2; j < count; j++ {
+				next := tokenTree.Child(j)
+				if next.Type() == nodeTokenTree && strings.HasPrefix(parser.GetNodeText(next, source), "{") {
+					end = next
+					i = j
+					break
+				}
+			}
+
+			attrs := testAttributes{}
+			for _, attr := range pendingAttrs {
+				switch attr {
+				case "test":
+					attrs.isTest = true
+				case "ignore":
+					attrs.isIgnore = true
+				case "should_panic":
+					attrs.shouldPanic = "#[should_panic]"
+				}
+			}
+
+			if attrs.isTest {
+				status, modifier := attrs.statusAndModifier()
+				if modifier == "" {
+					modifier = "proptest!"
+				}
+				location := parser.GetLocation(start, filename)
+				endLocation := parser.GetLocation(end, filename)
+				location.EndLine = endLocation.EndLine
+				location.EndCol = endLocation.EndCol
+
+				tests = append(tests, domain.Test{
+					Name:     parser.GetNodeText(nameNode, source),
+					Status:   status,
+					Modifier: modifier,
+					Kind:     domain.TestKindProperty,
+					Location: location,
+				})
+			}
+
+			pendingAttrs = nil
+			start = nil
+
+		default:
+			// Inner attributes (#![proptest_config(...)]) and other tokens reset pending state
+			if text == "!" {
+				continue
+			}
+			pendingAttrs = nil
+			start = nil
+		}
+	}
+
+	return tests
+}
+
 func extractAttributeName(attrItem *sitter.Node, source []byte) string {
 	attr := parser.FindChildByType(attrItem, nodeAttribute)
 	if attr == nil {
@@ -2,6 +2,8 @@ package cargotest
 
 import (
 	"context"
+	"path/filepath"
+	"reflect"
 	"testing"
 
 	"github.com/specvital/core/pkg/domain"
@@ -578,6 +580,133 @@ rgtest!(test_rg, |dir, cmd| { assert!(true); });
 				}
 			},
 		},
+		{
+			name: "rstest cases expand into suite",
+			source: `
+use rstest::rstest;
+
+#[rstest]
+#[case(0, 0)]
+#[case::one(1, 1)]
+#[ignore]
+fn fibonacci(#[case] input: u32, #[case] expected: u32) {
+    assert_eq!(expected, fib(input));
+}
+
+#[rstest]
+fn with_fixture(repo: Repository) {}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				suite := file.Suites[0]
+				if suite.Name != "fibonacci" {
+					t.Errorf("expected suite 'fibonacci', got %q", suite.Name)
+				}
+				if len(suite.Tests) != 2 {
+					t.Fatalf("expected 2 cases, got %d", len(suite.Tests))
+				}
+				if suite.Tests[0].Name != "case_1" || suite.Tests[1].Name != "case_2_one" {
+					t.Errorf("unexpected case names: %q, %q", suite.Tests[0].Name, suite.Tests[1].Name)
+				}
+				if suite.Tests[0].Status != domain.TestStatusSkipped {
+					t.Errorf("expected ignored case to be skipped, got %q", suite.Tests[0].Status)
+				}
+				if len(file.Tests) != 1 || file.Tests[0].Name != "with_fixture" {
+					t.Errorf("expected rstest without cases as single test, got %+v", file.Tests)
+				}
+			},
+		},
+		{
+			name: "test_case attributes expand into suite",
+			source: `
+use test_case::test_case;
+
+#[test_case(4, 2 ; "when both operands are positive")]
+#[test_case(-4, 2)]
+fn multiplication(x: i8, y: i8) {}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				suite := file.Suites[0]
+				if len(suite.Tests) != 2 {
+					t.Fatalf("expected 2 cases, got %d", len(suite.Tests))
+				}
+				if suite.Tests[0].Name != "when both operands are positive" {
+					t.Errorf("expected description name, got %q", suite.Tests[0].Name)
+				}
+				if suite.Tests[1].Name != "-4, 2" {
+					t.Errorf("expected argument fallback name, got %q", suite.Tests[1].Name)
+				}
+			},
+		},
+		{
+			name: "async runtime test attributes",
+			source: `
+#[tokio::test]
+async fn tokio_test() {}
+
+#[tokio::test(flavor = "multi_thread")]
+#[should_panic(expected = "boom")]
+async fn tokio_panics() {}
+
+#[async_std::test]
+async fn async_std_test() {}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 3 {
+					t.Fatalf("expected 3 tests, got %d", len(file.Tests))
+				}
+				if file.Tests[1].Modifier != `#[should_panic(expected = "boom")]` {
+					t.Errorf("expected should_panic modifier, got %q", file.Tests[1].Modifier)
+				}
+			},
+		},
+		{
+			name: "proptest block functions",
+			source: `
+use proptest::prelude::*;
+
+proptest! {
+    #![proptest_config(ProptestConfig::with_cases(10))]
+
+    #[test]
+    fn doesnt_crash(s in "\\PC*") {
+        parse_date(&s);
+    }
+
+    #[test]
+    #[ignore]
+    fn slow_roundtrip(a in 0..10u32) -> Result<(), TestCaseError> {
+        Ok(())
+    }
+
+    fn helper(a in 0..10) {}
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 2 {
+					t.Fatalf("expected 2 tests, got %d", len(file.Tests))
+				}
+				if file.Tests[0].Name != "doesnt_crash" || file.Tests[0].Modifier != "proptest!" {
+					t.Errorf("unexpected first test: %+v", file.Tests[0])
+				}
+				if file.Tests[1].Name != "slow_roundtrip" || file.Tests[1].Status != domain.TestStatusSkipped {
+					t.Errorf("unexpected second test: %+v", file.Tests[1])
+				}
+				for _, test := range file.Tests {
+					if test.Kind != domain.TestKindProperty {
+						t.Errorf("%s: expected kind %q, got %q", test.Name, domain.TestKindProperty, test.Kind)
+					}
+				}
+				if file.Tests[0].Location.StartLine != 7 || file.Tests[0].Location.EndLine != 10 {
+					t.Errorf("unexpected location: %+v", file.Tests[0].Location)
+				}
+			},
+		},
 	}
 
 	parser := &CargoTestParser{}
@@ -605,6 +734,307 @@ rgtest!(test_rg, |dir, cmd| { assert!(true); });
 	}
 }
 
+func TestCargoTestParser_IntegrationTestCrates(t *testing.T) {
+	source := `
+#[test]
+fn it_works() {}
+
+mod nested {
+    #[test]
+    fn inner() {}
+}
+`
+
+	tests := []struct {
+		name      string
+		filename  string
+		wantCrate string
+	}{
+		{"single-file crate", "tests/api.rs", "api"},
+		{"directory crate main", "tests/cli/main.rs", "cli"},
+		{"directory crate submodule", "crates/core/tests/cli/commands.rs", "cli"},
+		{"unit test module under src", "src/tests/helpers.rs", ""},
+		{"source file", "src/lib.rs", ""},
+	}
+
+	parser := &CargoTestParser{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := parser.Parse(ctx, []byte(source), tt.filename)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if tt.wantCrate == "" {
+				if len(file.Tests) != 2 || len(file.Suites) != 0 {
+					t.Errorf("expected ungrouped top-level tests, got %d tests and %d suites", len(file.Tests), len(file.Suites))
+				}
+				return
+			}
+
+			if len(file.Tests) != 0 || len(file.Suites) != 1 {
+				t.Fatalf("expected single crate suite, got %d tests and %d suites", len(file.Tests), len(file.Suites))
+			}
+			crate := file.Suites[0]
+			if crate.Name != tt.wantCrate {
+				t.Errorf("expected crate %q, got %q", tt.wantCrate, crate.Name)
+			}
+			if crate.CountTests() != 2 {
+				t.Errorf("expected 2 tests in crate, got %d", crate.CountTests())
+			}
+		})
+	}
+}
+
+func TestCargoConfigParser_Parse(t *testing.T) {
+	t.Run("package", func(t *testing.T) {
+		content := `[package]
+name = "shop-core"   # the core crate
+autotests = false
+
+[dev-dependencies]
+libtest-mimic = "0.7"
+serde_json.workspace = true
+
+[dev-dependencies.insta]
+version = "1"
+
+[target.'cfg(unix)'.dev-dependencies]
+nix = "0.27"
+
+[[test]]
+name = "fixtures"
+path = "tests/fixtures/main.rs"
+harness = false
+
+[[test]]
+name = "api"
+
+[[bench]]
+name = "orders"
+harness = false
+`
+		scope, err := (&CargoConfigParser{}).Parse(context.Background(), filepath.FromSlash("/repo/crates/core/Cargo.toml"), []byte(content))
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		if scope.Framework != frameworkName {
+			t.Errorf("expected framework %q, got %q", frameworkName, scope.Framework)
+		}
+		if len(scope.Projects) != 1 || scope.Projects[0].Name != "shop-core" {
+			t.Errorf("expected project shop-core, got %+v", scope.Projects)
+		}
+
+		wantTargets := []testTarget{
+			{Name: "fixtures", Path: "tests/fixtures/main.rs", Harness: false},
+			{Name: "api", Harness: true},
+		}
+		if targets, _ := scope.Settings[settingTestTargets].([]testTarget); !reflect.DeepEqual(targets, wantTargets) {
+			t.Errorf("expected targets %+v, got %+v", wantTargets, targets)
+		}
+		wantDeps := []string{"libtest-mimic", "serde_json", "insta", "nix"}
+		if deps, _ := scope.Settings[settingDevDependencies].([]string); !reflect.DeepEqual(deps, wantDeps) {
+			t.Errorf("expected dev-dependencies %v, got %v", wantDeps, deps)
+		}
+
+		for path, want := range map[string]bool{
+			"/repo/crates/core/src/orders.rs":             true,
+			"/repo/crates/core/tests/fixtures/main.rs":    true,
+			"/repo/crates/core/tests/fixtures/yaml.rs":    true,
+			"/repo/crates/core/tests/api.rs":              true,
+			"/repo/crates/core/tests/api/client.rs":       true,
+			"/repo/crates/core/tests/smoke.rs":            false,
+			"/repo/crates/core/benches/orders.rs":         false,
+			"/repo/crates/core/build.rs":                  false,
+			"/repo/crates/core/target/debug/build/out.rs": false,
+		} {
+			filePath := filepath.FromSlash(path)
+			if !scope.Decides(filePath) {
+				t.Errorf("expected Decides(%s)", path)
+			}
+			if got := scope.Collects(filePath); got != want {
+				t.Errorf("Collects(%s) = %v, want %v", path, got, want)
+			}
+		}
+	})
+
+	t.Run("virtual workspace", func(t *testing.T) {
+		content := `[workspace]
+resolver = "2"
+members = [
+    "crates/*",  # every crate
+    "xtask",
+]
+exclude = ["crates/legacy"]
+
+[workspace.dependencies]
+serde = "1"
+`
+		scope, err := (&CargoConfigParser{}).Parse(context.Background(), filepath.FromSlash("/repo/Cargo.toml"), []byte(content))
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		if want := []string{"crates/*", "xtask", "!crates/legacy"}; !reflect.DeepEqual(scope.ProjectPatterns, want) {
+			t.Errorf("expected project patterns %v, got %v", want, scope.ProjectPatterns)
+		}
+		if len(scope.Projects) != 0 {
+			t.Errorf("expected no package project, got %+v", scope.Projects)
+		}
+
+		filePath := filepath.FromSlash("/repo/scripts/gen.rs")
+		if !scope.Decides(filePath) || scope.Collects(filePath) {
+			t.Errorf("expected %s to be decided as not a test", filePath)
+		}
+	})
+}
+
+func TestCargoTestParser_ParseWithScope(t *testing.T) {
+	source := `
+#[test]
+fn it_works() {}
+`
+	scope := framework.NewConfigScope(filepath.FromSlash("/repo/crates/core/Cargo.toml"), "")
+	scope.Settings[settingTestTargets] = []testTarget{
+		{Name: "integration", Path: "it/main.rs", Harness: true},
+		{Name: "api", Harness: true},
+	}
+	scope.Settings[settingAutotests] = false
+
+	tests := []struct {
+		filename   string
+		wantTarget string
+	}{
+		{"crates/core/it/main.rs", "integration"},
+		{"crates/core/it/orders.rs", "integration"},
+		{"crates/core/tests/api.rs", "api"},
+		{"crates/core/tests/api/client.rs", "api"},
+		{"crates/core/tests/smoke.rs", ""},
+		{"crates/core/src/lib.rs", ""},
+	}
+
+	parser := &CargoTestParser{}
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			file, err := parser.ParseWithScope(context.Background(), []byte(source), tt.filename, scope)
+			if err != nil {
+				t.Fatalf("ParseWithScope() error = %v", err)
+			}
+
+			var target string
+			if len(file.Suites) == 1 {
+				target = file.Suites[0].Name
+			}
+			if target != tt.wantTarget {
+				t.Errorf("expected target %q, got %q", tt.wantTarget, target)
+			}
+			if file.CountTests() != 1 {
+				t.Errorf("expected 1 test, got %d", file.CountTests())
+			}
+		})
+	}
+}
+
+func TestCargoTestParser_CustomHarness(t *testing.T) {
+	t.Run("libtest-mimic trials", func(t *testing.T) {
+		source := `
+use libtest_mimic::{Arguments, Trial};
+
+fn main() {
+    let args = Arguments::from_args();
+    let mut tests = vec![
+        Trial::test("check_toph", check_toph),
+        Trial::test("check_sine", check_sine).with_ignored_flag(true),
+    ];
+    tests.push(libtest_mimic::Trial::test("check_cosine", check_cosine));
+    libtest_mimic::run(&args, tests).exit();
+}
+`
+		file, err := (&CargoTestParser{}).Parse(context.Background(), []byte(source), "tests/trig.rs")
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		var got []string
+		for _, test := range file.Suites[0].Tests {
+			got = append(got, test.Name+":"+string(test.Status)+":"+test.Modifier)
+		}
+		want := []string{
+			"check_toph:active:Trial::test",
+			"check_sine:skipped:Trial::test",
+			"check_cosine:active:Trial::test",
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("datatest harness", func(t *testing.T) {
+		source := `
+fn parse_fixture(path: &Path) -> datatest_stable::Result<()> { Ok(()) }
+
+datatest_stable::harness!(parse_fixture, "tests/fixtures", r"^.*\.toml$");
+datatest_stable::harness! {
+    { test = lex_fixture, root = "tests/lex", pattern = r"^.*$" },
+}
+`
+		file, err := (&CargoTestParser{}).Parse(context.Background(), []byte(source), "src/lib.rs")
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		var got []string
+		for _, test := range file.Tests {
+			got = append(got, test.Name+":"+test.Modifier)
+		}
+		if want := []string{"parse_fixture:harness!", "lex_fixture:harness!"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("harness = false target", func(t *testing.T) {
+		source := `
+use harness::run;
+
+fn main() {
+    run(vec![Trial::test("imported", imported)]);
+}
+`
+		scope := framework.NewConfigScope(filepath.FromSlash("/repo/Cargo.toml"), "")
+		scope.Settings[settingTestTargets] = []testTarget{{Name: "custom", Harness: false}}
+
+		parser := &CargoTestParser{}
+		file, err := parser.ParseWithScope(context.Background(), []byte(source), "tests/custom.rs", scope)
+		if err != nil {
+			t.Fatalf("ParseWithScope() error = %v", err)
+		}
+		if file.CountTests() != 1 {
+			t.Errorf("expected the trial of a harness = false target, got %d tests", file.CountTests())
+		}
+
+		file, err = parser.Parse(context.Background(), []byte(source), "tests/custom.rs")
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		if file.CountTests() != 0 {
+			t.Errorf("expected no tests without a harness, got %d", file.CountTests())
+		}
+	})
+
+	t.Run("nightly datatest attributes", func(t *testing.T) {
+		source := `
+#[datatest::files("tests/cases", { input in r"^(.*)\.yaml" })]
+fn sample(input: &str) {}
+`
+		file, err := (&CargoTestParser{}).Parse(context.Background(), []byte(source), "tests/datatests.rs")
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		if file.CountTests() != 1 {
+			t.Errorf("expected 1 test, got %d", file.CountTests())
+		}
+	})
+}
+
 func TestCargoTestFileMatcher_Match(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -651,6 +1081,10 @@ func TestCargoTestContentMatcher_Match(t *testing.T) {
 		{"macro-based test rgtest", "rgtest!(my_test, |dir| {});", true},
 		{"macro-based test quicktest", "quicktest!(test_case, || {});", true},
 		{"non-test macro", "println!(\"test\");", false},
+		{"rstest attribute", "#[rstest]\nfn fixture_test() {}", true},
+		{"test_case attribute", "#[test_case(1)]\nfn case() {}", true},
+		{"tokio test attribute", "#[tokio::test]\nasync fn run() {}", true},
+		{"proptest block", "proptest! {\n    #[test]\n    fn prop(x in 0..1) {}\n}", true},
 	}
 
 	matcher := &CargoTestContentMatcher{}
@@ -0,0 +1,204 @@
+package cargotest
+
+import (
+	"slices"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+const (
+	nodeCallExpression   = "call_expression"
+	nodeFieldExpression  = "field_expression"
+	nodeRawStringLiteral = "raw_string_literal"
+
+	trialModifier   = "Trial::test"
+	harnessModifier = "harness!"
+)
+
+// harnessCratePaths are the crate paths of harnessCrates as they appear in source.
+var harnessCratePaths = []string{"libtest_mimic", "datatest_stable"}
+
+// testTargetOf returns the integration test target the file belongs to, or "" for
+// unit tests, and whether libtest runs the target. Without a Cargo.toml scope, a
+// tests/ file belongs to the target named after its file or directory.
+func testTargetOf(filename string, scope *framework.ConfigScope) (name string, harness bool) {
+	if scope == nil {
+		return integrationTestCrate(filename), true
+	}
+
+	relPath := scope.RelPath(filename)
+	targets, _ := scope.Settings[settingTestTargets].([]testTarget)
+	for _, target := range targets {
+		if target.contains(relPath) {
+			return target.Name, target.Harness
+		}
+	}
+	if autotests, _ := scope.Settings[settingAutotests].(bool); autotests {
+		return integrationTestCrate(relPath), true
+	}
+	return "", true
+}
+
+// usesCustomHarness reports whether the file may run its tests through a custom
+// harness: it belongs to a harness = false target, names a harness crate, or its
+// package dev-depends on one.
+func usesCustomHarness(source []byte, harness bool, scope *framework.ConfigScope) bool {
+	if !harness {
+		return true
+	}
+	for _, crate := range harnessCratePaths {
+		if strings.Contains(string(source), crate) {
+			return true
+		}
+	}
+	if scope != nil {
+		deps, _ := scope.Settings[settingDevDependencies].([]string)
+		for _, crate := range harnessCrates {
+			if slices.Contains(deps, crate) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// trialCallTest returns the test of a libtest-mimic Trial::test("name", runner) call.
+func trialCallTest(node *sitter.Node, source []byte, filename string) (domain.Test, bool) {
+	function := node.ChildByFieldName("function")
+	if function == nil || function.Type() != nodeScopedIdentifier || !isTrialTestPath(parser.GetNodeText(function, source)) {
+		return domain.Test{}, false
+	}
+	args := node.ChildByFieldName("arguments")
+	if args == nil || args.NamedChildCount() == 0 {
+		return domain.Test{}, false
+	}
+	name, ok := literalString(args.NamedChild(0), source)
+	if !ok {
+		return domain.Test{}, false
+	}
+
+	ignored := false
+	if field := node.Parent(); field != nil && field.Type() == nodeFieldExpression {
+		if call := field.Parent(); call != nil && call.Type() == nodeCallExpression {
+			fieldName := field.ChildByFieldName("field")
+			callArgs := call.ChildByFieldName("arguments")
+			ignored = fieldName != nil && callArgs != nil &&
+				parser.GetNodeText(fieldName, source) == "with_ignored_flag" &&
+				parser.GetNodeText(callArgs, source) == "(true)"
+		}
+	}
+	return trialTest(name, ignored, node, filename), true
+}
+
+// trialTokenTests returns the tests of Trial::test("name", runner) calls written inside
+// a macro (vec![...]), where they are plain tokens.
+func trialTokenTests(tokenTree *sitter.Node, source []byte, filename string) []domain.Test {
+	var tests []domain.Test
+	count := int(tokenTree.ChildCount())
+	text := func(i int) string {
+		if i >= count {
+			return ""
+		}
+		return parser.GetNodeText(tokenTree.Child(i), source)
+	}
+
+	for i := 0; i+3 < count; i++ {
+		if text(i) != "Trial" || text(i+1) != "::" || text(i+2) != "test" || tokenTree.Child(i+3).Type() != nodeTokenTree {
+			continue
+		}
+		args := tokenTree.Child(i + 3)
+		if args.NamedChildCount() == 0 {
+			continue
+		}
+		name, ok := literalString(args.NamedChild(0), source)
+		if !ok {
+			continue
+		}
+		ignored := text(i+4) == "." && text(i+5) == "with_ignored_flag" && text(i+6) == "(true)"
+		tests = append(tests, trialTest(name, ignored, tokenTree.Child(i), filename))
+	}
+	return tests
+}
+
+func trialTest(name string, ignored bool, node *sitter.Node, filename string) domain.Test {
+	status := domain.TestStatusActive
+	if ignored {
+		status = domain.TestStatusSkipped
+	}
+	return domain.Test{
+		Name:     name,
+		Status:   status,
+		Modifier: trialModifier,
+		Location: parser.GetLocation(node, filename),
+	}
+}
+
+func isTrialTestPath(text string) bool {
+	text = strings.Join(strings.Fields(text), "")
+	return text == "Trial::test" || strings.HasSuffix(text, "::Trial::test")
+}
+
+// isHarnessMacro reports whether node is a datatest-stable harness! invocation.
+func isHarnessMacro(node *sitter.Node, source []byte) bool {
+	macroField := node.ChildByFieldName("macro")
+	return macroField != nil && extractMacroName(macroField, source) == "harness"
+}
+
+// extractHarnessTests returns a test per test function of a datatest-stable harness!:
+// harness!(parse, "tests/fixtures", r"^.*$") or harness! { { test = parse, ... } }.
+func extractHarnessTests(node *sitter.Node, source []byte, filename string) []domain.Test {
+	var tests []domain.Test
+	var walk func(tokenTree *sitter.Node)
+	walk = func(tokenTree *sitter.Node) {
+		count := int(tokenTree.ChildCount())
+		for i := 0; i < count; i++ {
+			child := tokenTree.Child(i)
+			if child.Type() == nodeTokenTree {
+				walk(child)
+				continue
+			}
+			if child.Type() != nodeIdentifier || i+2 >= count {
+				continue
+			}
+
+			next, after := tokenTree.Child(i+1), tokenTree.Child(i+2)
+			var fn *sitter.Node
+			switch {
+			case parser.GetNodeText(child, source) == "test" && next.Type() == "=" && after.Type() == nodeIdentifier:
+				fn = after
+			case next.Type() == "," && (after.Type() == nodeStringLiteral || after.Type() == nodeRawStringLiteral):
+				fn = child
+			default:
+				continue
+			}
+			tests = append(tests, domain.Test{
+				Name:     parser.GetNodeText(fn, source),
+				Status:   domain.TestStatusActive,
+				Modifier: harnessModifier,
+				Location: parser.GetLocation(fn, filename),
+			})
+		}
+	}
+
+	if tokenTree := parser.FindChildByType(node, nodeTokenTree); tokenTree != nil {
+		walk(tokenTree)
+	}
+	return tests
+}
+
+// literalString returns the content of a string literal node.
+func literalString(node *sitter.Node, source []byte) (string, bool) {
+	if node == nil || node.Type() != nodeStringLiteral {
+		return "", false
+	}
+	content := parser.FindChildByType(node, nodeStringContent)
+	if content == nil {
+		return "", false
+	}
+	return parser.GetNodeText(content, source), true
+}
@@ -0,0 +1,296 @@
+// Package catch2 implements Catch2 framework support for C++ test files.
+package catch2
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+	"github.com/specvital/core/pkg/parser/framework"
+	"github.com/specvital/core/pkg/parser/framework/matchers"
+	"github.com/specvital/core/pkg/parser/strategies/shared/cppast"
+)
+
+const frameworkName = framework.FrameworkCatch2
+
+// macroPrefix is prepended to all macros when CATCH_CONFIG_PREFIX_ALL is defined.
+const macroPrefix = "CATCH_"
+
+// testCaseMacro describes where a test case macro keeps its name and tag arguments.
+type testCaseMacro struct {
+	nameArg    int
+	tagsArg    int
+	namePrefix string
+}
+
+var testCaseMacros = map[string]testCaseMacro{
+	"TEST_CASE":                         {nameArg: 0, tagsArg: 1},
+	"TEST_CASE_METHOD":                  {nameArg: 1, tagsArg: 2},
+	"METHOD_AS_TEST_CASE":               {nameArg: 1, tagsArg: 2},
+	"SCENARIO":                          {nameArg: 0, tagsArg: 1, namePrefix: "Scenario: "},
+	"SCENARIO_METHOD":                   {nameArg: 1, tagsArg: 2, namePrefix: "Scenario: "},
+	"TEMPLATE_TEST_CASE":                {nameArg: 0, tagsArg: 1},
+	"TEMPLATE_TEST_CASE_SIG":            {nameArg: 0, tagsArg: 1},
+	"TEMPLATE_PRODUCT_TEST_CASE":        {nameArg: 0, tagsArg: 1},
+	"TEMPLATE_PRODUCT_TEST_CASE_SIG":    {nameArg: 0, tagsArg: 1},
+	"TEMPLATE_LIST_TEST_CASE":           {nameArg: 0, tagsArg: 1},
+	"TEMPLATE_TEST_CASE_METHOD":         {nameArg: 1, tagsArg: 2},
+	"TEMPLATE_TEST_CASE_METHOD_SIG":     {nameArg: 1, tagsArg: 2},
+	"TEMPLATE_PRODUCT_TEST_CASE_METHOD": {nameArg: 1, tagsArg: 2},
+	"TEMPLATE_LIST_TEST_CASE_METHOD":    {nameArg: 1, tagsArg: 2},
+}
+
+// sectionPrefixes maps section macros to the name prefix Catch2 reports.
+var sectionPrefixes = map[string]string{
+	"SECTION":   "",
+	"GIVEN":     "Given: ",
+	"AND_GIVEN": "And given: ",
+	"WHEN":      "When: ",
+	"AND_WHEN":  "And when: ",
+	"THEN":      "Then: ",
+	"AND_THEN":  "And: ",
+}
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageCpp},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher(
+				"catch2/",
+				"catch.hpp",
+				"catch_amalgamated.hpp",
+			),
+			&Catch2ContentMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &Catch2Parser{},
+		Priority:     framework.PriorityGeneric,
+	}
+}
+
+// Catch2ContentMatcher matches Catch2-specific patterns in file content.
+// TEST_CASE is shared with doctest, so files with doctest-only markers are rejected.
+type Catch2ContentMatcher struct{}
+
+var catch2Patterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`#include\s*[<"]catch2/`), "#include <catch2/...>"},
+	{regexp.MustCompile(`#include\s*[<"]catch\.hpp[>"]`), "#include <catch.hpp>"},
+	{regexp.MustCompile(`\b(?:CATCH_)?TEST_CASE(?:_METHOD)?\s*\(`), "TEST_CASE() macro"},
+	{regexp.MustCompile(`\b(?:CATCH_)?SCENARIO\s*\(`), "SCENARIO() macro"},
+	{regexp.MustCompile(`\b(?:CATCH_)?TEMPLATE_(?:PRODUCT_|LIST_)?TEST_CASE\w*\s*\(`), "TEMPLATE_TEST_CASE() macro"},
+}
+
+var doctestMarkerPattern = regexp.MustCompile(`\bSUBCASE\s*\(|\bTEST_SUITE(?:_BEGIN)?\s*\(|\bDOCTEST_|doctest::`)
+
+func (m *Catch2ContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	if doctestMarkerPattern.Match(content) {
+		return framework.NoMatch()
+	}
+
+	for _, p := range catch2Patterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found Catch2 pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+// Catch2Parser extracts test definitions from C++ Catch2 files.
+type Catch2Parser struct{}
+
+func (p *Catch2Parser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	source = maskTypeLists(source)
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageCpp, source)
+	if err != nil {
+		return nil, fmt.Errorf("catch2 parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	file := &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageCpp,
+		Framework: frameworkName,
+	}
+
+	for _, m := range cppast.Macros(tree.RootNode(), source) {
+		spec, ok := testCaseMacros[strings.TrimPrefix(m.Name, macroPrefix)]
+		if !ok {
+			continue
+		}
+
+		name, ok := cppast.StringValue(m.Arg(spec.nameArg), source)
+		if !ok {
+			// METHOD_AS_TEST_CASE and anonymous TEST_CASE() have no literal name
+			name = m.ArgText(spec.nameArg, source)
+		}
+		if name == "" {
+			name = "Anonymous test case"
+		}
+
+		tags, _ := cppast.StringValue(m.Arg(spec.tagsArg), source)
+
+		test, suite := cppast.BuildCase(spec.namePrefix+name, m, parseTags(tags), sectionName, source, filename)
+		if suite != nil {
+			file.Suites = append(file.Suites, *suite)
+		} else {
+			file.Tests = append(file.Tests, *test)
+		}
+	}
+
+	return file, nil
+}
+
+// templateMacroPattern opens the arguments of the template test case macros.
+var templateMacroPattern = regexp.MustCompile(`\b(?:CATCH_)?TEMPLATE_\w*TEST_CASE\w*\s*\(`)
+
+// maskTypeLists blanks the type list arguments following the tags of template test
+// cases: types such as int or std::vector<int> are not expressions, and the error
+// recovery of tree-sitter would swallow the macros that follow. Same-length replacement
+// keeps node positions aligned with the original source.
+func maskTypeLists(source []byte) []byte {
+	var masked []byte
+	for _, loc := range templateMacroPattern.FindAllIndex(source, -1) {
+		name := strings.TrimPrefix(strings.TrimRight(string(source[loc[0]:loc[1]-1]), " \t\r\n"), macroPrefix)
+		spec, ok := testCaseMacros[name]
+		if !ok {
+			continue
+		}
+		start, end := typeListBounds(source, loc[1], spec.tagsArg+1)
+		if start < 0 {
+			continue
+		}
+		if masked == nil {
+			masked = append([]byte(nil), source...)
+		}
+		for i := start; i < end; i++ {
+			if masked[i] != '\n' {
+				masked[i] = ' '
+			}
+		}
+	}
+	if masked == nil {
+		return source
+	}
+	return masked
+}
+
+// typeListBounds returns the span from the comma ending the first args arguments of the
+// argument list starting at open to its closing parenthesis, or -1 when there is no
+// further argument.
+func typeListBounds(source []byte, open, args int) (int, int) {
+	depth, commas, start := 0, 0, -1
+	for i := open; i < len(source); i++ {
+		switch source[i] {
+		case '"', '\'':
+			for quote := source[i]; i+1 < len(source); {
+				i++
+				if source[i] == '\\' {
+					i++
+				} else if source[i] == quote {
+					break
+				}
+			}
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			if depth == 0 {
+				return start, i
+			}
+			depth--
+		case ',':
+			if depth == 0 {
+				if commas++; commas == args {
+					start = i
+				}
+			}
+		}
+	}
+	return -1, -1
+}
+
+// sectionName returns the display name for SECTION, DYNAMIC_SECTION and BDD-style sections.
+func sectionName(m cppast.Macro, source []byte) (string, bool) {
+	name := strings.TrimPrefix(m.Name, macroPrefix)
+
+	if name == "DYNAMIC_SECTION" {
+		return m.ArgText(0, source), true
+	}
+
+	prefix, ok := sectionPrefixes[name]
+	if !ok {
+		return "", false
+	}
+
+	value, ok := cppast.StringValue(m.Arg(0), source)
+	if !ok {
+		value = m.ArgText(0, source)
+	}
+	return prefix + value, true
+}
+
+// parseTags converts a Catch2 tag string such as "[db][.][!mayfail]" into test attributes.
+// Hidden tags ([.], [.name], [!hide]) skip the test by default; [!mayfail] and
+// [!shouldfail] mark it as expected to fail.
+func parseTags(tagString string) cppast.CaseAttributes {
+	attrs := cppast.CaseAttributes{Status: domain.TestStatusActive}
+
+	for _, tag := range splitTags(tagString) {
+		switch {
+		case tag == "." || tag == "!hide":
+			attrs.Status = domain.TestStatusSkipped
+			attrs.Modifier = "[" + tag + "]"
+			continue
+		case strings.HasPrefix(tag, "."):
+			attrs.Status = domain.TestStatusSkipped
+			attrs.Modifier = "[.]"
+			tag = strings.TrimPrefix(tag, ".")
+		case tag == "!mayfail" || tag == "!shouldfail":
+			if attrs.Status == domain.TestStatusActive {
+				attrs.Status = domain.TestStatusXfail
+				attrs.Modifier = "[" + tag + "]"
+			}
+		}
+		attrs.Tags = append(attrs.Tags, tag)
+	}
+
+	return attrs
+}
+
+func splitTags(tagString string) []string {
+	var tags []string
+	for {
+		open := strings.Index(tagString, "[")
+		if open < 0 {
+			return tags
+		}
+		closeIdx := strings.Index(tagString[open:], "]")
+		if closeIdx < 0 {
+			return tags
+		}
+		if tag := strings.TrimSpace(tagString[open+1 : open+closeIdx]); tag != "" {
+			tags = append(tags, tag)
+		}
+		tagString = tagString[open+closeIdx+1:]
+	}
+}
@@ -0,0 +1,369 @@
+package catch2
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+func TestCatch2Parser_Parse(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		checkFunc func(t *testing.T, file *domain.TestFile)
+	}{
+		{
+			name: "basic TEST_CASE with tags",
+			source: `
+#include <catch2/catch_test_macros.hpp>
+
+TEST_CASE("Factorials are computed", "[factorial][math]") {
+    REQUIRE(Factorial(1) == 1);
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 1 {
+					t.Fatalf("expected 1 test, got %d", len(file.Tests))
+				}
+				test := file.Tests[0]
+				if test.Name != "Factorials are computed" {
+					t.Errorf("expected name 'Factorials are computed', got %q", test.Name)
+				}
+				if test.Status != domain.TestStatusActive {
+					t.Errorf("expected status active, got %q", test.Status)
+				}
+				if !reflect.DeepEqual(test.Tags, []string{"factorial", "math"}) {
+					t.Errorf("expected tags [factorial math], got %v", test.Tags)
+				}
+			},
+		},
+		{
+			name: "sections become tests within a suite",
+			source: `
+#include <catch2/catch_test_macros.hpp>
+
+TEST_CASE("vectors can be sized and resized", "[vector]") {
+    std::vector<int> v(5);
+
+    SECTION("resizing bigger changes size") {
+        v.resize(10);
+        REQUIRE(v.size() == 10);
+    }
+    SECTION("reserving") {
+        SECTION("bigger changes capacity") {
+            v.reserve(10);
+        }
+        SECTION("smaller does not change capacity") {
+            v.reserve(0);
+        }
+    }
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 0 {
+					t.Errorf("expected 0 top-level tests, got %d", len(file.Tests))
+				}
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				suite := file.Suites[0]
+				if suite.Name != "vectors can be sized and resized" {
+					t.Errorf("unexpected suite name %q", suite.Name)
+				}
+				if len(suite.Tests) != 1 || suite.Tests[0].Name != "resizing bigger changes size" {
+					t.Errorf("expected leaf section test, got %+v", suite.Tests)
+				}
+				if len(suite.Tests) == 1 && !reflect.DeepEqual(suite.Tests[0].Tags, []string{"vector"}) {
+					t.Errorf("expected section to inherit tags, got %v", suite.Tests[0].Tags)
+				}
+				if len(suite.Suites) != 1 || len(suite.Suites[0].Tests) != 2 {
+					t.Fatalf("expected nested section suite with 2 tests, got %+v", suite.Suites)
+				}
+				if suite.Suites[0].Name != "reserving" {
+					t.Errorf("expected nested suite 'reserving', got %q", suite.Suites[0].Name)
+				}
+			},
+		},
+		{
+			name: "BDD-style scenario",
+			source: `
+#include <catch2/catch_test_macros.hpp>
+
+SCENARIO("vectors can be sized", "[bdd]") {
+    GIVEN("an empty vector") {
+        std::vector<int> v;
+        WHEN("an element is pushed") {
+            v.push_back(1);
+            THEN("the size grows") {
+                REQUIRE(v.size() == 1);
+            }
+        }
+    }
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				scenario := file.Suites[0]
+				if scenario.Name != "Scenario: vectors can be sized" {
+					t.Errorf("unexpected scenario name %q", scenario.Name)
+				}
+				if len(scenario.Suites) != 1 || scenario.Suites[0].Name != "Given: an empty vector" {
+					t.Fatalf("expected Given suite, got %+v", scenario.Suites)
+				}
+				when := scenario.Suites[0].Suites
+				if len(when) != 1 || when[0].Name != "When: an element is pushed" {
+					t.Fatalf("expected When suite, got %+v", when)
+				}
+				if len(when[0].Tests) != 1 || when[0].Tests[0].Name != "Then: the size grows" {
+					t.Errorf("expected Then test, got %+v", when[0].Tests)
+				}
+			},
+		},
+		{
+			name: "hidden and may-fail tags",
+			source: `
+#include <catch2/catch_test_macros.hpp>
+
+TEST_CASE("hidden", "[.]") {}
+TEST_CASE("hidden with name", "[.integration][db]") {}
+TEST_CASE("hide flag", "[!hide]") {}
+TEST_CASE("may fail", "[!mayfail]") {}
+TEST_CASE("should fail", "[!shouldfail]") {}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 5 {
+					t.Fatalf("expected 5 tests, got %d", len(file.Tests))
+				}
+				want := []struct {
+					status   domain.TestStatus
+					modifier string
+				}{
+					{domain.TestStatusSkipped, "[.]"},
+					{domain.TestStatusSkipped, "[.]"},
+					{domain.TestStatusSkipped, "[!hide]"},
+					{domain.TestStatusXfail, "[!mayfail]"},
+					{domain.TestStatusXfail, "[!shouldfail]"},
+				}
+				for i, w := range want {
+					if file.Tests[i].Status != w.status || file.Tests[i].Modifier != w.modifier {
+						t.Errorf("test %q: expected %q/%q, got %q/%q",
+							file.Tests[i].Name, w.status, w.modifier, file.Tests[i].Status, file.Tests[i].Modifier)
+					}
+				}
+				if !reflect.DeepEqual(file.Tests[1].Tags, []string{"integration", "db"}) {
+					t.Errorf("expected tags [integration db], got %v", file.Tests[1].Tags)
+				}
+			},
+		},
+		{
+			name: "fixture, template and method macros",
+			source: `
+#include <catch2/catch_all.hpp>
+
+class Fixture { protected: int x = 1; };
+
+TEST_CASE_METHOD(Fixture, "uses fixture", "[fixture]") {
+    REQUIRE(x == 1);
+}
+
+TEMPLATE_TEST_CASE("templated", "[template]", int, float) {
+    TestType value{};
+}
+
+METHOD_AS_TEST_CASE(Fixture::run, "method test", "[method]")
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 3 {
+					t.Fatalf("expected 3 tests, got %d", len(file.Tests))
+				}
+				names := []string{file.Tests[0].Name, file.Tests[1].Name, file.Tests[2].Name}
+				want := []string{"uses fixture", "templated", "method test"}
+				if !reflect.DeepEqual(names, want) {
+					t.Errorf("expected %v, got %v", want, names)
+				}
+			},
+		},
+		{
+			name: "template test cases before other macros",
+			source: `
+#include <catch2/catch_all.hpp>
+
+TEMPLATE_TEST_CASE("templated", "[template]", int, float) {
+    TestType value{};
+}
+
+TEST_CASE_METHOD(Fixture, "uses fixture", "[fixture]") {
+    REQUIRE(x == 1);
+}
+
+TEMPLATE_TEST_CASE_SIG("signature", "[template]", ((typename T, int V), T, V),
+                       (int, 5), (std::vector<int>, 2)) {
+    SECTION("sized") {}
+}
+
+TEMPLATE_PRODUCT_TEST_CASE("product", "[template]", (std::vector, std::list), (int, float)) {}
+
+TEST_CASE("plain", "[plain]") {}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				var names []string
+				for _, test := range file.Tests {
+					names = append(names, test.Name)
+				}
+				want := []string{"templated", "uses fixture", "product", "plain"}
+				if !reflect.DeepEqual(names, want) {
+					t.Errorf("expected tests %v, got %v", want, names)
+				}
+				if len(file.Suites) != 1 || file.Suites[0].Name != "signature" || len(file.Suites[0].Tests) != 1 {
+					t.Fatalf("expected suite 'signature' with its section, got %+v", file.Suites)
+				}
+				if line := file.Suites[0].Tests[0].Location.StartLine; line != 14 {
+					t.Errorf("expected section on line 14, got %d", line)
+				}
+			},
+		},
+		{
+			name: "CATCH_ prefixed macros",
+			source: `
+#define CATCH_CONFIG_PREFIX_ALL
+#include <catch2/catch_test_macros.hpp>
+
+CATCH_TEST_CASE("prefixed", "[prefix]") {
+    CATCH_SECTION("inner") {
+        CATCH_REQUIRE(true);
+    }
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				if file.Suites[0].Name != "prefixed" {
+					t.Errorf("expected suite 'prefixed', got %q", file.Suites[0].Name)
+				}
+				if len(file.Suites[0].Tests) != 1 || file.Suites[0].Tests[0].Name != "inner" {
+					t.Errorf("expected section 'inner', got %+v", file.Suites[0].Tests)
+				}
+			},
+		},
+		{
+			name: "test cases inside namespace",
+			source: `
+#include <catch2/catch_test_macros.hpp>
+
+namespace project::tests {
+
+TEST_CASE("in namespace") {
+    REQUIRE(true);
+}
+
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 1 || file.Tests[0].Name != "in namespace" {
+					t.Errorf("expected test 'in namespace', got %+v", file.Tests)
+				}
+			},
+		},
+	}
+
+	parser := &Catch2Parser{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := parser.Parse(ctx, []byte(tt.source), "test.cpp")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if file.Framework != frameworkName {
+				t.Errorf("expected framework %q, got %q", frameworkName, file.Framework)
+			}
+
+			if file.Language != domain.LanguageCpp {
+				t.Errorf("expected language Cpp, got %q", file.Language)
+			}
+
+			if tt.checkFunc != nil {
+				tt.checkFunc(t, file)
+			}
+		})
+	}
+}
+
+func TestCatch2ContentMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantMatch bool
+	}{
+		{"catch2 v3 include", "#include <catch2/catch_test_macros.hpp>", true},
+		{"catch2 v2 include", `#include "catch.hpp"`, true},
+		{"TEST_CASE macro", `TEST_CASE("name", "[tag]") {}`, true},
+		{"SCENARIO macro", `SCENARIO("name") {}`, true},
+		{"TEMPLATE_TEST_CASE macro", `TEMPLATE_TEST_CASE("name", "", int) {}`, true},
+		{"prefixed macro", `CATCH_TEST_CASE("name") {}`, true},
+		{"doctest SUBCASE", "TEST_CASE(\"name\") { SUBCASE(\"a\") {} }", false},
+		{"doctest TEST_SUITE", "TEST_SUITE(\"suite\") { TEST_CASE(\"name\") {} }", false},
+		{"doctest decorator", `TEST_CASE("name" * doctest::skip()) {}`, false},
+		{"gtest macro", "TEST(Suite, Test) {}", false},
+		{"plain cpp code", "int main() { return 0; }", false},
+	}
+
+	matcher := &Catch2ContentMatcher{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := framework.Signal{
+				Type:    framework.SignalFileContent,
+				Value:   tt.content,
+				Context: []byte(tt.content),
+			}
+			result := matcher.Match(ctx, signal)
+			if (result.Confidence > 0) != tt.wantMatch {
+				t.Errorf("Match() confidence = %v, want match = %v", result.Confidence, tt.wantMatch)
+			}
+		})
+	}
+}
+
+func TestParseTags(t *testing.T) {
+	tests := []struct {
+		name         string
+		tags         string
+		wantStatus   domain.TestStatus
+		wantModifier string
+		wantTags     []string
+	}{
+		{"empty", "", domain.TestStatusActive, "", nil},
+		{"plain tags", "[a][b]", domain.TestStatusActive, "", []string{"a", "b"}},
+		{"hidden dot", "[.][slow]", domain.TestStatusSkipped, "[.]", []string{"slow"}},
+		{"hidden prefix", "[.slow]", domain.TestStatusSkipped, "[.]", []string{"slow"}},
+		{"hide flag", "[!hide]", domain.TestStatusSkipped, "[!hide]", nil},
+		{"mayfail", "[!mayfail]", domain.TestStatusXfail, "[!mayfail]", []string{"!mayfail"}},
+		{"hidden wins over mayfail", "[.][!mayfail]", domain.TestStatusSkipped, "[.]", []string{"!mayfail"}},
+		{"whitespace between tags", "[a] [b]", domain.TestStatusActive, "", []string{"a", "b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := parseTags(tt.tags)
+			if attrs.Status != tt.wantStatus {
+				t.Errorf("status = %q, want %q", attrs.Status, tt.wantStatus)
+			}
+			if attrs.Modifier != tt.wantModifier {
+				t.Errorf("modifier = %q, want %q", attrs.Modifier, tt.wantModifier)
+			}
+			if !reflect.DeepEqual(attrs.Tags, tt.wantTags) {
+				t.Errorf("tags = %v, want %v", attrs.Tags, tt.wantTags)
+			}
+		})
+	}
+}
@@ -0,0 +1,303 @@
+// Package check implements Check (libcheck) framework support for C test files.
+package check
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+	"github.com/specvital/core/pkg/parser/framework"
+	"github.com/specvital/core/pkg/parser/framework/matchers"
+	"github.com/specvital/core/pkg/parser/strategies/shared/cppast"
+)
+
+const frameworkName = framework.FrameworkCheck
+
+const (
+	macroStartTest = "START_TEST"
+
+	funcSuiteCreate   = "suite_create"
+	funcTcaseCreate   = "tcase_create"
+	funcSuiteAddTcase = "suite_add_tcase"
+)
+
+// addTestFuncs register a test function (second argument) with a TCase (first argument).
+// Loop tests count as one test since their iteration range is evaluated at runtime.
+var addTestFuncs = map[string]bool{
+	"tcase_add_test":                   true,
+	"tcase_add_test_raise_signal":      true,
+	"tcase_add_exit_test":              true,
+	"tcase_add_loop_test":              true,
+	"tcase_add_loop_test_raise_signal": true,
+	"tcase_add_loop_exit_test":         true,
+}
+
+// endTestPattern matches the END_TEST terminator. tree-sitter cannot parse
+// START_TEST(name) { ... } END_TEST sequences, so END_TEST is blanked before parsing.
+var endTestPattern = regexp.MustCompile(`\bEND_TEST\b`)
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageC},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher("check.h"),
+			&CheckContentMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &CheckParser{},
+		Priority:     framework.PriorityGeneric,
+	}
+}
+
+// CheckContentMatcher matches Check-specific patterns in file content.
+type CheckContentMatcher struct{}
+
+var checkPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`#include\s*[<"]check\.h[>"]`), "#include <check.h>"},
+	{regexp.MustCompile(`\bSTART_TEST\s*\(`), "START_TEST() macro"},
+	{regexp.MustCompile(`\btcase_add_\w*test\w*\s*\(`), "tcase_add_test()"},
+	{regexp.MustCompile(`\bck_assert\w*\s*\(`), "ck_assert macro"},
+}
+
+func (m *CheckContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range checkPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found Check pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+// CheckParser extracts test definitions from C Check files.
+// START_TEST functions are grouped by the TCase they are added to, and TCases by the
+// Suite they are added to. Tests that are never registered remain at the top level.
+type CheckParser struct{}
+
+// registry records the suite_create/tcase_create/tcase_add_test wiring in a file.
+type registry struct {
+	suiteNames map[string]string // Suite variable -> suite name
+	tcaseNames map[string]string // TCase variable -> tcase name
+	testTcase  map[string]string // test function -> TCase variable
+	tcaseSuite map[string]string // TCase variable -> Suite variable
+	tcaseOrder []string
+	suiteOrder []string
+}
+
+func (p *CheckParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	// Same-length replacement keeps node positions aligned with the original source
+	parseSource := endTestPattern.ReplaceAllFunc(source, func(match []byte) []byte {
+		return []byte(strings.Repeat(" ", len(match)))
+	})
+
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageC, parseSource)
+	if err != nil {
+		return nil, fmt.Errorf("check parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	root := tree.RootNode()
+
+	file := &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageC,
+		Framework: frameworkName,
+	}
+
+	reg := collectRegistry(root, parseSource)
+
+	tcaseSuites := make(map[string]*domain.TestSuite)
+	for _, m := range cppast.Macros(root, parseSource) {
+		if m.Name != macroStartTest {
+			continue
+		}
+		name := m.ArgText(0, parseSource)
+		if name == "" {
+			continue
+		}
+
+		test := domain.Test{
+			Name:     name,
+			Status:   domain.TestStatusActive,
+			Location: m.Location(filename),
+		}
+
+		tcase, ok := reg.testTcase[name]
+		if !ok {
+			file.Tests = append(file.Tests, test)
+			continue
+		}
+		suite, ok := tcaseSuites[tcase]
+		if !ok {
+			suite = &domain.TestSuite{
+				Name:     reg.tcaseNames[tcase],
+				Status:   domain.TestStatusActive,
+				Location: m.Location(filename),
+			}
+			if suite.Name == "" {
+				suite.Name = unscopedName(tcase)
+			}
+			tcaseSuites[tcase] = suite
+		}
+		suite.Tests = append(suite.Tests, test)
+	}
+
+	file.Suites = reg.assemble(tcaseSuites)
+	return file, nil
+}
+
+func collectRegistry(root *sitter.Node, source []byte) *registry {
+	reg := &registry{
+		suiteNames: make(map[string]string),
+		tcaseNames: make(map[string]string),
+		testTcase:  make(map[string]string),
+		tcaseSuite: make(map[string]string),
+	}
+
+	parser.WalkTree(root, func(node *sitter.Node) bool {
+		if node.Type() != cppast.NodeCallExpression {
+			return true
+		}
+		args := cppast.CallArgs(node)
+		name := cppast.CallName(node, source)
+
+		switch {
+		case name == funcSuiteCreate || name == funcTcaseCreate:
+			variable := cppast.AssignedName(node, source)
+			value, ok := cppast.StringValue(firstArg(args), source)
+			if variable == "" || !ok {
+				return true
+			}
+			variable = scopedName(node, variable, source)
+			if name == funcSuiteCreate {
+				reg.suiteNames[variable] = value
+			} else {
+				reg.tcaseNames[variable] = value
+			}
+
+		case addTestFuncs[name] && len(args) >= 2:
+			tcase := scopedName(node, parser.GetNodeText(args[0], source), source)
+			fn := parser.GetNodeText(args[1], source)
+			if _, ok := reg.testTcase[fn]; !ok {
+				reg.testTcase[fn] = tcase
+			}
+			reg.addTcase(tcase)
+
+		case name == funcSuiteAddTcase && len(args) >= 2:
+			suite := scopedName(node, parser.GetNodeText(args[0], source), source)
+			tcase := scopedName(node, parser.GetNodeText(args[1], source), source)
+			reg.tcaseSuite[tcase] = suite
+			reg.addTcase(tcase)
+			reg.addSuite(suite)
+		}
+		return true
+	})
+
+	return reg
+}
+
+func (r *registry) addTcase(tcase string) {
+	for _, existing := range r.tcaseOrder {
+		if existing == tcase {
+			return
+		}
+	}
+	r.tcaseOrder = append(r.tcaseOrder, tcase)
+}
+
+func (r *registry) addSuite(suite string) {
+	for _, existing := range r.suiteOrder {
+		if existing == suite {
+			return
+		}
+	}
+	r.suiteOrder = append(r.suiteOrder, suite)
+}
+
+// assemble nests TCase suites under their Suite in registration order.
+// TCases not added to any Suite are returned at the top level.
+func (r *registry) assemble(tcaseSuites map[string]*domain.TestSuite) []domain.TestSuite {
+	var result []domain.TestSuite
+	parents := make(map[string]int)
+
+	for _, suiteVar := range r.suiteOrder {
+		parents[suiteVar] = -1
+	}
+
+	for _, tcase := range r.tcaseOrder {
+		tc, ok := tcaseSuites[tcase]
+		if !ok {
+			continue
+		}
+
+		suiteVar, ok := r.tcaseSuite[tcase]
+		if !ok {
+			result = append(result, *tc)
+			continue
+		}
+
+		index := parents[suiteVar]
+		if index < 0 {
+			name := r.suiteNames[suiteVar]
+			if name == "" {
+				name = unscopedName(suiteVar)
+			}
+			result = append(result, domain.TestSuite{
+				Name:     name,
+				Status:   domain.TestStatusActive,
+				Location: tc.Location,
+			})
+			index = len(result) - 1
+			parents[suiteVar] = index
+		}
+		result[index].Suites = append(result[index].Suites, *tc)
+	}
+
+	return result
+}
+
+// scopedName qualifies a local variable with its enclosing function, since suite
+// builders commonly reuse names such as `s` and `tc_core`.
+func scopedName(node *sitter.Node, variable string, source []byte) string {
+	if fn := cppast.EnclosingFunctionName(node, source); fn != "" {
+		return fn + "." + variable
+	}
+	return variable
+}
+
+// unscopedName strips the function qualifier added by scopedName.
+func unscopedName(name string) string {
+	if idx := strings.LastIndex(name, "."); idx >= 0 {
+		return name[idx+1:]
+	}
+	return name
+}
+
+func firstArg(args []*sitter.Node) *sitter.Node {
+	if len(args) == 0 {
+		return nil
+	}
+	return args[0]
+}
@@ -0,0 +1,211 @@
+package check
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+func TestCheckParser_Parse(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		checkFunc func(t *testing.T, file *domain.TestFile)
+	}{
+		{
+			name: "suite with tcase",
+			source: `
+#include <check.h>
+#include "money.h"
+
+START_TEST(test_money_create)
+{
+    ck_assert_int_eq(money_amount(m), 5);
+}
+END_TEST
+
+START_TEST(test_money_loop)
+{
+    ck_assert_int_ge(_i, 0);
+}
+END_TEST
+
+Suite *money_suite(void)
+{
+    Suite *s;
+    TCase *tc_core;
+
+    s = suite_create("Money");
+    tc_core = tcase_create("Core");
+
+    tcase_add_test(tc_core, test_money_create);
+    tcase_add_loop_test(tc_core, test_money_loop, 0, 5);
+    suite_add_tcase(s, tc_core);
+
+    return s;
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 0 {
+					t.Errorf("expected 0 top-level tests, got %d", len(file.Tests))
+				}
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				suite := file.Suites[0]
+				if suite.Name != "Money" {
+					t.Errorf("expected suite 'Money', got %q", suite.Name)
+				}
+				if len(suite.Suites) != 1 || suite.Suites[0].Name != "Core" {
+					t.Fatalf("expected tcase 'Core', got %+v", suite.Suites)
+				}
+				core := suite.Suites[0]
+				if len(core.Tests) != 2 {
+					t.Fatalf("expected 2 tests, got %d", len(core.Tests))
+				}
+				if core.Tests[0].Name != "test_money_create" {
+					t.Errorf("unexpected test %q", core.Tests[0].Name)
+				}
+				loc := core.Tests[0].Location
+				if loc.StartLine != 5 || loc.EndLine != 8 {
+					t.Errorf("expected lines 5-8, got %d-%d", loc.StartLine, loc.EndLine)
+				}
+			},
+		},
+		{
+			name: "multiple suite builders reusing variable names",
+			source: `
+#include <check.h>
+
+START_TEST(test_parse) {}
+END_TEST
+
+START_TEST(test_emit) {}
+END_TEST
+
+START_TEST(test_unregistered) {}
+END_TEST
+
+Suite *parser_suite(void)
+{
+    Suite *s = suite_create("Parser");
+    TCase *tc = tcase_create("Core");
+    tcase_add_test(tc, test_parse);
+    suite_add_tcase(s, tc);
+    return s;
+}
+
+Suite *emitter_suite(void)
+{
+    Suite *s = suite_create("Emitter");
+    TCase *tc = tcase_create("Core");
+    tcase_add_test(tc, test_emit);
+    suite_add_tcase(s, tc);
+    return s;
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 1 || file.Tests[0].Name != "test_unregistered" {
+					t.Errorf("expected unregistered test at top level, got %+v", file.Tests)
+				}
+				if len(file.Suites) != 2 {
+					t.Fatalf("expected 2 suites, got %d", len(file.Suites))
+				}
+				if file.Suites[0].Name != "Parser" || file.Suites[1].Name != "Emitter" {
+					t.Errorf("expected Parser and Emitter, got %q and %q", file.Suites[0].Name, file.Suites[1].Name)
+				}
+				for _, suite := range file.Suites {
+					if len(suite.Suites) != 1 || len(suite.Suites[0].Tests) != 1 {
+						t.Errorf("expected %q to have one tcase with one test, got %+v", suite.Name, suite.Suites)
+					}
+				}
+			},
+		},
+		{
+			name: "tcase not added to a suite",
+			source: `
+#include <check.h>
+
+START_TEST(test_signal)
+{
+    raise(SIGSEGV);
+}
+END_TEST
+
+void register_tests(TCase *tc_signals)
+{
+    tcase_add_test_raise_signal(tc_signals, test_signal, SIGSEGV);
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				if file.Suites[0].Name != "tc_signals" {
+					t.Errorf("expected suite named after variable, got %q", file.Suites[0].Name)
+				}
+				if len(file.Suites[0].Tests) != 1 {
+					t.Errorf("expected 1 test, got %d", len(file.Suites[0].Tests))
+				}
+			},
+		},
+	}
+
+	parser := &CheckParser{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := parser.Parse(ctx, []byte(tt.source), "check_money.c")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if file.Framework != frameworkName {
+				t.Errorf("expected framework %q, got %q", frameworkName, file.Framework)
+			}
+
+			if file.Language != domain.LanguageC {
+				t.Errorf("expected language C, got %q", file.Language)
+			}
+
+			if tt.checkFunc != nil {
+				tt.checkFunc(t, file)
+			}
+		})
+	}
+}
+
+func TestCheckContentMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantMatch bool
+	}{
+		{"check include", "#include <check.h>", true},
+		{"START_TEST macro", "START_TEST(test_a) {} END_TEST", true},
+		{"tcase_add_test", "tcase_add_test(tc, test_a);", true},
+		{"ck_assert", "ck_assert_int_eq(1, 1);", true},
+		{"cmocka include", "#include <cmocka.h>", false},
+		{"plain c code", "int main(void) { return 0; }", false},
+	}
+
+	matcher := &CheckContentMatcher{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := framework.Signal{
+				Type:    framework.SignalFileContent,
+				Value:   tt.content,
+				Context: []byte(tt.content),
+			}
+			result := matcher.Match(ctx, signal)
+			if (result.Confidence > 0) != tt.wantMatch {
+				t.Errorf("Match() confidence = %v, want match = %v", result.Confidence, tt.wantMatch)
+			}
+		})
+	}
+}
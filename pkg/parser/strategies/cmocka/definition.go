@@ -0,0 +1,215 @@
+// Package cmocka implements CMocka framework support for C test files.
+package cmocka
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+	"github.com/specvital/core/pkg/parser/framework"
+	"github.com/specvital/core/pkg/parser/framework/matchers"
+	"github.com/specvital/core/pkg/parser/strategies/shared/cppast"
+)
+
+const frameworkName = framework.FrameworkCMocka
+
+// testEntryMacros are the CMUnitTest (and legacy UnitTest) array entry macros.
+// The test function is always the first argument.
+var testEntryMacros = map[string]bool{
+	"cmocka_unit_test":                         true,
+	"cmocka_unit_test_setup":                   true,
+	"cmocka_unit_test_teardown":                true,
+	"cmocka_unit_test_setup_teardown":          true,
+	"cmocka_unit_test_prestate":                true,
+	"cmocka_unit_test_prestate_setup_teardown": true,
+	"unit_test":                true,
+	"unit_test_setup_teardown": true,
+}
+
+const (
+	funcRunGroupTests     = "cmocka_run_group_tests"
+	funcRunGroupTestsName = "cmocka_run_group_tests_name"
+	funcSkip              = "skip"
+)
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageC},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher("cmocka.h"),
+			&CMockaContentMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &CMockaParser{},
+		Priority:     framework.PriorityGeneric,
+	}
+}
+
+// CMockaContentMatcher matches CMocka-specific patterns in file content.
+type CMockaContentMatcher struct{}
+
+var cmockaPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`#include\s*[<"]cmocka\.h[>"]`), "#include <cmocka.h>"},
+	{regexp.MustCompile(`\bstruct\s+CMUnitTest\b`), "struct CMUnitTest"},
+	{regexp.MustCompile(`\bcmocka_unit_test\w*\s*\(`), "cmocka_unit_test() macro"},
+	{regexp.MustCompile(`\bcmocka_run_group_tests\w*\s*\(`), "cmocka_run_group_tests()"},
+}
+
+func (m *CMockaContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range cmockaPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found CMocka pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+// CMockaParser extracts test definitions from C CMocka files.
+// Each CMUnitTest array becomes a suite named after its cmocka_run_group_tests_name
+// group (or the array variable), and each cmocka_unit_test entry becomes a test.
+type CMockaParser struct{}
+
+func (p *CMockaParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageC, source)
+	if err != nil {
+		return nil, fmt.Errorf("cmocka parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	root := tree.RootNode()
+
+	file := &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageC,
+		Framework: frameworkName,
+	}
+
+	functions := make(map[string]cppast.Function)
+	for _, fn := range cppast.Functions(root, source) {
+		functions[fn.Name] = fn
+	}
+
+	groupNames := make(map[string]string)
+	var entries []*sitter.Node
+	parser.WalkTree(root, func(node *sitter.Node) bool {
+		if node.Type() != cppast.NodeCallExpression {
+			return true
+		}
+		args := cppast.CallArgs(node)
+		switch name := cppast.CallName(node, source); {
+		case testEntryMacros[name]:
+			entries = append(entries, node)
+			return false
+		case name == funcRunGroupTestsName && len(args) >= 2:
+			if groupName, ok := cppast.StringValue(args[0], source); ok {
+				groupNames[parser.GetNodeText(args[1], source)] = groupName
+			}
+		case name == funcRunGroupTests && len(args) >= 1:
+			array := parser.GetNodeText(args[0], source)
+			if _, ok := groupNames[array]; !ok {
+				groupNames[array] = array
+			}
+		}
+		return true
+	})
+
+	suiteIndex := make(map[string]int)
+	for _, entry := range entries {
+		args := cppast.CallArgs(entry)
+		if len(args) == 0 {
+			continue
+		}
+		test := buildTest(parser.GetNodeText(args[0], source), entry, functions, source, filename)
+
+		array := enclosingArrayName(entry, source)
+		if array == "" {
+			file.Tests = append(file.Tests, test)
+			continue
+		}
+
+		i, ok := suiteIndex[array]
+		if !ok {
+			name := groupNames[array]
+			if name == "" {
+				name = array
+			}
+			i = len(file.Suites)
+			suiteIndex[array] = i
+			file.Suites = append(file.Suites, domain.TestSuite{
+				Name:     name,
+				Status:   domain.TestStatusActive,
+				Location: parser.GetLocation(entry.Parent(), filename),
+			})
+		}
+		file.Suites[i].Tests = append(file.Suites[i].Tests, test)
+	}
+
+	return file, nil
+}
+
+// buildTest creates a test for the named function, located at its definition when it is
+// defined in this file. Tests that call skip() are reported as skipped.
+func buildTest(name string, entry *sitter.Node, functions map[string]cppast.Function, source []byte, filename string) domain.Test {
+	test := domain.Test{
+		Name:     name,
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(entry, filename),
+	}
+
+	fn, ok := functions[name]
+	if !ok {
+		return test
+	}
+
+	test.Location = parser.GetLocation(fn.Node, filename)
+	for _, call := range cppast.CalledFunctions(fn.Body, source) {
+		if call == funcSkip {
+			test.Status = domain.TestStatusSkipped
+			test.Modifier = funcSkip
+			break
+		}
+	}
+	return test
+}
+
+// enclosingArrayName returns the name of the array whose initializer contains entry.
+func enclosingArrayName(entry *sitter.Node, source []byte) string {
+	for current := entry.Parent(); current != nil; current = current.Parent() {
+		switch current.Type() {
+		case "init_declarator":
+			declarator := current.ChildByFieldName("declarator")
+			for declarator != nil && declarator.Type() == "array_declarator" {
+				declarator = declarator.ChildByFieldName("declarator")
+			}
+			if declarator == nil || declarator.Type() != cppast.NodeIdentifier {
+				return ""
+			}
+			return parser.GetNodeText(declarator, source)
+		case cppast.NodeFunctionDefinition, cppast.NodeCompoundStatement:
+			return ""
+		}
+	}
+	return ""
+}
@@ -0,0 +1,180 @@
+package cmocka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+func TestCMockaParser_Parse(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		checkFunc func(t *testing.T, file *domain.TestFile)
+	}{
+		{
+			name: "named test group",
+			source: `
+#include <stdarg.h>
+#include <stddef.h>
+#include <setjmp.h>
+#include <cmocka.h>
+
+static void null_test_success(void **state) {
+    (void) state;
+}
+
+static void skipped_test(void **state) {
+    skip();
+}
+
+int main(void) {
+    const struct CMUnitTest tests[] = {
+        cmocka_unit_test(null_test_success),
+        cmocka_unit_test_setup_teardown(skipped_test, setup, teardown),
+    };
+    return cmocka_run_group_tests_name("basic", tests, NULL, NULL);
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				suite := file.Suites[0]
+				if suite.Name != "basic" {
+					t.Errorf("expected suite 'basic', got %q", suite.Name)
+				}
+				if len(suite.Tests) != 2 {
+					t.Fatalf("expected 2 tests, got %d", len(suite.Tests))
+				}
+				if suite.Tests[0].Name != "null_test_success" || suite.Tests[0].Status != domain.TestStatusActive {
+					t.Errorf("unexpected first test %q/%q", suite.Tests[0].Name, suite.Tests[0].Status)
+				}
+				if suite.Tests[0].Location.StartLine != 7 {
+					t.Errorf("expected location at definition line 7, got %d", suite.Tests[0].Location.StartLine)
+				}
+				if suite.Tests[1].Status != domain.TestStatusSkipped || suite.Tests[1].Modifier != "skip" {
+					t.Errorf("expected skipped test, got %q/%q", suite.Tests[1].Status, suite.Tests[1].Modifier)
+				}
+			},
+		},
+		{
+			name: "multiple groups named after arrays",
+			source: `
+#include <cmocka.h>
+
+static void parse_empty(void **state) {}
+static void parse_number(void **state) {}
+static void emit_empty(void **state) {}
+
+int main(void) {
+    const struct CMUnitTest parser_tests[] = {
+        cmocka_unit_test(parse_empty),
+        cmocka_unit_test(parse_number),
+    };
+    const struct CMUnitTest emitter_tests[] = {
+        cmocka_unit_test_prestate(emit_empty, &state),
+    };
+    int failed = cmocka_run_group_tests(parser_tests, NULL, NULL);
+    failed += cmocka_run_group_tests(emitter_tests, NULL, NULL);
+    return failed;
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 2 {
+					t.Fatalf("expected 2 suites, got %d", len(file.Suites))
+				}
+				if file.Suites[0].Name != "parser_tests" || len(file.Suites[0].Tests) != 2 {
+					t.Errorf("expected parser_tests with 2 tests, got %q with %d", file.Suites[0].Name, len(file.Suites[0].Tests))
+				}
+				if file.Suites[1].Name != "emitter_tests" || len(file.Suites[1].Tests) != 1 {
+					t.Errorf("expected emitter_tests with 1 test, got %q with %d", file.Suites[1].Name, len(file.Suites[1].Tests))
+				}
+			},
+		},
+		{
+			name: "legacy unit_test API with external functions",
+			source: `
+#include <cmocka.h>
+
+extern void test_external(void **state);
+
+int main(void) {
+    const UnitTest tests[] = {
+        unit_test(test_external),
+    };
+    return run_tests(tests);
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 || len(file.Suites[0].Tests) != 1 {
+					t.Fatalf("expected 1 suite with 1 test, got %+v", file.Suites)
+				}
+				test := file.Suites[0].Tests[0]
+				if test.Name != "test_external" {
+					t.Errorf("expected test_external, got %q", test.Name)
+				}
+				if test.Location.StartLine != 8 {
+					t.Errorf("expected location at array entry line 8, got %d", test.Location.StartLine)
+				}
+			},
+		},
+	}
+
+	parser := &CMockaParser{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := parser.Parse(ctx, []byte(tt.source), "test_parser.c")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if file.Framework != frameworkName {
+				t.Errorf("expected framework %q, got %q", frameworkName, file.Framework)
+			}
+
+			if file.Language != domain.LanguageC {
+				t.Errorf("expected language C, got %q", file.Language)
+			}
+
+			if tt.checkFunc != nil {
+				tt.checkFunc(t, file)
+			}
+		})
+	}
+}
+
+func TestCMockaContentMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantMatch bool
+	}{
+		{"cmocka include", "#include <cmocka.h>", true},
+		{"CMUnitTest array", "const struct CMUnitTest tests[] = {};", true},
+		{"unit test macro", "cmocka_unit_test(test_a),", true},
+		{"unity include", `#include "unity.h"`, false},
+		{"plain c code", "int main(void) { return 0; }", false},
+	}
+
+	matcher := &CMockaContentMatcher{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := framework.Signal{
+				Type:    framework.SignalFileContent,
+				Value:   tt.content,
+				Context: []byte(tt.content),
+			}
+			result := matcher.Match(ctx, signal)
+			if (result.Confidence > 0) != tt.wantMatch {
+				t.Errorf("Match() confidence = %v, want match = %v", result.Confidence, tt.wantMatch)
+			}
+		})
+	}
+}
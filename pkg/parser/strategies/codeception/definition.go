@@ -0,0 +1,127 @@
+// Package codeception implements Codeception test framework support for PHP test files.
+package codeception
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/framework"
+	"github.com/specvital/core/pkg/parser/framework/matchers"
+)
+
+const frameworkName = framework.FrameworkCodeception
+
+// Test file suffixes: Cest classes hold scenario methods, Cept files are a single scenario.
+const (
+	cestSuffix = "Cest.php"
+	ceptSuffix = "Cept.php"
+)
+
+// defaultTestsDir is where Codeception looks for suites when paths.tests is not set.
+const defaultTestsDir = "tests"
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguagePHP},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher("Codeception\\"),
+			matchers.NewConfigMatcher("codeception.yml", "codeception.dist.yml"),
+			&CodeceptionFileMatcher{},
+			&CodeceptionContentMatcher{},
+		},
+		ConfigParser: &CodeceptionConfigParser{},
+		Parser:       &CodeceptionParser{},
+		Priority:     framework.PrioritySpecialized,
+	}
+}
+
+// CodeceptionFileMatcher matches *Cest.php and *Cept.php files.
+type CodeceptionFileMatcher struct{}
+
+func (m *CodeceptionFileMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileName {
+		return framework.NoMatch()
+	}
+
+	base := filepath.Base(signal.Value)
+	if strings.HasSuffix(base, cestSuffix) || strings.HasSuffix(base, ceptSuffix) {
+		return framework.DefiniteMatch("Codeception Cest/Cept file naming convention")
+	}
+
+	return framework.NoMatch()
+}
+
+// CodeceptionContentMatcher matches Codeception specific patterns.
+type CodeceptionContentMatcher struct{}
+
+var codeceptionPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`extends\s+\\?Codeception\\Test\\Unit\b`), "extends Codeception\\Test\\Unit"},
+	{regexp.MustCompile(`\w+Tester\s+\$I\b`), "actor parameter"},
+	{regexp.MustCompile(`\$I->wantTo(?:Test)?\(`), "$I->wantTo scenario"},
+}
+
+func (m *CodeceptionContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range codeceptionPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found Codeception pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+// CodeceptionConfigParser reads codeception.yml and scopes Codeception to its tests directory.
+type CodeceptionConfigParser struct{}
+
+type codeceptionConfig struct {
+	Paths struct {
+		Tests string `yaml:"tests"`
+	} `yaml:"paths"`
+}
+
+func (p *CodeceptionConfigParser) Parse(ctx context.Context, configPath string, content []byte) (*framework.ConfigScope, error) {
+	var config codeceptionConfig
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return nil, fmt.Errorf("codeception config: failed to parse %s: %w", configPath, err)
+	}
+
+	scope := framework.NewConfigScope(configPath, "")
+	scope.Framework = frameworkName
+
+	testsDir := config.Paths.Tests
+	if testsDir == "" {
+		testsDir = defaultTestsDir
+	}
+	scope.Roots = []string{filepath.Join(scope.BaseDir, filepath.FromSlash(testsDir))}
+	return scope, nil
+}
+
+// CodeceptionParser extracts test definitions from Codeception Cest, Cept and unit test files.
+type CodeceptionParser struct{}
+
+func (p *CodeceptionParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	return parseFile(ctx, source, filename)
+}
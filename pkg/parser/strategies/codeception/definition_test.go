@@ -0,0 +1,110 @@
+package codeception
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+func TestCodeceptionConfigParser_Parse(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantRoots []string
+	}{
+		{
+			name: "custom tests path",
+			content: `namespace: App\Tests
+paths:
+    tests: app/tests
+    output: app/tests/_output
+`,
+			wantRoots: []string{"/project/app/tests"},
+		},
+		{
+			name:      "default tests path",
+			content:   "namespace: Tests\nactor_suffix: Tester\n",
+			wantRoots: []string{"/project/tests"},
+		},
+	}
+
+	parser := &CodeceptionConfigParser{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope, err := parser.Parse(ctx, filepath.FromSlash("/project/codeception.yml"), []byte(tt.content))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if scope.Framework != frameworkName {
+				t.Errorf("expected framework %q, got %q", frameworkName, scope.Framework)
+			}
+
+			var wantRoots []string
+			for _, root := range tt.wantRoots {
+				wantRoots = append(wantRoots, filepath.FromSlash(root))
+			}
+			if !reflect.DeepEqual(scope.Roots, wantRoots) {
+				t.Errorf("Roots = %v, want %v", scope.Roots, wantRoots)
+			}
+		})
+	}
+}
+
+func TestCodeceptionFileMatcher_Match(t *testing.T) {
+	tests := []struct {
+		filename  string
+		wantMatch bool
+	}{
+		{"LoginCest.php", true},
+		{"SigninCept.php", true},
+		{"UserTest.php", false},
+		{"Cest.txt", false},
+	}
+
+	matcher := &CodeceptionFileMatcher{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			result := matcher.Match(ctx, framework.Signal{Type: framework.SignalFileName, Value: tt.filename})
+			if (result.Confidence == 100) != tt.wantMatch {
+				t.Errorf("Match() confidence = %v, want definite match = %v", result.Confidence, tt.wantMatch)
+			}
+		})
+	}
+}
+
+func TestCodeceptionContentMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantMatch bool
+	}{
+		{"unit base class", "<?php\nclass UserTest extends \\Codeception\\Test\\Unit {}", true},
+		{"actor parameter", "<?php\nclass LoginCest { public function login(AcceptanceTester $I) {} }", true},
+		{"cept scenario", "<?php\n$I->wantTo('log in');", true},
+		{"phpunit class", "<?php\nclass UserTest extends TestCase {}", false},
+	}
+
+	matcher := &CodeceptionContentMatcher{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := framework.Signal{
+				Type:    framework.SignalFileContent,
+				Value:   tt.content,
+				Context: []byte(tt.content),
+			}
+			result := matcher.Match(ctx, signal)
+			if (result.Confidence > 0) != tt.wantMatch {
+				t.Errorf("Match() confidence = %v, want match = %v", result.Confidence, tt.wantMatch)
+			}
+		})
+	}
+}
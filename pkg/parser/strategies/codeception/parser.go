@@ -0,0 +1,297 @@
+package codeception
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+	"github.com/specvital/core/pkg/parser/strategies/shared/phpast"
+)
+
+// PHP AST node types used by Codeception files.
+const (
+	nodeArgument       = "argument"
+	nodeArguments      = "arguments"
+	nodeEncapsedString = "encapsed_string"
+	nodeMemberCall     = "member_call_expression"
+	nodeString         = "string"
+	nodeVariableName   = "variable_name"
+)
+
+// unitBaseClass is the base of Codeception unit tests, Codeception\Test\Unit.
+const unitBaseClass = "Unit"
+
+// Cest method attributes (Codeception\Attribute\*) and their docblock annotations.
+const (
+	attrDataProvider = "DataProvider"
+	attrExamples     = "Examples"
+	attrGroup        = "Group"
+	attrIncomplete   = "Incomplete"
+	attrSkip         = "Skip"
+)
+
+var (
+	skipAnnotation       = regexp.MustCompile(`@skip\b`)
+	incompleteAnnotation = regexp.MustCompile(`@incomplete\b`)
+	groupAnnotation      = regexp.MustCompile(`@group\s+(\S+)`)
+	exampleAnnotation    = regexp.MustCompile(`@example\s+(.+?)\s*(?:\*/)?$`)
+)
+
+// Cept scenarios are named with $I->wantTo('...') or $I->wantToTest('...').
+var wantToMethods = map[string]bool{"wantTo": true, "wantToTest": true}
+
+// parseFile extracts Codeception tests. Every public method of a Cest class not starting
+// with "_" is a test; #[Examples]/@example repeat it once per example. A Cept file is a
+// single scenario, and unit test classes are read like PHPUnit classes.
+func parseFile(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguagePHP, source)
+	if err != nil {
+		return nil, fmt.Errorf("codeception parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	root := tree.RootNode()
+	file := &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguagePHP,
+		Framework: frameworkName,
+	}
+
+	if strings.HasSuffix(filepath.Base(filename), ceptSuffix) {
+		file.Tests = []domain.Test{parseCept(root, source, filename)}
+		return file, nil
+	}
+
+	isCest := strings.HasSuffix(filepath.Base(filename), cestSuffix)
+	for _, class := range classDeclarations(root, 0) {
+		var suite *domain.TestSuite
+		switch {
+		case phpast.IsAbstractClass(class):
+		case isCest:
+			suite = parseCestClass(class, source, filename)
+		case phpast.GetBaseClassName(class, source) == unitBaseClass || phpast.ExtendsTestCase(class, source):
+			suite = parseUnitClass(class, source, filename)
+		}
+		if suite != nil {
+			file.Suites = append(file.Suites, *suite)
+		}
+	}
+	return file, nil
+}
+
+func classDeclarations(node *sitter.Node, depth int) []*sitter.Node {
+	if depth > parser.MaxTreeDepth {
+		return nil
+	}
+	if node.Type() == phpast.NodeClassDeclaration {
+		return []*sitter.Node{node}
+	}
+	var classes []*sitter.Node
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		classes = append(classes, classDeclarations(node.NamedChild(i), depth+1)...)
+	}
+	return classes
+}
+
+func parseUnitClass(class *sitter.Node, source []byte, filename string) *domain.TestSuite {
+	tests := phpast.ParseTestMethods(class, source, filename)
+	if len(tests) == 0 {
+		return nil
+	}
+	return &domain.TestSuite{
+		Name:     phpast.GetClassName(class, source),
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(class, filename),
+		Tests:    tests,
+	}
+}
+
+func parseCestClass(class *sitter.Node, source []byte, filename string) *domain.TestSuite {
+	body := phpast.GetDeclarationList(class)
+	if body == nil {
+		return nil
+	}
+
+	suite := &domain.TestSuite{
+		Name:     phpast.GetClassName(class, source),
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(class, filename),
+	}
+
+	var docblock string
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		child := body.NamedChild(i)
+		switch child.Type() {
+		case phpast.NodeComment:
+			docblock = child.Content(source)
+			continue
+		case phpast.NodeMethodDeclaration:
+			name := phpast.GetMethodName(child, source)
+			if name != "" && !strings.HasPrefix(name, "_") && phpast.IsPublicInstanceMethod(child, source) {
+				addScenario(suite, child, name, docblock, source, filename)
+			}
+		}
+		docblock = ""
+	}
+
+	if len(suite.Tests) == 0 && len(suite.Suites) == 0 {
+		return nil
+	}
+	return suite
+}
+
+// addScenario adds a Cest method to the suite, unrolled into a suite of its examples
+// when it has any.
+func addScenario(suite *domain.TestSuite, method *sitter.Node, name, docblock string, source []byte, filename string) {
+	test := domain.Test{
+		Name:     name,
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(method, filename),
+	}
+
+	var examples []string
+	skipped, incomplete := "", ""
+	for _, attr := range phpast.GetAttributes(method) {
+		switch phpast.GetAttributeName(attr, source) {
+		case attrSkip:
+			skipped = "#[" + attrSkip + "]"
+		case attrIncomplete:
+			incomplete = "#[" + attrIncomplete + "]"
+		case attrGroup:
+			test.Tags = append(test.Tags, stringArguments(attr, source)...)
+		case attrExamples:
+			examples = append(examples, argumentsText(attr, source))
+		case attrDataProvider:
+			// Provider data is only known at runtime; the scenario counts as one test.
+		}
+	}
+
+	for _, line := range strings.Split(docblock, "\n") {
+		switch {
+		case skipAnnotation.MatchString(line):
+			skipped = "@skip"
+		case incompleteAnnotation.MatchString(line):
+			incomplete = "@incomplete"
+		}
+		if m := groupAnnotation.FindStringSubmatch(line); m != nil {
+			test.Tags = append(test.Tags, m[1])
+		}
+		if m := exampleAnnotation.FindStringSubmatch(line); m != nil {
+			examples = append(examples, m[1])
+		}
+	}
+
+	switch {
+	case skipped != "":
+		test.Status, test.Modifier = domain.TestStatusSkipped, skipped
+	case incomplete != "":
+		test.Status, test.Modifier = domain.TestStatusTodo, incomplete
+	}
+
+	if len(examples) == 0 {
+		suite.Tests = append(suite.Tests, test)
+		return
+	}
+
+	unrolled := domain.TestSuite{
+		Name:     test.Name,
+		Status:   test.Status,
+		Modifier: test.Modifier,
+		Location: test.Location,
+	}
+	for _, example := range examples {
+		unrolled.Tests = append(unrolled.Tests, domain.Test{
+			Name:     test.Name + " | " + example,
+			Status:   test.Status,
+			Modifier: test.Modifier,
+			Location: test.Location,
+			Tags:     test.Tags,
+		})
+	}
+	suite.Suites = append(suite.Suites, unrolled)
+}
+
+// parseCept returns the single scenario of a Cept file, named by $I->wantTo() or,
+// without one, by the file name.
+func parseCept(root *sitter.Node, source []byte, filename string) domain.Test {
+	test := domain.Test{
+		Name:     strings.TrimSuffix(filepath.Base(filename), ceptSuffix),
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(root, filename),
+	}
+	if call := findWantTo(root, source, 0); call != nil {
+		if names := stringArguments(call, source); len(names) > 0 {
+			test.Name = names[0]
+		}
+		test.Location = parser.GetLocation(call, filename)
+	}
+	return test
+}
+
+func findWantTo(node *sitter.Node, source []byte, depth int) *sitter.Node {
+	if depth > parser.MaxTreeDepth {
+		return nil
+	}
+	if node.Type() == nodeMemberCall {
+		object := node.ChildByFieldName("object")
+		name := node.ChildByFieldName("name")
+		if object != nil && name != nil && object.Type() == nodeVariableName && wantToMethods[name.Content(source)] {
+			return node
+		}
+	}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if found := findWantTo(node.NamedChild(i), source, depth+1); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// callArguments returns the argument values of a call or attribute.
+func callArguments(node *sitter.Node) []*sitter.Node {
+	args := node.ChildByFieldName("arguments")
+	if args == nil {
+		args = node.ChildByFieldName("parameters")
+	}
+	if args == nil || args.Type() != nodeArguments {
+		return nil
+	}
+	var values []*sitter.Node
+	for i := 0; i < int(args.NamedChildCount()); i++ {
+		arg := args.NamedChild(i)
+		if arg.Type() == nodeArgument && arg.NamedChildCount() > 0 {
+			values = append(values, arg.NamedChild(int(arg.NamedChildCount())-1))
+		}
+	}
+	return values
+}
+
+// stringArguments returns the string literal arguments of a call or attribute, unquoted.
+func stringArguments(node *sitter.Node, source []byte) []string {
+	var values []string
+	for _, arg := range callArguments(node) {
+		if arg.Type() != nodeString && arg.Type() != nodeEncapsedString {
+			continue
+		}
+		if text := arg.Content(source); len(text) >= 2 {
+			values = append(values, text[1:len(text)-1])
+		}
+	}
+	return values
+}
+
+// argumentsText returns the arguments of an attribute as written, comma separated.
+func argumentsText(node *sitter.Node, source []byte) string {
+	args := callArguments(node)
+	values := make([]string, 0, len(args))
+	for _, arg := range args {
+		values = append(values, arg.Content(source))
+	}
+	return strings.Join(values, ", ")
+}
@@ -0,0 +1,190 @@
+package codeception
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specvital/core/pkg/domain"
+)
+
+func TestCodeceptionParser_Parse(t *testing.T) {
+	tests := []struct {
+		name      string
+		filename  string
+		source    string
+		checkFunc func(t *testing.T, file *domain.TestFile)
+	}{
+		{
+			name:     "cest scenarios",
+			filename: "tests/Acceptance/LoginCest.php",
+			source: `<?php
+
+namespace Tests\Acceptance;
+
+use Codeception\Attribute\Examples;
+use Codeception\Attribute\Group;
+use Codeception\Attribute\Incomplete;
+use Tests\Support\AcceptanceTester;
+
+class LoginCest
+{
+    public function _before(AcceptanceTester $I)
+    {
+    }
+
+    #[Examples('admin', 'secret')]
+    #[Examples('guest', 'guest')]
+    public function tryToLogin(AcceptanceTester $I, \Codeception\Example $example)
+    {
+        $I->amOnPage('/');
+    }
+
+    /**
+     * @skip not ready
+     * @group smoke
+     */
+    public function tryToLogout(AcceptanceTester $I)
+    {
+    }
+
+    #[Incomplete]
+    #[Group('slow')]
+    public function tryToReset(AcceptanceTester $I)
+    {
+    }
+
+    /**
+     * @example ["en", "Hello"]
+     * @example ["de", "Hallo"]
+     * @example ["fr", "Bonjour"]
+     */
+    function tryGreeting(AcceptanceTester $I, \Codeception\Example $example)
+    {
+    }
+
+    protected function helper() {}
+
+    public static function factory() {}
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 || file.Suites[0].Name != "LoginCest" {
+					t.Fatalf("expected LoginCest suite, got %+v", file.Suites)
+				}
+				cest := file.Suites[0]
+				if len(cest.Tests) != 2 {
+					t.Fatalf("expected 2 plain scenarios, got %+v", cest.Tests)
+				}
+				logout, reset := cest.Tests[0], cest.Tests[1]
+				if logout.Status != domain.TestStatusSkipped || logout.Modifier != "@skip" {
+					t.Errorf("expected tryToLogout skipped, got %s/%q", logout.Status, logout.Modifier)
+				}
+				if len(logout.Tags) != 1 || logout.Tags[0] != "smoke" {
+					t.Errorf("expected smoke tag, got %v", logout.Tags)
+				}
+				if reset.Status != domain.TestStatusTodo || reset.Modifier != "#[Incomplete]" {
+					t.Errorf("expected tryToReset todo, got %s/%q", reset.Status, reset.Modifier)
+				}
+				if len(reset.Tags) != 1 || reset.Tags[0] != "slow" {
+					t.Errorf("expected slow tag, got %v", reset.Tags)
+				}
+
+				if len(cest.Suites) != 2 {
+					t.Fatalf("expected 2 example suites, got %d", len(cest.Suites))
+				}
+				login := cest.Suites[0]
+				if login.Name != "tryToLogin" || len(login.Tests) != 2 {
+					t.Fatalf("expected 2 tryToLogin examples, got %+v", login)
+				}
+				if login.Tests[0].Name != "tryToLogin | 'admin', 'secret'" {
+					t.Errorf("unexpected example name %q", login.Tests[0].Name)
+				}
+				greeting := cest.Suites[1]
+				if len(greeting.Tests) != 3 || greeting.Tests[2].Name != `tryGreeting | ["fr", "Bonjour"]` {
+					t.Errorf("expected 3 @example scenarios, got %+v", greeting.Tests)
+				}
+				if file.CountTests() != 7 {
+					t.Errorf("expected 7 tests, got %d", file.CountTests())
+				}
+			},
+		},
+		{
+			name:     "cept with wantTo",
+			filename: "tests/acceptance/SigninCept.php",
+			source: `<?php
+$I = new AcceptanceTester($scenario);
+$I->wantTo('sign in as a registered user');
+$I->amOnPage('/login');
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 1 {
+					t.Fatalf("expected 1 scenario, got %d", len(file.Tests))
+				}
+				if file.Tests[0].Name != "sign in as a registered user" || file.Tests[0].Location.StartLine != 3 {
+					t.Errorf("unexpected scenario %q at line %d", file.Tests[0].Name, file.Tests[0].Location.StartLine)
+				}
+			},
+		},
+		{
+			name:     "cept without wantTo",
+			filename: "tests/acceptance/SignupCept.php",
+			source: `<?php
+$I = new AcceptanceTester($scenario);
+$I->amOnPage('/signup');
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 1 || file.Tests[0].Name != "Signup" {
+					t.Errorf("expected scenario named after the file, got %+v", file.Tests)
+				}
+			},
+		},
+		{
+			name:     "unit test",
+			filename: "tests/Unit/UserTest.php",
+			source: `<?php
+
+namespace Tests\Unit;
+
+class UserTest extends \Codeception\Test\Unit
+{
+    protected function _before() {}
+
+    public function testValidation() {}
+
+    /** @test */
+    public function itHashesPasswords() {}
+
+    public function helper() {}
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 || file.Suites[0].Name != "UserTest" {
+					t.Fatalf("expected UserTest suite, got %+v", file.Suites)
+				}
+				if len(file.Suites[0].Tests) != 2 {
+					t.Errorf("expected 2 tests, got %+v", file.Suites[0].Tests)
+				}
+			},
+		},
+	}
+
+	parser := &CodeceptionParser{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := parser.Parse(ctx, []byte(tt.source), tt.filename)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if file.Framework != frameworkName {
+				t.Errorf("expected framework %q, got %q", frameworkName, file.Framework)
+			}
+
+			if tt.checkFunc != nil {
+				tt.checkFunc(t, file)
+			}
+		})
+	}
+}
@@ -0,0 +1,311 @@
+// Package cunit implements CUnit framework support for C test files.
+package cunit
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+	"github.com/specvital/core/pkg/parser/framework"
+	"github.com/specvital/core/pkg/parser/framework/matchers"
+	"github.com/specvital/core/pkg/parser/strategies/shared/cppast"
+)
+
+const frameworkName = framework.FrameworkCUnit
+
+const (
+	funcAddTest      = "CU_add_test"
+	macroAddTest     = "CU_ADD_TEST"
+	typeTestInfo     = "CU_TestInfo"
+	typeSuiteInfo    = "CU_SuiteInfo"
+	nodeInitDecl     = "init_declarator"
+	nodeArrayDecl    = "array_declarator"
+	nodeInitializers = "initializer_list"
+)
+
+// addSuiteFuncs create a suite named by their first argument.
+var addSuiteFuncs = map[string]bool{
+	"CU_add_suite":                         true,
+	"CU_add_suite_with_setup_and_teardown": true,
+}
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageC},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher("CUnit/"),
+			&CUnitContentMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &CUnitParser{},
+		Priority:     framework.PriorityGeneric,
+	}
+}
+
+// CUnitContentMatcher matches CUnit-specific patterns in file content.
+type CUnitContentMatcher struct{}
+
+var cunitPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`#include\s*[<"]CUnit/`), "#include <CUnit/...>"},
+	{regexp.MustCompile(`\bCU_add_(?:test|suite)\w*\s*\(`), "CU_add_test()/CU_add_suite()"},
+	{regexp.MustCompile(`\bCU_ADD_TEST\s*\(`), "CU_ADD_TEST() macro"},
+	{regexp.MustCompile(`\bCU_(?:TestInfo|SuiteInfo)\b`), "CU_TestInfo/CU_SuiteInfo arrays"},
+}
+
+func (m *CUnitContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range cunitPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found CUnit pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+// CUnitParser extracts test definitions from C CUnit files.
+// Tests registered with CU_add_test/CU_ADD_TEST are grouped by their CU_add_suite suite.
+// Static registrations in CU_TestInfo arrays are grouped by the CU_SuiteInfo entry that
+// references them, or by the array name when no suite references the array.
+type CUnitParser struct{}
+
+// suiteBuilder accumulates the tests of one suite in registration order.
+type suiteBuilder struct {
+	order  []string
+	suites map[string]*domain.TestSuite
+}
+
+func (b *suiteBuilder) get(key, name string, location domain.Location) *domain.TestSuite {
+	if suite, ok := b.suites[key]; ok {
+		return suite
+	}
+	suite := &domain.TestSuite{
+		Name:     name,
+		Status:   domain.TestStatusActive,
+		Location: location,
+	}
+	b.suites[key] = suite
+	b.order = append(b.order, key)
+	return suite
+}
+
+func (p *CUnitParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageC, source)
+	if err != nil {
+		return nil, fmt.Errorf("cunit parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	root := tree.RootNode()
+
+	file := &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageC,
+		Framework: frameworkName,
+	}
+
+	functions := make(map[string]cppast.Function)
+	for _, fn := range cppast.Functions(root, source) {
+		functions[fn.Name] = fn
+	}
+	locate := func(fn string, fallback *sitter.Node) domain.Location {
+		if def, ok := functions[fn]; ok {
+			return parser.GetLocation(def.Node, filename)
+		}
+		return parser.GetLocation(fallback, filename)
+	}
+
+	builder := &suiteBuilder{suites: make(map[string]*domain.TestSuite)}
+	suiteNames := make(map[string]string)
+	testInfoArrays := make(map[string]*sitter.Node)
+	var testInfoOrder []string
+	var suiteInfoArrays []*sitter.Node
+
+	parser.WalkTree(root, func(node *sitter.Node) bool {
+		if node.Type() == nodeInitDecl {
+			switch declaredType(node, source) {
+			case typeTestInfo:
+				if name := arrayName(node, source); name != "" {
+					testInfoArrays[name] = node.ChildByFieldName("value")
+					testInfoOrder = append(testInfoOrder, name)
+				}
+			case typeSuiteInfo:
+				suiteInfoArrays = append(suiteInfoArrays, node.ChildByFieldName("value"))
+			}
+			return true
+		}
+		if node.Type() != cppast.NodeCallExpression {
+			return true
+		}
+
+		args := cppast.CallArgs(node)
+		name := cppast.CallName(node, source)
+
+		switch {
+		case addSuiteFuncs[name]:
+			variable := cppast.AssignedName(node, source)
+			value, ok := cppast.StringValue(firstArg(args), source)
+			if variable != "" && ok {
+				suiteNames[scopedName(node, variable, source)] = value
+			}
+
+		case name == funcAddTest && len(args) >= 3:
+			testName, ok := cppast.StringValue(args[1], source)
+			if !ok {
+				testName = parser.GetNodeText(args[1], source)
+			}
+			addRegisteredTest(builder, suiteNames, node, args[0], testName, locate(parser.GetNodeText(args[2], source), node), source)
+
+		case name == macroAddTest && len(args) >= 2:
+			fn := parser.GetNodeText(args[1], source)
+			addRegisteredTest(builder, suiteNames, node, args[0], fn, locate(fn, node), source)
+		}
+		return true
+	})
+
+	// CU_SuiteInfo entries: { "name", init, clean, [setup, teardown,] tests }
+	referenced := make(map[string]bool)
+	for _, list := range suiteInfoArrays {
+		for _, entry := range initializerEntries(list) {
+			values := initializerEntries(entry)
+			if len(values) < 2 {
+				continue
+			}
+			suiteName, ok := cppast.StringValue(values[0], source)
+			if !ok {
+				continue
+			}
+			testsArray := parser.GetNodeText(values[len(values)-1], source)
+			tests, ok := testInfoArrays[testsArray]
+			if !ok {
+				continue
+			}
+			referenced[testsArray] = true
+			suite := builder.get("info:"+suiteName, suiteName, parser.GetLocation(entry, filename))
+			suite.Tests = append(suite.Tests, testInfoTests(tests, locate, source)...)
+		}
+	}
+	for _, array := range testInfoOrder {
+		if referenced[array] {
+			continue
+		}
+		tests := testInfoArrays[array]
+		suite := builder.get("array:"+array, array, parser.GetLocation(tests, filename))
+		suite.Tests = append(suite.Tests, testInfoTests(tests, locate, source)...)
+	}
+
+	for _, key := range builder.order {
+		file.Suites = append(file.Suites, *builder.suites[key])
+	}
+	return file, nil
+}
+
+func addRegisteredTest(builder *suiteBuilder, suiteNames map[string]string, call, suiteArg *sitter.Node, name string, location domain.Location, source []byte) {
+	variable := scopedName(call, parser.GetNodeText(suiteArg, source), source)
+	suiteName := suiteNames[variable]
+	if suiteName == "" {
+		suiteName = parser.GetNodeText(suiteArg, source)
+	}
+	suite := builder.get("var:"+variable, suiteName, location)
+	suite.Tests = append(suite.Tests, domain.Test{
+		Name:     name,
+		Status:   domain.TestStatusActive,
+		Location: location,
+	})
+}
+
+// testInfoTests converts CU_TestInfo entries ({ "name", fn }) into tests.
+// CU_TEST_INFO_NULL terminators are not initializer lists and are skipped.
+func testInfoTests(list *sitter.Node, locate func(string, *sitter.Node) domain.Location, source []byte) []domain.Test {
+	var tests []domain.Test
+	for _, entry := range initializerEntries(list) {
+		values := initializerEntries(entry)
+		if len(values) < 2 {
+			continue
+		}
+		name, ok := cppast.StringValue(values[0], source)
+		if !ok {
+			continue
+		}
+		tests = append(tests, domain.Test{
+			Name:     name,
+			Status:   domain.TestStatusActive,
+			Location: locate(parser.GetNodeText(values[1], source), entry),
+		})
+	}
+	return tests
+}
+
+// initializerEntries returns the named children of an initializer_list, or nil for other nodes.
+func initializerEntries(node *sitter.Node) []*sitter.Node {
+	if node == nil || node.Type() != nodeInitializers {
+		return nil
+	}
+	var entries []*sitter.Node
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		entries = append(entries, node.NamedChild(i))
+	}
+	return entries
+}
+
+// declaredType returns the type name of the declaration an init_declarator belongs to.
+func declaredType(initDecl *sitter.Node, source []byte) string {
+	decl := initDecl.Parent()
+	if decl == nil || decl.Type() != cppast.NodeDeclaration {
+		return ""
+	}
+	typ := decl.ChildByFieldName("type")
+	if typ == nil {
+		return ""
+	}
+	return parser.GetNodeText(typ, source)
+}
+
+// arrayName returns the variable name of an array init_declarator (tests[] = {...}).
+func arrayName(initDecl *sitter.Node, source []byte) string {
+	declarator := initDecl.ChildByFieldName("declarator")
+	if declarator == nil || declarator.Type() != nodeArrayDecl {
+		return ""
+	}
+	ident := declarator.ChildByFieldName("declarator")
+	if ident == nil || ident.Type() != cppast.NodeIdentifier {
+		return ""
+	}
+	return parser.GetNodeText(ident, source)
+}
+
+// scopedName qualifies a local suite variable with its enclosing function,
+// since registration functions commonly reuse names such as `pSuite`.
+func scopedName(node *sitter.Node, variable string, source []byte) string {
+	if fn := cppast.EnclosingFunctionName(node, source); fn != "" {
+		return fn + "." + variable
+	}
+	return variable
+}
+
+func firstArg(args []*sitter.Node) *sitter.Node {
+	if len(args) == 0 {
+		return nil
+	}
+	return args[0]
+}
@@ -0,0 +1,200 @@
+package cunit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+func TestCUnitParser_Parse(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		checkFunc func(t *testing.T, file *domain.TestFile)
+	}{
+		{
+			name: "CU_add_suite with CU_add_test",
+			source: `
+#include <CUnit/Basic.h>
+
+void test_max(void)
+{
+    CU_ASSERT_EQUAL(max(1, 2), 2);
+}
+
+void test_min(void)
+{
+    CU_ASSERT_EQUAL(min(1, 2), 1);
+}
+
+int main(void)
+{
+    CU_pSuite pSuite = NULL;
+    CU_initialize_registry();
+    pSuite = CU_add_suite("math", init_suite, clean_suite);
+    CU_add_test(pSuite, "test of max()", test_max);
+    CU_ADD_TEST(pSuite, test_min);
+    CU_basic_run_tests();
+    return CU_get_error();
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				suite := file.Suites[0]
+				if suite.Name != "math" {
+					t.Errorf("expected suite 'math', got %q", suite.Name)
+				}
+				if len(suite.Tests) != 2 {
+					t.Fatalf("expected 2 tests, got %d", len(suite.Tests))
+				}
+				if suite.Tests[0].Name != "test of max()" {
+					t.Errorf("expected 'test of max()', got %q", suite.Tests[0].Name)
+				}
+				if suite.Tests[0].Location.StartLine != 4 {
+					t.Errorf("expected location at definition line 4, got %d", suite.Tests[0].Location.StartLine)
+				}
+				if suite.Tests[1].Name != "test_min" {
+					t.Errorf("expected 'test_min', got %q", suite.Tests[1].Name)
+				}
+			},
+		},
+		{
+			name: "suite builders reusing variable names",
+			source: `
+#include <CUnit/CUnit.h>
+
+void test_parse(void) {}
+void test_emit(void) {}
+
+int add_parser_suite(void)
+{
+    CU_pSuite s = CU_add_suite("parser", NULL, NULL);
+    CU_add_test(s, "parse", test_parse);
+    return 0;
+}
+
+int add_emitter_suite(void)
+{
+    CU_pSuite s = CU_add_suite_with_setup_and_teardown("emitter", NULL, NULL, setup, teardown);
+    CU_add_test(s, "emit", test_emit);
+    return 0;
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 2 {
+					t.Fatalf("expected 2 suites, got %d", len(file.Suites))
+				}
+				if file.Suites[0].Name != "parser" || file.Suites[1].Name != "emitter" {
+					t.Errorf("expected parser and emitter, got %q and %q", file.Suites[0].Name, file.Suites[1].Name)
+				}
+				for _, suite := range file.Suites {
+					if len(suite.Tests) != 1 {
+						t.Errorf("expected %q to have 1 test, got %d", suite.Name, len(suite.Tests))
+					}
+				}
+			},
+		},
+		{
+			name: "CU_TestInfo and CU_SuiteInfo arrays",
+			source: `
+#include <CUnit/CUnit.h>
+
+static void test_push(void) {}
+static void test_pop(void) {}
+static void test_orphan(void) {}
+
+static CU_TestInfo stack_tests[] = {
+    { "push", test_push },
+    { "pop", test_pop },
+    CU_TEST_INFO_NULL,
+};
+
+static CU_TestInfo orphan_tests[] = {
+    { "orphan", test_orphan },
+    CU_TEST_INFO_NULL,
+};
+
+static CU_SuiteInfo suites[] = {
+    { "stack", NULL, NULL, NULL, NULL, stack_tests },
+    CU_SUITE_INFO_NULL,
+};
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 2 {
+					t.Fatalf("expected 2 suites, got %d", len(file.Suites))
+				}
+				stack := file.Suites[0]
+				if stack.Name != "stack" || len(stack.Tests) != 2 {
+					t.Fatalf("expected stack suite with 2 tests, got %q with %d", stack.Name, len(stack.Tests))
+				}
+				if stack.Tests[0].Name != "push" || stack.Tests[0].Location.StartLine != 4 {
+					t.Errorf("expected push at line 4, got %q at %d", stack.Tests[0].Name, stack.Tests[0].Location.StartLine)
+				}
+				orphan := file.Suites[1]
+				if orphan.Name != "orphan_tests" || len(orphan.Tests) != 1 {
+					t.Errorf("expected orphan_tests with 1 test, got %q with %d", orphan.Name, len(orphan.Tests))
+				}
+			},
+		},
+	}
+
+	parser := &CUnitParser{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := parser.Parse(ctx, []byte(tt.source), "test_math.c")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if file.Framework != frameworkName {
+				t.Errorf("expected framework %q, got %q", frameworkName, file.Framework)
+			}
+
+			if file.Language != domain.LanguageC {
+				t.Errorf("expected language C, got %q", file.Language)
+			}
+
+			if tt.checkFunc != nil {
+				tt.checkFunc(t, file)
+			}
+		})
+	}
+}
+
+func TestCUnitContentMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantMatch bool
+	}{
+		{"cunit include", "#include <CUnit/Basic.h>", true},
+		{"CU_add_test", `CU_add_test(pSuite, "a", test_a);`, true},
+		{"CU_ADD_TEST macro", "CU_ADD_TEST(pSuite, test_a);", true},
+		{"CU_TestInfo array", "CU_TestInfo tests[] = {};", true},
+		{"check include", "#include <check.h>", false},
+		{"plain c code", "int main(void) { return 0; }", false},
+	}
+
+	matcher := &CUnitContentMatcher{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := framework.Signal{
+				Type:    framework.SignalFileContent,
+				Value:   tt.content,
+				Context: []byte(tt.content),
+			}
+			result := matcher.Match(ctx, signal)
+			if (result.Confidence > 0) != tt.wantMatch {
+				t.Errorf("Match() confidence = %v, want match = %v", result.Confidence, tt.wantMatch)
+			}
+		})
+	}
+}
@@ -7,7 +7,7 @@ import (
 	"github.com/specvital/core/pkg/domain"
 	"github.com/specvital/core/pkg/parser/framework"
 	"github.com/specvital/core/pkg/parser/framework/matchers"
-	"github.com/specvital/core/pkg/parser/strategies/shared/configutil"
+	"github.com/specvital/core/pkg/parser/strategies/shared/jsconfig"
 	"github.com/specvital/core/pkg/parser/strategies/shared/jstest"
 )
 
@@ -96,54 +96,40 @@ func (m *CypressContentMatcher) Match(ctx context.Context, signal framework.Sign
 type CypressConfigParser struct{}
 
 func (p *CypressConfigParser) Parse(ctx context.Context, configPath string, content []byte) (*framework.ConfigScope, error) {
+	config, err := jsconfig.Evaluate(ctx, configPath, content)
+	if err != nil {
+		return nil, err
+	}
+
 	scope := framework.NewConfigScope(configPath, "")
 	scope.Framework = frameworkName
 	scope.GlobalsMode = true // Cypress injects globals (cy, Cypress) by default
 
-	e2ePatterns := parseSpecPattern(content, "e2e")
-	componentPatterns := parseSpecPattern(content, "component")
+	e2ePatterns := parseSpecPattern(config, "e2e")
+	componentPatterns := parseSpecPattern(config, "component")
 	scope.TestPatterns = append(e2ePatterns, componentPatterns...)
-	scope.ExcludePatterns = parseExcludeSpecPattern(content)
+	scope.ExcludePatterns = parseExcludeSpecPattern(config)
 
 	return scope, nil
 }
 
-// Config parsing regex patterns.
-// Limitation: Complex expressions, multiline patterns, and template literals are not fully supported.
-// This is acceptable because scope-based detection is an optimization;
-// filename/content matchers provide baseline detection.
-var (
-	e2eSpecPatternSingleRegex       = regexp.MustCompile(`(?s)e2e\s*:\s*\{[\s\S]*?specPattern\s*:\s*['"]([^'"]+)['"]`)
-	e2eSpecPatternArrayRegex        = regexp.MustCompile(`(?s)e2e\s*:\s*\{[\s\S]*?specPattern\s*:\s*\[([^\]]+)\]`)
-	componentSpecPatternSingleRegex = regexp.MustCompile(`(?s)component\s*:\s*\{[\s\S]*?specPattern\s*:\s*['"]([^'"]+)['"]`)
-	componentSpecPatternArrayRegex  = regexp.MustCompile(`(?s)component\s*:\s*\{[\s\S]*?specPattern\s*:\s*\[([^\]]+)\]`)
-	excludeSpecPatternSingleRegex   = regexp.MustCompile(`excludeSpecPattern\s*:\s*['"]([^'"]+)['"]`)
-	excludeSpecPatternArrayRegex    = regexp.MustCompile(`excludeSpecPattern\s*:\s*\[([^\]]+)\]`)
-)
-
-func parsePattern(content []byte, singleRegex, arrayRegex *regexp.Regexp) []string {
-	if match := singleRegex.FindSubmatch(content); match != nil {
-		return []string{string(match[1])}
-	}
-	if match := arrayRegex.FindSubmatch(content); match != nil {
-		return configutil.ExtractQuotedStrings(match[1])
-	}
-	return nil
-}
-
-func parseSpecPattern(content []byte, section string) []string {
+func parseSpecPattern(config jsconfig.Value, section string) []string {
 	switch section {
-	case "e2e":
-		return parsePattern(content, e2eSpecPatternSingleRegex, e2eSpecPatternArrayRegex)
-	case "component":
-		return parsePattern(content, componentSpecPatternSingleRegex, componentSpecPatternArrayRegex)
+	case "e2e", "component":
+		return config.Lookup(section, "specPattern").Strings()
 	default:
 		return nil
 	}
 }
 
-func parseExcludeSpecPattern(content []byte) []string {
-	return parsePattern(content, excludeSpecPatternSingleRegex, excludeSpecPatternArrayRegex)
+// parseExcludeSpecPattern collects excludeSpecPattern from the testing types and
+// from the top level, where Cypress versions before 10 kept it.
+func parseExcludeSpecPattern(config jsconfig.Value) []string {
+	var patterns []string
+	for _, section := range []jsconfig.Value{config, config.Get("e2e"), config.Get("component")} {
+		patterns = append(patterns, section.Get("excludeSpecPattern").Strings()...)
+	}
+	return patterns
 }
 
 type CypressParser struct{}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/specvital/core/pkg/domain"
 	"github.com/specvital/core/pkg/parser/framework"
+	"github.com/specvital/core/pkg/parser/strategies/shared/jsconfig"
 )
 
 func TestNewDefinition(t *testing.T) {
@@ -441,6 +442,27 @@ export default defineConfig({
 			expectedTestPatterns:    []string{"src/**/*.cy.tsx"},
 			expectedExcludePatterns: nil,
 		},
+		{
+			name: "specPattern from shared constants",
+			configContent: `
+const { defineConfig } = require('cypress');
+
+const specRoot = 'apps/web/cypress';
+const ignored = ['**/__snapshots__/**'];
+
+module.exports = defineConfig({
+  e2e: {
+    specPattern: ` + "`${specRoot}/e2e/**/*.cy.{js,ts}`" + `,
+    // specPattern: 'old/**/*.cy.js',
+    excludeSpecPattern: ignored,
+  },
+});
+`,
+			configPath:              "/project/cypress.config.js",
+			expectedGlobalsMode:     true,
+			expectedTestPatterns:    []string{"apps/web/cypress/e2e/**/*.cy.{js,ts}"},
+			expectedExcludePatterns: []string{"**/__snapshots__/**"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -460,6 +482,14 @@ export default defineConfig({
 	}
 }
 
+// evalConfigFragment evaluates config properties as the argument of defineConfig.
+func evalConfigFragment(t *testing.T, fragment string) jsconfig.Value {
+	t.Helper()
+	config, err := jsconfig.Evaluate(context.Background(), "/project/cypress.config.ts", []byte("export default defineConfig({\n"+fragment+"\n});"))
+	require.NoError(t, err)
+	return config
+}
+
 func TestParseSpecPattern(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -507,7 +537,7 @@ func TestParseSpecPattern(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := parseSpecPattern([]byte(tt.content), tt.section)
+			result := parseSpecPattern(evalConfigFragment(t, tt.content), tt.section)
 			assert.Equal(t, tt.expected, result)
 		})
 	}
@@ -538,7 +568,7 @@ func TestParseExcludeSpecPattern(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := parseExcludeSpecPattern([]byte(tt.content))
+			result := parseExcludeSpecPattern(evalConfigFragment(t, tt.content))
 			assert.Equal(t, tt.expected, result)
 		})
 	}
@@ -0,0 +1,263 @@
+// Package doctest implements doctest framework support for C++ test files.
+package doctest
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+	"github.com/specvital/core/pkg/parser/framework"
+	"github.com/specvital/core/pkg/parser/framework/matchers"
+	"github.com/specvital/core/pkg/parser/strategies/shared/cppast"
+)
+
+const frameworkName = framework.FrameworkDoctest
+
+// macroPrefix is the prefix of doctest's non-short macro names (DOCTEST_TEST_CASE).
+const macroPrefix = "DOCTEST_"
+
+const (
+	macroTestSuite      = "TEST_SUITE"
+	macroTestSuiteBegin = "TEST_SUITE_BEGIN"
+	macroTestSuiteEnd   = "TEST_SUITE_END"
+)
+
+// testCaseMacros maps test case macros to the index of their name argument.
+var testCaseMacros = map[string]int{
+	"TEST_CASE":                 0,
+	"TEST_CASE_FIXTURE":         1,
+	"TEST_CASE_TEMPLATE":        0,
+	"TEST_CASE_TEMPLATE_DEFINE": 0,
+	"SCENARIO":                  0,
+	"SCENARIO_TEMPLATE":         0,
+	"SCENARIO_TEMPLATE_DEFINE":  0,
+}
+
+// sectionPrefixes maps subcase macros to the name prefix doctest reports.
+var sectionPrefixes = map[string]string{
+	"SUBCASE":  "",
+	"GIVEN":    "Given: ",
+	"WHEN":     "When: ",
+	"AND_WHEN": "And when: ",
+	"THEN":     "Then: ",
+	"AND_THEN": "And: ",
+}
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageCpp},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher(
+				"doctest/",
+				"doctest.h",
+			),
+			&DoctestContentMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &DoctestParser{},
+		Priority:     framework.PriorityGeneric,
+	}
+}
+
+// DoctestContentMatcher matches doctest-specific patterns in file content.
+type DoctestContentMatcher struct{}
+
+var doctestPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`#include\s*[<"](?:doctest/)?doctest\.h[>"]`), "#include <doctest/doctest.h>"},
+	{regexp.MustCompile(`\bDOCTEST_\w+\s*\(`), "DOCTEST_ macro"},
+	{regexp.MustCompile(`\bSUBCASE\s*\(`), "SUBCASE() macro"},
+	{regexp.MustCompile(`\bTEST_SUITE(?:_BEGIN)?\s*\(`), "TEST_SUITE() macro"},
+	{regexp.MustCompile(`\bTEST_CASE_FIXTURE\s*\(`), "TEST_CASE_FIXTURE() macro"},
+	{regexp.MustCompile(`doctest::`), "doctest:: namespace"},
+}
+
+func (m *DoctestContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range doctestPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found doctest pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+// DoctestParser extracts test definitions from C++ doctest files.
+type DoctestParser struct{}
+
+// suiteScope is an open TEST_SUITE_BEGIN block.
+type suiteScope struct {
+	suite *domain.TestSuite
+	attrs cppast.CaseAttributes
+}
+
+func (p *DoctestParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageCpp, source)
+	if err != nil {
+		return nil, fmt.Errorf("doctest parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	file := &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageCpp,
+		Framework: frameworkName,
+	}
+
+	root := &domain.TestSuite{}
+	parseMacros(cppast.Macros(tree.RootNode(), source), root, activeAttributes(), source, filename, 0)
+
+	file.Tests = root.Tests
+	file.Suites = root.Suites
+	return file, nil
+}
+
+// parseMacros appends the test cases and suites among macros to parent.
+// TEST_SUITE_BEGIN/TEST_SUITE_END pairs are tracked as a stack within this scope.
+func parseMacros(macros []cppast.Macro, parent *domain.TestSuite, inherited cppast.CaseAttributes, source []byte, filename string, depth int) {
+	if depth > parser.MaxTreeDepth {
+		return
+	}
+
+	var stack []*suiteScope
+	current := func() (*domain.TestSuite, cppast.CaseAttributes) {
+		if len(stack) == 0 {
+			return parent, inherited
+		}
+		top := stack[len(stack)-1]
+		return top.suite, top.attrs
+	}
+	closeSuite := func() {
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		target, _ := current()
+		target.Suites = append(target.Suites, *top.suite)
+	}
+
+	for _, m := range macros {
+		name := strings.TrimPrefix(m.Name, macroPrefix)
+		target, attrs := current()
+
+		switch name {
+		case macroTestSuite:
+			suiteName, suiteAttrs := nameAndDecorators(m.Arg(0), attrs, source)
+			suite := &domain.TestSuite{
+				Name:     suiteName,
+				Status:   suiteAttrs.Status,
+				Modifier: suiteAttrs.Modifier,
+				Location: m.Location(filename),
+			}
+			if m.Body != nil {
+				parseMacros(cppast.Macros(m.Body, source), suite, suiteAttrs, source, filename, depth+1)
+			}
+			target.Suites = append(target.Suites, *suite)
+			continue
+
+		case macroTestSuiteBegin:
+			suiteName, suiteAttrs := nameAndDecorators(m.Arg(0), attrs, source)
+			stack = append(stack, &suiteScope{
+				suite: &domain.TestSuite{
+					Name:     suiteName,
+					Status:   suiteAttrs.Status,
+					Modifier: suiteAttrs.Modifier,
+					Location: m.Location(filename),
+				},
+				attrs: suiteAttrs,
+			})
+			continue
+
+		case macroTestSuiteEnd:
+			if len(stack) > 0 {
+				closeSuite()
+			}
+			continue
+		}
+
+		nameArg, ok := testCaseMacros[name]
+		if !ok {
+			continue
+		}
+
+		caseName, caseAttrs := nameAndDecorators(m.Arg(nameArg), attrs, source)
+		if caseName == "" {
+			caseName = m.ArgText(nameArg, source)
+		}
+		if strings.HasPrefix(name, "SCENARIO") {
+			caseName = "Scenario: " + caseName
+		}
+
+		test, suite := cppast.BuildCase(caseName, m, caseAttrs, sectionName, source, filename)
+		if suite != nil {
+			target.Suites = append(target.Suites, *suite)
+		} else {
+			target.Tests = append(target.Tests, *test)
+		}
+	}
+
+	// Unterminated TEST_SUITE_BEGIN blocks extend to the end of the file
+	for len(stack) > 0 {
+		closeSuite()
+	}
+}
+
+func activeAttributes() cppast.CaseAttributes {
+	return cppast.CaseAttributes{Status: domain.TestStatusActive}
+}
+
+// nameAndDecorators splits a doctest name expression such as
+// "name" * doctest::skip() * doctest::may_fail() into the name and the
+// resulting attributes. Decorators override those inherited from the enclosing suite.
+func nameAndDecorators(arg *sitter.Node, inherited cppast.CaseAttributes, source []byte) (string, cppast.CaseAttributes) {
+	name, _ := cppast.FirstString(arg, source)
+	attrs := inherited
+
+	for _, decorator := range cppast.CalledFunctions(arg, source) {
+		switch decorator {
+		case "skip":
+			attrs.Status = domain.TestStatusSkipped
+			attrs.Modifier = decorator
+		case "may_fail", "should_fail", "expected_failures":
+			if attrs.Status != domain.TestStatusSkipped {
+				attrs.Status = domain.TestStatusXfail
+				attrs.Modifier = decorator
+			}
+		}
+	}
+
+	return name, attrs
+}
+
+// sectionName returns the display name for SUBCASE and BDD-style sections.
+func sectionName(m cppast.Macro, source []byte) (string, bool) {
+	prefix, ok := sectionPrefixes[strings.TrimPrefix(m.Name, macroPrefix)]
+	if !ok {
+		return "", false
+	}
+
+	value, ok := cppast.FirstString(m.Arg(0), source)
+	if !ok {
+		value = m.ArgText(0, source)
+	}
+	return prefix + value, true
+}
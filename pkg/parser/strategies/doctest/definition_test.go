@@ -0,0 +1,271 @@
+package doctest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+func TestDoctestParser_Parse(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		checkFunc func(t *testing.T, file *domain.TestFile)
+	}{
+		{
+			name: "basic TEST_CASE",
+			source: `
+#include <doctest/doctest.h>
+
+TEST_CASE("testing the factorial function") {
+    CHECK(factorial(1) == 1);
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 1 {
+					t.Fatalf("expected 1 test, got %d", len(file.Tests))
+				}
+				if file.Tests[0].Name != "testing the factorial function" {
+					t.Errorf("unexpected test name %q", file.Tests[0].Name)
+				}
+				if file.Tests[0].Status != domain.TestStatusActive {
+					t.Errorf("expected status active, got %q", file.Tests[0].Status)
+				}
+			},
+		},
+		{
+			name: "subcases become tests within a suite",
+			source: `
+#include "doctest.h"
+
+TEST_CASE("vectors") {
+    std::vector<int> v(5);
+
+    SUBCASE("adding to the vector increases its size") {
+        v.push_back(1);
+    }
+    SUBCASE("reserving") {
+        SUBCASE("bigger") {}
+        SUBCASE("smaller") {}
+    }
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				suite := file.Suites[0]
+				if suite.Name != "vectors" {
+					t.Errorf("expected suite 'vectors', got %q", suite.Name)
+				}
+				if len(suite.Tests) != 1 {
+					t.Errorf("expected 1 leaf subcase, got %d", len(suite.Tests))
+				}
+				if len(suite.Suites) != 1 || len(suite.Suites[0].Tests) != 2 {
+					t.Errorf("expected nested subcase suite with 2 tests, got %+v", suite.Suites)
+				}
+			},
+		},
+		{
+			name: "decorators",
+			source: `
+#include <doctest/doctest.h>
+
+TEST_CASE("skipped" * doctest::skip()) {}
+TEST_CASE("may fail" * doctest::may_fail()) {}
+TEST_CASE("should fail" * doctest::should_fail() * doctest::timeout(0.5)) {}
+TEST_CASE("expected failures" * doctest::expected_failures(2)) {}
+TEST_CASE("timeout only" * doctest::timeout(1)) {}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 5 {
+					t.Fatalf("expected 5 tests, got %d", len(file.Tests))
+				}
+				want := []struct {
+					name     string
+					status   domain.TestStatus
+					modifier string
+				}{
+					{"skipped", domain.TestStatusSkipped, "skip"},
+					{"may fail", domain.TestStatusXfail, "may_fail"},
+					{"should fail", domain.TestStatusXfail, "should_fail"},
+					{"expected failures", domain.TestStatusXfail, "expected_failures"},
+					{"timeout only", domain.TestStatusActive, ""},
+				}
+				for i, w := range want {
+					got := file.Tests[i]
+					if got.Name != w.name || got.Status != w.status || got.Modifier != w.modifier {
+						t.Errorf("test %d: expected %q %q/%q, got %q %q/%q",
+							i, w.name, w.status, w.modifier, got.Name, got.Status, got.Modifier)
+					}
+				}
+			},
+		},
+		{
+			name: "TEST_SUITE block propagates decorators",
+			source: `
+#include <doctest/doctest.h>
+
+TEST_SUITE("math" * doctest::skip()) {
+    TEST_CASE("addition") {}
+    TEST_CASE("subtraction") {}
+}
+
+TEST_SUITE("io") {
+    TEST_CASE("read") {}
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 2 {
+					t.Fatalf("expected 2 suites, got %d", len(file.Suites))
+				}
+				math := file.Suites[0]
+				if math.Name != "math" || math.Status != domain.TestStatusSkipped {
+					t.Errorf("expected skipped suite 'math', got %q/%q", math.Name, math.Status)
+				}
+				if len(math.Tests) != 2 {
+					t.Fatalf("expected 2 tests in math, got %d", len(math.Tests))
+				}
+				for _, test := range math.Tests {
+					if test.Status != domain.TestStatusSkipped {
+						t.Errorf("expected %q to inherit skipped, got %q", test.Name, test.Status)
+					}
+				}
+				if len(file.Suites[1].Tests) != 1 || file.Suites[1].Tests[0].Status != domain.TestStatusActive {
+					t.Errorf("expected active test in io, got %+v", file.Suites[1].Tests)
+				}
+			},
+		},
+		{
+			name: "TEST_SUITE_BEGIN and TEST_SUITE_END",
+			source: `
+#include <doctest/doctest.h>
+
+TEST_SUITE_BEGIN("outer");
+
+TEST_CASE("first") {}
+
+TEST_SUITE_BEGIN("inner");
+TEST_CASE_FIXTURE(Fixture, "with fixture") {}
+TEST_SUITE_END();
+
+TEST_SUITE_END();
+
+TEST_CASE("top level") {}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 1 || file.Tests[0].Name != "top level" {
+					t.Errorf("expected top-level test, got %+v", file.Tests)
+				}
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				outer := file.Suites[0]
+				if outer.Name != "outer" || len(outer.Tests) != 1 {
+					t.Errorf("expected suite 'outer' with 1 test, got %q with %d", outer.Name, len(outer.Tests))
+				}
+				if len(outer.Suites) != 1 || outer.Suites[0].Name != "inner" {
+					t.Fatalf("expected nested suite 'inner', got %+v", outer.Suites)
+				}
+				if len(outer.Suites[0].Tests) != 1 || outer.Suites[0].Tests[0].Name != "with fixture" {
+					t.Errorf("expected fixture test in inner, got %+v", outer.Suites[0].Tests)
+				}
+			},
+		},
+		{
+			name: "DOCTEST_ prefixed macros and scenarios",
+			source: `
+#include <doctest/doctest.h>
+
+DOCTEST_TEST_CASE("prefixed") {
+    DOCTEST_SUBCASE("inner") {}
+}
+
+SCENARIO("bdd") {
+    GIVEN("a value") {
+        THEN("it works") {}
+    }
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 2 {
+					t.Fatalf("expected 2 suites, got %d", len(file.Suites))
+				}
+				if file.Suites[0].Name != "prefixed" || len(file.Suites[0].Tests) != 1 {
+					t.Errorf("expected 'prefixed' with 1 subcase, got %+v", file.Suites[0])
+				}
+				scenario := file.Suites[1]
+				if scenario.Name != "Scenario: bdd" {
+					t.Errorf("expected 'Scenario: bdd', got %q", scenario.Name)
+				}
+				if len(scenario.Suites) != 1 || scenario.Suites[0].Name != "Given: a value" {
+					t.Fatalf("expected Given suite, got %+v", scenario.Suites)
+				}
+				if len(scenario.Suites[0].Tests) != 1 || scenario.Suites[0].Tests[0].Name != "Then: it works" {
+					t.Errorf("expected Then test, got %+v", scenario.Suites[0].Tests)
+				}
+			},
+		},
+	}
+
+	parser := &DoctestParser{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := parser.Parse(ctx, []byte(tt.source), "test.cpp")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if file.Framework != frameworkName {
+				t.Errorf("expected framework %q, got %q", frameworkName, file.Framework)
+			}
+
+			if file.Language != domain.LanguageCpp {
+				t.Errorf("expected language Cpp, got %q", file.Language)
+			}
+
+			if tt.checkFunc != nil {
+				tt.checkFunc(t, file)
+			}
+		})
+	}
+}
+
+func TestDoctestContentMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantMatch bool
+	}{
+		{"doctest include", "#include <doctest/doctest.h>", true},
+		{"single header include", `#include "doctest.h"`, true},
+		{"SUBCASE macro", `SUBCASE("a") {}`, true},
+		{"TEST_SUITE macro", `TEST_SUITE("s") {}`, true},
+		{"TEST_SUITE_BEGIN macro", `TEST_SUITE_BEGIN("s");`, true},
+		{"prefixed macro", `DOCTEST_TEST_CASE("a") {}`, true},
+		{"decorator", `TEST_CASE("a" * doctest::skip()) {}`, true},
+		{"catch2 include", "#include <catch2/catch_test_macros.hpp>", false},
+		{"plain cpp code", "int main() { return 0; }", false},
+	}
+
+	matcher := &DoctestContentMatcher{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := framework.Signal{
+				Type:    framework.SignalFileContent,
+				Value:   tt.content,
+				Context: []byte(tt.content),
+			}
+			result := matcher.Match(ctx, signal)
+			if (result.Confidence > 0) != tt.wantMatch {
+				t.Errorf("Match() confidence = %v, want match = %v", result.Confidence, tt.wantMatch)
+			}
+		})
+	}
+}
@@ -0,0 +1,461 @@
+// Package exunit implements ExUnit framework support for Elixir test files.
+package exunit
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+	"github.com/specvital/core/pkg/parser/framework"
+	"github.com/specvital/core/pkg/parser/framework/matchers"
+)
+
+const frameworkName = framework.FrameworkExUnit
+
+// Elixir AST node types.
+const (
+	nodeAlias         = "alias"
+	nodeArguments     = "arguments"
+	nodeAtom          = "atom"
+	nodeCall          = "call"
+	nodeDoBlock       = "do_block"
+	nodeIdentifier    = "identifier"
+	nodeKeywords      = "keywords"
+	nodeList          = "list"
+	nodePair          = "pair"
+	nodeString        = "string"
+	nodeUnaryOperator = "unary_operator"
+)
+
+// ExUnit macros and module attributes.
+const (
+	macroDefmodule = "defmodule"
+	macroDescribe  = "describe"
+	macroDoctest   = "doctest"
+	macroTest      = "test"
+	macroProperty  = "property"
+
+	attrTag         = "tag"
+	attrDescribeTag = "describetag"
+	attrModuleTag   = "moduletag"
+
+	tagSkip = "skip"
+	keyDo   = "do"
+)
+
+// testMacros register a single test; property comes from ExUnitProperties (StreamData).
+var testMacros = map[string]bool{
+	macroTest:     true,
+	macroProperty: true,
+}
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageElixir},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher("ExUnit", "ExUnit."),
+			&ExUnitFileMatcher{},
+			&ExUnitContentMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &ExUnitParser{},
+		Priority:     framework.PriorityGeneric,
+	}
+}
+
+// ExUnitFileMatcher matches *_test.exs files, the only files ExUnit loads by default.
+type ExUnitFileMatcher struct{}
+
+func (m *ExUnitFileMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileName {
+		return framework.NoMatch()
+	}
+
+	if strings.HasSuffix(signal.Value, "_test.exs") {
+		return framework.DefiniteMatch("ExUnit test file naming convention: *_test.exs")
+	}
+
+	return framework.NoMatch()
+}
+
+// ExUnitContentMatcher matches ExUnit-specific patterns in file content.
+type ExUnitContentMatcher struct{}
+
+var exunitPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`\buse\s+ExUnit\.Case\b`), "use ExUnit.Case"},
+	{regexp.MustCompile(`\buse\s+[\w.]+\.(?:Conn|Data|Channel|Feature)Case\b`), "use case template"},
+	{regexp.MustCompile(`(?m)^\s*doctest\s+[A-Z][\w.]*`), "doctest declaration"},
+	{regexp.MustCompile(`(?m)^\s*test\s+"[^"]*"(?:,\s*[^\n]*)?\s+do\s*$`), "test block"},
+}
+
+func (m *ExUnitContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range exunitPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found ExUnit pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+// ExUnitParser extracts test definitions from Elixir ExUnit files.
+// Each defmodule becomes a suite of its test/property blocks and describe groups.
+// A doctest declaration counts as a single test because its examples are only
+// known once the target module's documentation is compiled (ADR-02).
+type ExUnitParser struct{}
+
+func (p *ExUnitParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageElixir, source)
+	if err != nil {
+		return nil, fmt.Errorf("exunit parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	file := &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageElixir,
+		Framework: frameworkName,
+	}
+
+	parser.WalkTree(tree.RootNode(), func(node *sitter.Node) bool {
+		if macroName(node, source) != macroDefmodule {
+			return true
+		}
+		if suite, ok := parseModule(node, source, filename); ok {
+			file.Suites = append(file.Suites, suite)
+		}
+		return false
+	})
+
+	return file, nil
+}
+
+// tagSet accumulates the tags in effect for the next test.
+type tagSet struct {
+	tags     []string
+	skip     bool
+	modifier string
+}
+
+func (s tagSet) merge(other tagSet) tagSet {
+	merged := tagSet{
+		tags:     append(append([]string{}, s.tags...), other.tags...),
+		skip:     s.skip || other.skip,
+		modifier: s.modifier,
+	}
+	if merged.modifier == "" {
+		merged.modifier = other.modifier
+	}
+	return merged
+}
+
+// scope is the state shared by the tests of a module or describe block.
+type scope struct {
+	source   []byte
+	filename string
+	// inherited holds @moduletag/@describetag values that apply to every test in the scope.
+	inherited tagSet
+	// pending holds @tag values waiting for the next test.
+	pending tagSet
+}
+
+func parseModule(node *sitter.Node, source []byte, filename string) (domain.TestSuite, bool) {
+	suite := domain.TestSuite{
+		Name:     moduleName(node, source),
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(node, filename),
+	}
+
+	body := doBlock(node)
+	if body == nil {
+		return suite, false
+	}
+
+	s := &scope{source: source, filename: filename}
+	s.inherited = collectScopeTags(body, source, attrModuleTag)
+	if s.inherited.skip {
+		suite.Status = domain.TestStatusSkipped
+		suite.Modifier = s.inherited.modifier
+	}
+
+	s.walk(body, &suite)
+
+	return suite, len(suite.Tests) > 0 || len(suite.Suites) > 0
+}
+
+// walk visits the statements of a block, registering tests and describe groups.
+func (s *scope) walk(block *sitter.Node, suite *domain.TestSuite) {
+	for i := 0; i < int(block.NamedChildCount()); i++ {
+		child := block.NamedChild(i)
+
+		if attr, value := moduleAttribute(child, s.source); attr != "" {
+			if attr == attrTag {
+				s.pending = s.pending.merge(parseTags(value, child, s.source))
+			}
+			continue
+		}
+
+		switch name := macroName(child, s.source); {
+		case name == macroDefmodule:
+			// Helper modules nested in a test module group their own tests.
+			if nested, ok := parseModule(child, s.source, s.filename); ok {
+				suite.Suites = append(suite.Suites, nested)
+			}
+		case name == macroDescribe:
+			s.describe(child, suite)
+		case testMacros[name]:
+			s.test(child, suite)
+		case name == macroDoctest:
+			s.doctest(child, suite)
+		default:
+			// Tests generated in comprehensions or helper blocks: for x <- xs do test "..." end
+			if body := doBlock(child); body != nil {
+				s.walk(body, suite)
+			}
+		}
+	}
+}
+
+func (s *scope) describe(node *sitter.Node, suite *domain.TestSuite) {
+	name, ok := firstStringArg(node, s.source)
+	body := doBlock(node)
+	if !ok || body == nil {
+		return
+	}
+
+	nested := domain.TestSuite{
+		Name:     name,
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(node, s.filename),
+	}
+
+	describeTags := collectScopeTags(body, s.source, attrDescribeTag)
+	if describeTags.skip {
+		nested.Status = domain.TestStatusSkipped
+		nested.Modifier = describeTags.modifier
+	}
+
+	inner := &scope{
+		source:    s.source,
+		filename:  s.filename,
+		inherited: s.inherited.merge(describeTags),
+	}
+	inner.walk(body, &nested)
+
+	if len(nested.Tests) > 0 || len(nested.Suites) > 0 {
+		suite.Suites = append(suite.Suites, nested)
+	}
+}
+
+func (s *scope) test(node *sitter.Node, suite *domain.TestSuite) {
+	pending := s.pending
+	s.pending = tagSet{}
+
+	name, ok := firstStringArg(node, s.source)
+	if !ok {
+		return
+	}
+
+	test := domain.Test{
+		Name:     name,
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(node, s.filename),
+		Tags:     s.inherited.merge(pending).tags,
+	}
+
+	switch {
+	case !hasBody(node, s.source):
+		// A test without a body is reported by ExUnit as not implemented.
+		test.Status = domain.TestStatusTodo
+		test.Modifier = "not_implemented"
+	case pending.skip:
+		test.Status = domain.TestStatusSkipped
+		test.Modifier = pending.modifier
+	}
+
+	suite.Tests = append(suite.Tests, test)
+}
+
+func (s *scope) doctest(node *sitter.Node, suite *domain.TestSuite) {
+	args := arguments(node)
+	if len(args) == 0 {
+		return
+	}
+	suite.Tests = append(suite.Tests, domain.Test{
+		Name:     macroDoctest + " " + parser.GetNodeText(args[0], s.source),
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(node, s.filename),
+	})
+}
+
+// collectScopeTags gathers the @moduletag or @describetag attributes declared directly in a block.
+func collectScopeTags(block *sitter.Node, source []byte, attr string) tagSet {
+	var tags tagSet
+	for i := 0; i < int(block.NamedChildCount()); i++ {
+		child := block.NamedChild(i)
+		if name, value := moduleAttribute(child, source); name == attr {
+			tags = tags.merge(parseTags(value, child, source))
+		}
+	}
+	return tags
+}
+
+// parseTags reads the value of a tag attribute: an atom (:slow), a keyword list
+// (timeout: 120_000, skip: "reason") or a list of atoms ([:slow, :db]).
+func parseTags(args []*sitter.Node, attrNode *sitter.Node, source []byte) tagSet {
+	var tags tagSet
+	add := func(key, value string) {
+		if key == tagSkip && value != "false" && value != "nil" {
+			tags.skip = true
+			tags.modifier = parser.GetNodeText(attrNode, source)
+			return
+		}
+		if value == "" {
+			tags.tags = append(tags.tags, key)
+			return
+		}
+		tags.tags = append(tags.tags, key+":"+value)
+	}
+
+	var visit func(node *sitter.Node)
+	visit = func(node *sitter.Node) {
+		switch node.Type() {
+		case nodeAtom:
+			add(strings.TrimPrefix(parser.GetNodeText(node, source), ":"), "")
+		case nodeKeywords, nodeList:
+			for i := 0; i < int(node.NamedChildCount()); i++ {
+				visit(node.NamedChild(i))
+			}
+		case nodePair:
+			key := node.ChildByFieldName("key")
+			value := node.ChildByFieldName("value")
+			if key == nil || value == nil {
+				return
+			}
+			add(strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(parser.GetNodeText(key, source)), ":")),
+				strings.Trim(parser.GetNodeText(value, source), `"`))
+		}
+	}
+	for _, arg := range args {
+		visit(arg)
+	}
+	return tags
+}
+
+// moduleAttribute returns the name and arguments of a module attribute such as @tag :skip.
+func moduleAttribute(node *sitter.Node, source []byte) (string, []*sitter.Node) {
+	if node.Type() != nodeUnaryOperator {
+		return "", nil
+	}
+	operator := node.ChildByFieldName("operator")
+	operand := node.ChildByFieldName("operand")
+	if operator == nil || operand == nil || parser.GetNodeText(operator, source) != "@" {
+		return "", nil
+	}
+	name := macroName(operand, source)
+	if name != attrTag && name != attrDescribeTag && name != attrModuleTag {
+		return "", nil
+	}
+	return name, arguments(operand)
+}
+
+// macroName returns the name of a local call such as test "..." do, or "" for other nodes.
+func macroName(node *sitter.Node, source []byte) string {
+	if node == nil || node.Type() != nodeCall {
+		return ""
+	}
+	target := node.ChildByFieldName("target")
+	if target == nil || target.Type() != nodeIdentifier {
+		return ""
+	}
+	return parser.GetNodeText(target, source)
+}
+
+func moduleName(node *sitter.Node, source []byte) string {
+	for _, arg := range arguments(node) {
+		if arg.Type() == nodeAlias {
+			return parser.GetNodeText(arg, source)
+		}
+	}
+	return ""
+}
+
+func arguments(call *sitter.Node) []*sitter.Node {
+	for i := 0; i < int(call.NamedChildCount()); i++ {
+		child := call.NamedChild(i)
+		if child.Type() != nodeArguments {
+			continue
+		}
+		args := make([]*sitter.Node, 0, child.NamedChildCount())
+		for j := 0; j < int(child.NamedChildCount()); j++ {
+			args = append(args, child.NamedChild(j))
+		}
+		return args
+	}
+	return nil
+}
+
+func doBlock(call *sitter.Node) *sitter.Node {
+	for i := 0; i < int(call.NamedChildCount()); i++ {
+		if child := call.NamedChild(i); child.Type() == nodeDoBlock {
+			return child
+		}
+	}
+	return nil
+}
+
+// hasBody reports whether a test has a do block or the keyword form: test "name", do: ...
+func hasBody(call *sitter.Node, source []byte) bool {
+	if doBlock(call) != nil {
+		return true
+	}
+	for _, arg := range arguments(call) {
+		if arg.Type() != nodeKeywords {
+			continue
+		}
+		for i := 0; i < int(arg.NamedChildCount()); i++ {
+			pair := arg.NamedChild(i)
+			if key := pair.ChildByFieldName("key"); key != nil &&
+				strings.TrimSuffix(strings.TrimSpace(parser.GetNodeText(key, source)), ":") == keyDo {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// firstStringArg returns the content of the first argument when it is a string literal.
+// Interpolations are kept verbatim since their values are only known at runtime.
+func firstStringArg(call *sitter.Node, source []byte) (string, bool) {
+	args := arguments(call)
+	if len(args) == 0 || args[0].Type() != nodeString {
+		return "", false
+	}
+	text := parser.GetNodeText(args[0], source)
+	if strings.HasPrefix(text, `"""`) && strings.HasSuffix(text, `"""`) && len(text) >= 6 {
+		return strings.TrimSpace(text[3 : len(text)-3]), true
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(text, `"`), `"`), true
+}
@@ -0,0 +1,261 @@
+package exunit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+func TestExUnitParser_Parse(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		checkFunc func(t *testing.T, file *domain.TestFile)
+	}{
+		{
+			name: "tests, describe blocks and doctest",
+			source: `
+defmodule MyApp.UserTest do
+  use MyApp.DataCase, async: true
+
+  doctest MyApp.User
+
+  test "creates a user" do
+    assert {:ok, _} = Accounts.create_user(%{})
+  end
+
+  describe "changeset/2" do
+    test "requires email", %{conn: conn} do
+      refute changeset.valid?
+    end
+
+    test "inline body", do: assert(true)
+  end
+end
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				suite := file.Suites[0]
+				if suite.Name != "MyApp.UserTest" {
+					t.Errorf("expected suite 'MyApp.UserTest', got %q", suite.Name)
+				}
+				if len(suite.Tests) != 2 {
+					t.Fatalf("expected 2 module tests, got %d", len(suite.Tests))
+				}
+				if suite.Tests[0].Name != "doctest MyApp.User" {
+					t.Errorf("expected doctest entry, got %q", suite.Tests[0].Name)
+				}
+				if suite.Tests[1].Name != "creates a user" || suite.Tests[1].Location.StartLine != 7 {
+					t.Errorf("unexpected test %q at line %d", suite.Tests[1].Name, suite.Tests[1].Location.StartLine)
+				}
+				if len(suite.Suites) != 1 || suite.Suites[0].Name != "changeset/2" {
+					t.Fatalf("expected describe 'changeset/2', got %+v", suite.Suites)
+				}
+				if len(suite.Suites[0].Tests) != 2 {
+					t.Errorf("expected 2 describe tests, got %d", len(suite.Suites[0].Tests))
+				}
+				if file.CountTests() != 4 {
+					t.Errorf("expected 4 tests, got %d", file.CountTests())
+				}
+			},
+		},
+		{
+			name: "tags, skip and not implemented tests",
+			source: `
+defmodule MyApp.ApiTest do
+  use ExUnit.Case
+  @moduletag :integration
+
+  @tag :skip
+  test "skipped" do
+  end
+
+  @tag skip: "flaky on CI"
+  test "skipped with reason" do
+  end
+
+  @tag timeout: 120_000
+  @tag :slow
+  test "slow" do
+  end
+
+  test "runs without tags" do
+  end
+
+  test "not implemented"
+end
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				tests := file.Suites[0].Tests
+				if len(tests) != 5 {
+					t.Fatalf("expected 5 tests, got %d", len(tests))
+				}
+				if tests[0].Status != domain.TestStatusSkipped || tests[0].Modifier != "@tag :skip" {
+					t.Errorf("expected skipped test, got %q/%q", tests[0].Status, tests[0].Modifier)
+				}
+				if tests[1].Status != domain.TestStatusSkipped {
+					t.Errorf("expected skip: reason to skip, got %q", tests[1].Status)
+				}
+				if tags := tests[2].Tags; len(tags) != 3 || tags[0] != "integration" || tags[1] != "timeout:120_000" || tags[2] != "slow" {
+					t.Errorf("expected tags [integration timeout:120_000 slow], got %v", tags)
+				}
+				if tests[3].Status != domain.TestStatusActive || len(tests[3].Tags) != 1 {
+					t.Errorf("expected @tag to apply only to the next test, got %q with %v", tests[3].Status, tests[3].Tags)
+				}
+				if tests[4].Status != domain.TestStatusTodo {
+					t.Errorf("expected bodiless test as todo, got %q", tests[4].Status)
+				}
+			},
+		},
+		{
+			name: "module and describe skip tags",
+			source: `
+defmodule MyApp.LegacyTest do
+  use ExUnit.Case
+  @moduletag :skip
+
+  test "legacy" do
+  end
+end
+
+defmodule MyApp.OtherTest do
+  use ExUnit.Case
+
+  describe "broken" do
+    @describetag :skip
+    test "a" do
+    end
+  end
+end
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 2 {
+					t.Fatalf("expected 2 suites, got %d", len(file.Suites))
+				}
+				if file.Suites[0].Status != domain.TestStatusSkipped || file.Suites[0].Modifier != "@moduletag :skip" {
+					t.Errorf("expected skipped module, got %q/%q", file.Suites[0].Status, file.Suites[0].Modifier)
+				}
+				describe := file.Suites[1].Suites[0]
+				if describe.Status != domain.TestStatusSkipped {
+					t.Errorf("expected skipped describe, got %q", describe.Status)
+				}
+			},
+		},
+		{
+			name: "tests generated in a comprehension",
+			source: `
+defmodule MyApp.MathTest do
+  use ExUnit.Case
+
+  for n <- [1, 2, 3] do
+    test "square #{n}" do
+      assert n * n > 0
+    end
+  end
+end
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				tests := file.Suites[0].Tests
+				if len(tests) != 1 || tests[0].Name != "square #{n}" {
+					t.Errorf("expected 1 dynamic test, got %+v", tests)
+				}
+			},
+		},
+		{
+			name: "module without tests",
+			source: `
+defmodule MyApp.Support.Factory do
+  def build(:user), do: %{}
+end
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 0 {
+					t.Errorf("expected no suites, got %d", len(file.Suites))
+				}
+			},
+		},
+	}
+
+	parser := &ExUnitParser{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := parser.Parse(ctx, []byte(tt.source), "user_test.exs")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if file.Framework != frameworkName {
+				t.Errorf("expected framework %q, got %q", frameworkName, file.Framework)
+			}
+
+			if file.Language != domain.LanguageElixir {
+				t.Errorf("expected language Elixir, got %q", file.Language)
+			}
+
+			if tt.checkFunc != nil {
+				tt.checkFunc(t, file)
+			}
+		})
+	}
+}
+
+func TestExUnitFileMatcher_Match(t *testing.T) {
+	tests := []struct {
+		filename  string
+		wantMatch bool
+	}{
+		{"test/my_app/user_test.exs", true},
+		{"test/test_helper.exs", false},
+		{"lib/my_app/user.ex", false},
+	}
+
+	matcher := &ExUnitFileMatcher{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			signal := framework.Signal{Type: framework.SignalFileName, Value: tt.filename}
+			result := matcher.Match(ctx, signal)
+			if (result.Confidence > 0) != tt.wantMatch {
+				t.Errorf("Match() confidence = %v, want match = %v", result.Confidence, tt.wantMatch)
+			}
+		})
+	}
+}
+
+func TestExUnitContentMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantMatch bool
+	}{
+		{"use ExUnit.Case", "use ExUnit.Case, async: true", true},
+		{"case template", "use MyAppWeb.ConnCase", true},
+		{"doctest", "  doctest MyApp.User", true},
+		{"test block", `  test "works", %{conn: conn} do`, true},
+		{"plain module", "defmodule MyApp.User do\nend", false},
+	}
+
+	matcher := &ExUnitContentMatcher{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := framework.Signal{
+				Type:    framework.SignalFileContent,
+				Value:   tt.content,
+				Context: []byte(tt.content),
+			}
+			result := matcher.Match(ctx, signal)
+			if (result.Confidence > 0) != tt.wantMatch {
+				t.Errorf("Match() confidence = %v, want match = %v", result.Confidence, tt.wantMatch)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Package gherkin implements Gherkin feature file support for Cucumber-style runners.
+//
+// The same .feature file runs under cucumber-js, Cucumber-JVM, behave or Reqnroll, so the
+// runner is inferred from the surrounding project: each runner registers a config parser
+// whose scope claims the feature files below it. Files outside any runner's scope fall back
+// to the generic "cucumber" framework through content detection.
+package gherkin
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/framework"
+	"github.com/specvital/core/pkg/parser/framework/matchers"
+	"github.com/specvital/core/pkg/parser/strategies/shared/jvmbuild"
+)
+
+// cucumberJVMDependency identifies Cucumber-JVM in Maven and Gradle build files.
+var cucumberJVMDependency = []byte("io.cucumber")
+
+// mavenResourcesDir is where cucumber.properties lives in a Maven/Gradle layout.
+const mavenResourcesDir = "src/test/resources"
+
+func init() {
+	for _, def := range NewDefinitions() {
+		framework.Register(def)
+	}
+}
+
+// NewDefinitions returns the generic Cucumber definition and one definition per runner.
+func NewDefinitions() []*framework.Definition {
+	return []*framework.Definition{
+		{
+			Name:      framework.FrameworkCucumber,
+			Languages: []domain.Language{domain.LanguageGherkin},
+			Matchers: []framework.Matcher{
+				&GherkinContentMatcher{},
+			},
+			ConfigParser: nil,
+			Parser:       &GherkinParser{framework: framework.FrameworkCucumber},
+			Priority:     framework.PriorityGeneric,
+		},
+		newRunnerDefinition(framework.FrameworkCucumberJS, &DirConfigParser{framework: framework.FrameworkCucumberJS},
+			"cucumber.js", "cucumber.cjs", "cucumber.mjs", "cucumber.json", "cucumber.yaml", "cucumber.yml"),
+		newRunnerDefinition(framework.FrameworkCucumberJVM, &CucumberJVMConfigParser{},
+			"pom.xml", "build.gradle", "build.gradle.kts", "cucumber.properties"),
+		newRunnerDefinition(framework.FrameworkBehave, &DirConfigParser{framework: framework.FrameworkBehave},
+			"behave.ini", ".behaverc"),
+		newRunnerDefinition(framework.FrameworkReqnroll, &DirConfigParser{framework: framework.FrameworkReqnroll},
+			"reqnroll.json"),
+	}
+}
+
+func newRunnerDefinition(name string, configParser framework.ConfigParser, configFiles ...string) *framework.Definition {
+	return &framework.Definition{
+		Name:      name,
+		Languages: []domain.Language{domain.LanguageGherkin},
+		Matchers: []framework.Matcher{
+			matchers.NewConfigMatcher(configFiles...),
+		},
+		ConfigParser: configParser,
+		Parser:       &GherkinParser{framework: name},
+		Priority:     framework.PrioritySpecialized,
+	}
+}
+
+// GherkinContentMatcher matches the Feature keyword that every feature file starts with.
+type GherkinContentMatcher struct{}
+
+var gherkinPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`(?m)^\s*(?:Feature|Business Need|Ability)\s*:`), "Feature keyword"},
+}
+
+func (m *GherkinContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range gherkinPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found Gherkin pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+// DirConfigParser scopes a runner to the directory containing its config file.
+type DirConfigParser struct {
+	framework string
+}
+
+func (p *DirConfigParser) Parse(ctx context.Context, configPath string, content []byte) (*framework.ConfigScope, error) {
+	scope := framework.NewConfigScope(configPath, "")
+	scope.Framework = p.framework
+	return scope, nil
+}
+
+// CucumberJVMConfigParser scopes Cucumber-JVM to projects whose build declares an
+// io.cucumber dependency, or that ship a cucumber.properties file.
+// Build files without the dependency are declined with a nil scope; those with it
+// keep the test setup of the build for the project's JUnit and TestNG tests.
+type CucumberJVMConfigParser struct{}
+
+func (p *CucumberJVMConfigParser) Parse(ctx context.Context, configPath string, content []byte) (*framework.ConfigScope, error) {
+	if filepath.Base(configPath) != "cucumber.properties" && !bytes.Contains(content, cucumberJVMDependency) {
+		return nil, nil
+	}
+
+	scope := framework.NewConfigScope(configPath, "")
+	scope.Framework = framework.FrameworkCucumberJVM
+	if err := jvmbuild.Apply(scope, configPath, content); err != nil {
+		return nil, err
+	}
+
+	// cucumber.properties sits in src/test/resources; the project root is above it.
+	dir := filepath.ToSlash(scope.BaseDir)
+	if root, ok := strings.CutSuffix(dir, "/"+mavenResourcesDir); ok {
+		scope.BaseDir = filepath.FromSlash(root)
+	}
+	return scope, nil
+}
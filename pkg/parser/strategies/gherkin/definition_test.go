@@ -0,0 +1,101 @@
+package gherkin
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+func TestGherkinContentMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantMatch bool
+	}{
+		{"feature keyword", "Feature: Login\n  Scenario: ok", true},
+		{"indented feature after tags", "@smoke\n  Feature: Login", true},
+		{"business need", "Business Need: Billing", true},
+		{"plain text", "Scenario: without feature", false},
+	}
+
+	matcher := &GherkinContentMatcher{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := framework.Signal{
+				Type:    framework.SignalFileContent,
+				Value:   tt.content,
+				Context: []byte(tt.content),
+			}
+			result := matcher.Match(ctx, signal)
+			if (result.Confidence > 0) != tt.wantMatch {
+				t.Errorf("Match() confidence = %v, want match = %v", result.Confidence, tt.wantMatch)
+			}
+		})
+	}
+}
+
+func TestCucumberJVMConfigParser_Parse(t *testing.T) {
+	tests := []struct {
+		name        string
+		configPath  string
+		content     string
+		wantScope   bool
+		wantBaseDir string
+	}{
+		{
+			name:        "maven dependency",
+			configPath:  "/project/pom.xml",
+			content:     "<dependency><groupId>io.cucumber</groupId><artifactId>cucumber-java</artifactId></dependency>",
+			wantScope:   true,
+			wantBaseDir: "/project",
+		},
+		{
+			name:        "gradle dependency",
+			configPath:  "/project/app/build.gradle.kts",
+			content:     `testImplementation("io.cucumber:cucumber-java:7.15.0")`,
+			wantScope:   true,
+			wantBaseDir: "/project/app",
+		},
+		{
+			name:       "build file without cucumber",
+			configPath: "/project/pom.xml",
+			content:    "<dependency><groupId>org.junit.jupiter</groupId></dependency>",
+			wantScope:  false,
+		},
+		{
+			name:        "cucumber.properties in test resources",
+			configPath:  "/project/src/test/resources/cucumber.properties",
+			content:     "cucumber.publish.quiet=true",
+			wantScope:   true,
+			wantBaseDir: "/project",
+		},
+	}
+
+	parser := &CucumberJVMConfigParser{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope, err := parser.Parse(ctx, filepath.FromSlash(tt.configPath), []byte(tt.content))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if (scope != nil) != tt.wantScope {
+				t.Fatalf("expected scope = %v, got %+v", tt.wantScope, scope)
+			}
+			if scope == nil {
+				return
+			}
+			if scope.Framework != framework.FrameworkCucumberJVM {
+				t.Errorf("expected framework %q, got %q", framework.FrameworkCucumberJVM, scope.Framework)
+			}
+			if scope.BaseDir != filepath.FromSlash(tt.wantBaseDir) {
+				t.Errorf("expected BaseDir %q, got %q", tt.wantBaseDir, scope.BaseDir)
+			}
+		})
+	}
+}
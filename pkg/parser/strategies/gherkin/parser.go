@@ -0,0 +1,353 @@
+package gherkin
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/specvital/core/pkg/domain"
+)
+
+// Gherkin keywords (English dialect).
+var (
+	featureKeywords    = []string{"Feature", "Business Need", "Ability"}
+	ruleKeywords       = []string{"Rule"}
+	outlineKeywords    = []string{"Scenario Outline", "Scenario Template"}
+	scenarioKeywords   = []string{"Scenario", "Example"}
+	examplesKeywords   = []string{"Examples", "Scenarios"}
+	backgroundKeywords = []string{"Background"}
+)
+
+// Tags that change how a scenario runs. Runners exclude them through tag expressions
+// such as --tags "not @wip", so they are reported as statuses rather than executed tests.
+var tagStatuses = map[string]domain.TestStatus{
+	"@skip":     domain.TestStatusSkipped,
+	"@ignore":   domain.TestStatusSkipped,
+	"@disabled": domain.TestStatusSkipped,
+	"@wip":      domain.TestStatusTodo,
+}
+
+// GherkinParser extracts scenarios from Gherkin .feature files.
+// Feature becomes a suite, Rule a nested suite and Scenario a test. A Scenario Outline becomes
+// a suite with one test per Examples row, named by substituting the row into <placeholders>.
+type GherkinParser struct {
+	framework string
+}
+
+func (p *GherkinParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	file := &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageGherkin,
+		Framework: p.framework,
+	}
+
+	doc := &document{filename: filename}
+	scanner := bufio.NewScanner(bytes.NewReader(source))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(source)+1)
+
+	for line := 1; scanner.Scan(); line++ {
+		doc.readLine(scanner.Text(), line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("gherkin parser: failed to read %s: %w", filename, err)
+	}
+	if doc.feature != nil {
+		doc.finishOutline()
+		doc.finishRule()
+		file.Suites = append(file.Suites, *doc.feature)
+	}
+	return file, nil
+}
+
+// document is the parse state of a single .feature file.
+type document struct {
+	filename string
+
+	feature *domain.TestSuite
+	rule    *domain.TestSuite
+
+	featureTags []string
+	ruleTags    []string
+	// pendingTags are tag lines waiting for the next keyword.
+	pendingTags []string
+
+	outline *outline
+	// docStringDelimiter is the open """ or ``` fence, if inside a doc string.
+	docStringDelimiter string
+}
+
+// outline is a Scenario Outline whose Examples tables are being read.
+type outline struct {
+	suite    domain.TestSuite
+	tags     []string
+	examples *examples
+}
+
+type examples struct {
+	tags   []string
+	header []string
+}
+
+func (d *document) readLine(raw string, line int) {
+	text := strings.TrimSpace(raw)
+
+	if d.docStringDelimiter != "" {
+		if strings.HasPrefix(text, d.docStringDelimiter) {
+			d.docStringDelimiter = ""
+		}
+		return
+	}
+
+	switch {
+	case text == "" || strings.HasPrefix(text, "#"):
+		return
+	case strings.HasPrefix(text, `"""`):
+		d.docStringDelimiter = `"""`
+		return
+	case strings.HasPrefix(text, "```"):
+		d.docStringDelimiter = "```"
+		return
+	case strings.HasPrefix(text, "@"):
+		d.pendingTags = append(d.pendingTags, parseTags(text)...)
+		return
+	case strings.HasPrefix(text, "|"):
+		d.readTableRow(text, line)
+		return
+	}
+
+	if name, ok := keyword(text, featureKeywords); ok {
+		d.finishOutline()
+		d.featureTags = d.takeTags()
+		d.feature = &domain.TestSuite{
+			Name:     name,
+			Location: d.location(line),
+		}
+		d.feature.Status, d.feature.Modifier = statusFor(d.featureTags)
+		return
+	}
+
+	if d.feature == nil {
+		return
+	}
+
+	if name, ok := keyword(text, ruleKeywords); ok {
+		d.finishOutline()
+		d.finishRule()
+		d.ruleTags = d.takeTags()
+		d.rule = &domain.TestSuite{
+			Name:     name,
+			Location: d.location(line),
+		}
+		d.rule.Status, d.rule.Modifier = statusFor(append(append([]string{}, d.featureTags...), d.ruleTags...))
+		return
+	}
+
+	if name, ok := keyword(text, outlineKeywords); ok {
+		d.finishOutline()
+		tags := d.inheritedTags(d.takeTags())
+		status, modifier := statusFor(tags)
+		d.outline = &outline{
+			suite: domain.TestSuite{
+				Name:     name,
+				Status:   status,
+				Modifier: modifier,
+				Location: d.location(line),
+			},
+			tags: tags,
+		}
+		return
+	}
+
+	if _, ok := keyword(text, examplesKeywords); ok && d.outline != nil {
+		d.outline.examples = &examples{tags: d.takeTags()}
+		return
+	}
+
+	if name, ok := keyword(text, scenarioKeywords); ok {
+		d.finishOutline()
+		tags := d.inheritedTags(d.takeTags())
+		status, modifier := statusFor(tags)
+		d.addTest(domain.Test{
+			Name:     name,
+			Status:   status,
+			Modifier: modifier,
+			Location: d.location(line),
+			Tags:     tags,
+		})
+		return
+	}
+
+	if _, ok := keyword(text, backgroundKeywords); ok {
+		d.finishOutline()
+		d.pendingTags = nil
+		return
+	}
+
+	// Steps and free-form descriptions; a step ends the Examples header of an outline.
+	d.pendingTags = nil
+}
+
+// readTableRow consumes a | cell | row. Only Examples tables produce tests; step
+// data tables are ignored.
+func (d *document) readTableRow(text string, line int) {
+	if d.outline == nil || d.outline.examples == nil {
+		return
+	}
+
+	cells := parseRow(text)
+	ex := d.outline.examples
+	if ex.header == nil {
+		ex.header = cells
+		return
+	}
+
+	name := d.outline.suite.Name
+	for i, column := range ex.header {
+		if i < len(cells) {
+			name = strings.ReplaceAll(name, "<"+column+">", cells[i])
+		}
+	}
+
+	tags := append(append([]string{}, d.outline.tags...), ex.tags...)
+	status, modifier := statusFor(tags)
+	d.outline.suite.Tests = append(d.outline.suite.Tests, domain.Test{
+		Name:     name,
+		Status:   status,
+		Modifier: modifier,
+		Location: d.location(line),
+		Tags:     tags,
+	})
+}
+
+// finishOutline attaches the current Scenario Outline. An outline without Examples rows
+// generates no scenarios at runtime, so it is reported as a single todo test.
+func (d *document) finishOutline() {
+	if d.outline == nil {
+		return
+	}
+	o := d.outline
+	d.outline = nil
+
+	if len(o.suite.Tests) == 0 {
+		d.addTest(domain.Test{
+			Name:     o.suite.Name,
+			Status:   domain.TestStatusTodo,
+			Location: o.suite.Location,
+			Tags:     o.tags,
+		})
+		return
+	}
+	d.addSuite(o.suite)
+}
+
+func (d *document) finishRule() {
+	if d.rule == nil {
+		return
+	}
+	d.feature.Suites = append(d.feature.Suites, *d.rule)
+	d.rule = nil
+	d.ruleTags = nil
+}
+
+func (d *document) addTest(test domain.Test) {
+	if d.rule != nil {
+		d.rule.Tests = append(d.rule.Tests, test)
+		return
+	}
+	d.feature.Tests = append(d.feature.Tests, test)
+}
+
+func (d *document) addSuite(suite domain.TestSuite) {
+	if d.rule != nil {
+		d.rule.Suites = append(d.rule.Suites, suite)
+		return
+	}
+	d.feature.Suites = append(d.feature.Suites, suite)
+}
+
+func (d *document) takeTags() []string {
+	tags := d.pendingTags
+	d.pendingTags = nil
+	return tags
+}
+
+// inheritedTags returns the Feature and Rule tags followed by the element's own tags.
+func (d *document) inheritedTags(own []string) []string {
+	var tags []string
+	tags = append(tags, d.featureTags...)
+	tags = append(tags, d.ruleTags...)
+	return append(tags, own...)
+}
+
+func (d *document) location(line int) domain.Location {
+	return domain.Location{File: d.filename, StartLine: line, EndLine: line}
+}
+
+// keyword matches "Keyword: name" and returns the trimmed name.
+func keyword(text string, keywords []string) (string, bool) {
+	for _, kw := range keywords {
+		if rest, ok := strings.CutPrefix(text, kw); ok {
+			if rest, ok := strings.CutPrefix(strings.TrimLeft(rest, " \t"), ":"); ok {
+				return strings.TrimSpace(rest), true
+			}
+		}
+	}
+	return "", false
+}
+
+// parseTags splits a tag line, dropping a trailing comment: @smoke @wip # flaky.
+func parseTags(text string) []string {
+	var tags []string
+	for _, field := range strings.Fields(text) {
+		if strings.HasPrefix(field, "#") {
+			break
+		}
+		if strings.HasPrefix(field, "@") {
+			tags = append(tags, field)
+		}
+	}
+	return tags
+}
+
+// parseRow splits a table row into trimmed cells, honouring \| escapes.
+func parseRow(text string) []string {
+	text = strings.TrimSpace(text)
+	text = strings.TrimPrefix(text, "|")
+	text = strings.TrimSuffix(text, "|")
+
+	var cells []string
+	var cell strings.Builder
+	for i := 0; i < len(text); i++ {
+		switch {
+		case text[i] == '\\' && i+1 < len(text) && text[i+1] == '|':
+			cell.WriteByte('|')
+			i++
+		case text[i] == '|':
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteByte(text[i])
+		}
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+// statusFor maps the first status tag to a status; skip tags win over @wip.
+func statusFor(tags []string) (domain.TestStatus, string) {
+	status, modifier := domain.TestStatusActive, ""
+	for _, tag := range tags {
+		s, ok := tagStatuses[strings.ToLower(tag)]
+		if !ok {
+			continue
+		}
+		if s == domain.TestStatusSkipped {
+			return s, tag
+		}
+		if status == domain.TestStatusActive {
+			status, modifier = s, tag
+		}
+	}
+	return status, modifier
+}
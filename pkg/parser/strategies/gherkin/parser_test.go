@@ -0,0 +1,178 @@
+package gherkin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+func TestGherkinParser_Parse(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		checkFunc func(t *testing.T, file *domain.TestFile)
+	}{
+		{
+			name: "feature with scenarios and background",
+			source: `# language: en
+@auth
+Feature: Login
+  As a user I want to sign in.
+
+  Background:
+    Given the app is running
+
+  Scenario: Successful login
+    Given a registered user
+    When they sign in
+    Then they see the dashboard
+
+  @wip
+  Scenario: Remember me
+    Given a registered user
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				feature := file.Suites[0]
+				if feature.Name != "Login" || feature.Location.StartLine != 3 {
+					t.Errorf("unexpected feature %q at line %d", feature.Name, feature.Location.StartLine)
+				}
+				if len(feature.Tests) != 2 {
+					t.Fatalf("expected 2 scenarios, got %d", len(feature.Tests))
+				}
+				if feature.Tests[0].Name != "Successful login" || feature.Tests[0].Location.StartLine != 9 {
+					t.Errorf("unexpected scenario %q at line %d", feature.Tests[0].Name, feature.Tests[0].Location.StartLine)
+				}
+				if tags := feature.Tests[0].Tags; len(tags) != 1 || tags[0] != "@auth" {
+					t.Errorf("expected inherited tags [@auth], got %v", tags)
+				}
+				if feature.Tests[1].Status != domain.TestStatusTodo || feature.Tests[1].Modifier != "@wip" {
+					t.Errorf("expected @wip as todo, got %q/%q", feature.Tests[1].Status, feature.Tests[1].Modifier)
+				}
+			},
+		},
+		{
+			name: "scenario outline with examples",
+			source: `Feature: Eating
+  Scenario Outline: eating <eat> of <start> cucumbers
+    Given there are <start> cucumbers
+    When I eat <eat> cucumbers
+    Then I should have <left> cucumbers
+
+    Examples:
+      | start | eat | left |
+      |    12 |   5 |    7 |
+      |    20 |   5 |   15 |
+
+    @skip
+    Examples: Edge cases
+      | start | eat | left |
+      |     0 |   0 |    0 |
+
+  Scenario Outline: no examples yet
+    Given <nothing>
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				feature := file.Suites[0]
+				if len(feature.Suites) != 1 {
+					t.Fatalf("expected 1 outline suite, got %d", len(feature.Suites))
+				}
+				outline := feature.Suites[0]
+				if len(outline.Tests) != 3 {
+					t.Fatalf("expected 3 example rows, got %d", len(outline.Tests))
+				}
+				if outline.Tests[0].Name != "eating 5 of 12 cucumbers" || outline.Tests[0].Location.StartLine != 9 {
+					t.Errorf("unexpected example %q at line %d", outline.Tests[0].Name, outline.Tests[0].Location.StartLine)
+				}
+				if outline.Tests[2].Status != domain.TestStatusSkipped {
+					t.Errorf("expected tagged Examples to be skipped, got %q", outline.Tests[2].Status)
+				}
+				if len(feature.Tests) != 1 || feature.Tests[0].Status != domain.TestStatusTodo {
+					t.Errorf("expected outline without examples as todo, got %+v", feature.Tests)
+				}
+				if file.CountTests() != 4 {
+					t.Errorf("expected 4 tests, got %d", file.CountTests())
+				}
+			},
+		},
+		{
+			name: "rules, doc strings and step tables",
+			source: `@ignore
+Feature: Highlander
+
+  Rule: There can be only One
+
+    Example: Only One -- More than one alive
+      Given there are 3 ninjas
+        | name  |
+        | Kenji |
+      And a description
+        """
+        Scenario: not a scenario
+        """
+
+  @smoke
+  Rule: There can be Two (in some cases)
+
+    Scenario: Two -- Dead and Reborn as Phoenix
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				feature := file.Suites[0]
+				if feature.Status != domain.TestStatusSkipped {
+					t.Errorf("expected @ignore feature to be skipped, got %q", feature.Status)
+				}
+				if len(feature.Suites) != 2 {
+					t.Fatalf("expected 2 rules, got %d", len(feature.Suites))
+				}
+				first := feature.Suites[0]
+				if first.Name != "There can be only One" || len(first.Tests) != 1 {
+					t.Errorf("expected rule with 1 example, got %q with %d", first.Name, len(first.Tests))
+				}
+				second := feature.Suites[1]
+				if len(second.Tests) != 1 || second.Tests[0].Status != domain.TestStatusSkipped {
+					t.Fatalf("expected inherited skip in second rule, got %+v", second.Tests)
+				}
+				if tags := second.Tests[0].Tags; len(tags) != 2 || tags[1] != "@smoke" {
+					t.Errorf("expected tags [@ignore @smoke], got %v", tags)
+				}
+			},
+		},
+		{
+			name:   "file without feature",
+			source: "# just a comment\n",
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 0 {
+					t.Errorf("expected no suites, got %d", len(file.Suites))
+				}
+			},
+		},
+	}
+
+	parser := &GherkinParser{framework: framework.FrameworkCucumberJS}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := parser.Parse(ctx, []byte(tt.source), "login.feature")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if file.Framework != framework.FrameworkCucumberJS {
+				t.Errorf("expected framework %q, got %q", framework.FrameworkCucumberJS, file.Framework)
+			}
+
+			if file.Language != domain.LanguageGherkin {
+				t.Errorf("expected language Gherkin, got %q", file.Language)
+			}
+
+			if tt.checkFunc != nil {
+				tt.checkFunc(t, file)
+			}
+		})
+	}
+}
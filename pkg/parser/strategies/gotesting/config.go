@@ -0,0 +1,56 @@
+package gotesting
+
+import (
+	"context"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/specvital/core/pkg/parser/framework"
+	"github.com/specvital/core/pkg/parser/strategies/shared/gomod"
+)
+
+// settingModulePath holds the module path of a go.mod (string).
+const settingModulePath = "modulePath"
+
+// GoConfigParser reads go.mod and go.work.
+//
+// A go.mod gives the packages below it their import paths, so the parser can name the
+// package each test file runs in; a nested module takes over its directory as the
+// nearer config, and is no part of the workspace project around it. A go.work names
+// the modules it uses as projects, so the tests of a workspace are grouped by module.
+type GoConfigParser struct{}
+
+func (p *GoConfigParser) Parse(ctx context.Context, configPath string, content []byte) (*framework.ConfigScope, error) {
+	scope := framework.NewConfigScope(configPath, "")
+	scope.Framework = frameworkName
+
+	if filepath.Base(configPath) == gomod.WorkFile {
+		// The modules give the import paths; the workspace only names them.
+		scope.Passive = true
+		scope.ProjectPatterns = gomod.UseDirs(content)
+		return scope, nil
+	}
+
+	scope.ProjectBoundary = true
+	if module := gomod.ModulePath(content); module != "" {
+		scope.Settings[settingModulePath] = module
+	}
+	return scope, nil
+}
+
+// importPath returns the import path of the package of the file, as go test reports
+// it, or "" when no go.mod names its module.
+func importPath(filename string, scope *framework.ConfigScope) string {
+	if scope == nil {
+		return ""
+	}
+	module, _ := scope.Settings[settingModulePath].(string)
+	if module == "" {
+		return ""
+	}
+	if dir := path.Dir(scope.RelPath(filename)); dir != "." && !strings.HasPrefix(dir, "/") {
+		return module + "/" + dir
+	}
+	return module
+}
@@ -13,16 +13,40 @@ import (
 	"github.com/specvital/core/pkg/parser"
 	"github.com/specvital/core/pkg/parser/framework"
 	"github.com/specvital/core/pkg/parser/framework/matchers"
+	"github.com/specvital/core/pkg/parser/strategies/shared/gomod"
 )
 
 const (
 	frameworkName                = "go-testing"
+	nodeArrayType                = "array_type"
 	nodeCallExpression           = "call_expression"
+	nodeCompositeLiteral         = "composite_literal"
+	nodeExpressionList           = "expression_list"
+	nodeFieldDeclaration         = "field_declaration"
+	nodeFieldDeclarationList     = "field_declaration_list"
+	nodeForStatement             = "for_statement"
 	nodeFunctionDeclaration      = "function_declaration"
+	nodeIdentifier               = "identifier"
+	nodeImplicitLengthArrayType  = "implicit_length_array_type"
+	nodeKeyedElement             = "keyed_element"
+	nodeLiteralElement           = "literal_element"
+	nodeLiteralValue             = "literal_value"
+	nodeMapType                  = "map_type"
+	nodePackageClause            = "package_clause"
+	nodePackageIdentifier        = "package_identifier"
 	nodeParameterDeclaration     = "parameter_declaration"
 	nodePointerType              = "pointer_type"
 	nodeQualifiedType            = "qualified_type"
+	nodeRangeClause              = "range_clause"
 	nodeSelectorExpression       = "selector_expression"
+	nodeShortVarDeclaration      = "short_var_declaration"
+	nodeSliceType                = "slice_type"
+	nodeStructType               = "struct_type"
+	nodeTypeDeclaration          = "type_declaration"
+	nodeTypeIdentifier           = "type_identifier"
+	nodeTypeSpec                 = "type_spec"
+	nodeVarDeclaration           = "var_declaration"
+	nodeVarSpec                  = "var_spec"
 	nodeInterpretedStringLiteral = "interpreted_string_literal"
 	nodeRawStringLiteral         = "raw_string_literal"
 	methodRun                    = "Run"
@@ -52,8 +76,9 @@ func NewDefinition() *framework.Definition {
 		Matchers: []framework.Matcher{
 			matchers.NewImportMatcher("testing"),
 			&GoTestFileMatcher{},
+			matchers.NewConfigMatcher(gomod.ModFile, gomod.WorkFile),
 		},
-		ConfigParser: nil, // Go doesn't have config files
+		ConfigParser: &GoConfigParser{},
 		Parser:       &GoTestingParser{},
 		Priority:     framework.PriorityGeneric,
 	}
@@ -77,6 +102,15 @@ func (m *GoTestFileMatcher) Match(ctx context.Context, signal framework.Signal)
 type GoTestingParser struct{}
 
 func (p *GoTestingParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	return p.parse(ctx, source, filename, nil)
+}
+
+// ParseWithScope names the package of the file after the module of the nearest go.mod.
+func (p *GoTestingParser) ParseWithScope(ctx context.Context, source []byte, filename string, scope *framework.ConfigScope) (*domain.TestFile, error) {
+	return p.parse(ctx, source, filename, scope)
+}
+
+func (p *GoTestingParser) parse(ctx context.Context, source []byte, filename string, scope *framework.ConfigScope) (*domain.TestFile, error) {
 	tree, err := parser.ParseWithPool(ctx, domain.LanguageGo, source)
 	if err != nil {
 		return nil, fmt.Errorf("go-testing parser: failed to parse %s: %w", filename, err)
@@ -84,52 +118,60 @@ func (p *GoTestingParser) Parse(ctx context.Context, source []byte, filename str
 	defer tree.Close()
 	root := tree.RootNode()
 
-	suites, tests := parseTestFunctions(root, source, filename)
+	pkg := importPath(filename, scope)
+	suites, tests := parseTestFunctions(root, source, filename, pkg)
 
 	testFile := &domain.TestFile{
-		Path:      filename,
-		Language:  domain.LanguageGo,
-		Framework: frameworkName,
-		Suites:    suites,
-		Tests:     tests,
+		Path:            filename,
+		Language:        domain.LanguageGo,
+		Framework:       frameworkName,
+		Package:         pkg,
+		ExternalPackage: strings.HasSuffix(packageName(root, source), "_test"),
+		Suites:          suites,
+		Tests:           tests,
 	}
 
 	return testFile, nil
 }
 
-func extractSubtests(body *sitter.Node, source []byte, filename string) []domain.Test {
+// packageName returns the name of the package clause of the file.
+func packageName(root *sitter.Node, source []byte) string {
+	clause := parser.FindChildByType(root, nodePackageClause)
+	if clause == nil {
+		return ""
+	}
+	if name := parser.FindChildByType(clause, nodePackageIdentifier); name != nil {
+		return parser.GetNodeText(name, source)
+	}
+	return ""
+}
+
+func extractSubtests(body, root *sitter.Node, source []byte, filename, testName, importPath string) []domain.Test {
 	var subtests []domain.Test
 
 	parser.WalkTree(body, func(node *sitter.Node) bool {
-		if node.Type() != nodeCallExpression {
+		args, ok := runCallArgs(node, source)
+		if !ok {
 			return true
 		}
 
-		funcNode := node.ChildByFieldName("function")
-		if funcNode == nil || funcNode.Type() != nodeSelectorExpression {
-			return true
-		}
-
-		field := funcNode.ChildByFieldName("field")
-		if field == nil || parser.GetNodeText(field, source) != methodRun {
-			return true
-		}
-
-		args := node.ChildByFieldName("arguments")
-		if args == nil {
-			return true
+		var found []domain.Test
+		if name := extractSubtestName(args, source); name != "" {
+			found = []domain.Test{{
+				Name:     name,
+				Status:   domain.TestStatusActive,
+				Location: parser.GetLocation(node, filename),
+			}}
+		} else {
+			found = expandTableSubtests(node, args, root, source, filename)
 		}
 
-		name := extractSubtestName(args, source)
-		if name == "" {
-			return true
+		if parents, ok := enclosingSubtestNames(node, source); ok && importPath != "" {
+			for i := range found {
+				found[i].ID = qualifiedName(importPath, testName, append(parents, found[i].Name)...)
+			}
 		}
-
-		subtests = append(subtests, domain.Test{
-			Name:     name,
-			Status:   domain.TestStatusActive,
-			Location: parser.GetLocation(node, filename),
-		})
+		subtests = append(subtests, found...)
 
 		return true
 	})
@@ -137,6 +179,44 @@ func extractSubtests(body *sitter.Node, source []byte, filename string) []domain
 	return subtests
 }
 
+// runCallArgs returns the arguments of a t.Run call.
+func runCallArgs(node *sitter.Node, source []byte) (*sitter.Node, bool) {
+	if node.Type() != nodeCallExpression {
+		return nil, false
+	}
+
+	funcNode := node.ChildByFieldName("function")
+	if funcNode == nil || funcNode.Type() != nodeSelectorExpression {
+		return nil, false
+	}
+
+	field := funcNode.ChildByFieldName("field")
+	if field == nil || parser.GetNodeText(field, source) != methodRun {
+		return nil, false
+	}
+
+	args := node.ChildByFieldName("arguments")
+	return args, args != nil
+}
+
+// enclosingSubtestNames returns the names of the t.Run calls around a t.Run call,
+// outermost first, or false when one of them is not a literal.
+func enclosingSubtestNames(call *sitter.Node, source []byte) ([]string, bool) {
+	var names []string
+	for node := call.Parent(); node != nil; node = node.Parent() {
+		args, ok := runCallArgs(node, source)
+		if !ok {
+			continue
+		}
+		name := extractSubtestName(args, source)
+		if name == "" {
+			return nil, false
+		}
+		names = append([]string{name}, names...)
+	}
+	return names, true
+}
+
 func extractSubtestName(args *sitter.Node, source []byte) string {
 	for i := 0; i < int(args.ChildCount()); i++ {
 		child := args.Child(i)
@@ -178,7 +258,7 @@ func classifyTestFunction(name string) goTestFuncType {
 	return funcTypeNone
 }
 
-func parseTestFunctions(root *sitter.Node, source []byte, filename string) ([]domain.TestSuite, []domain.Test) {
+func parseTestFunctions(root *sitter.Node, source []byte, filename, importPath string) ([]domain.TestSuite, []domain.Test) {
 	var suites []domain.TestSuite
 	var tests []domain.Test
 
@@ -201,7 +281,7 @@ func parseTestFunctions(root *sitter.Node, source []byte, filename string) ([]do
 		body := child.ChildByFieldName("body")
 		var subtests []domain.Test
 		if body != nil && funcType == funcTypeTest {
-			subtests = extractSubtests(body, source, filename)
+			subtests = extractSubtests(body, root, source, filename, name, importPath)
 		}
 
 		if len(subtests) > 0 {
@@ -218,6 +298,9 @@ func parseTestFunctions(root *sitter.Node, source []byte, filename string) ([]do
 				Status:   domain.TestStatusActive,
 				Location: parser.GetLocation(child, filename),
 			}
+			if importPath != "" {
+				test.ID = qualifiedName(importPath, name)
+			}
 			tests = append(tests, test)
 		}
 	}
@@ -20,9 +20,9 @@ func TestNewDefinition(t *testing.T) {
 		[]domain.Language{domain.LanguageGo},
 		def.Languages,
 	)
-	assert.Nil(t, def.ConfigParser, "Go testing should not have config parser")
+	assert.NotNil(t, def.ConfigParser)
 	assert.NotNil(t, def.Parser)
-	assert.Len(t, def.Matchers, 2) // ImportMatcher + GoTestFileMatcher
+	assert.Len(t, def.Matchers, 3) // ImportMatcher + GoTestFileMatcher + ConfigMatcher
 }
 
 func TestGoTestFileMatcher_Match(t *testing.T) {
@@ -543,3 +543,308 @@ func ExampleInvalid(t *testing.T) {}
 		})
 	}
 }
+
+func TestGoTestingParser_TableDrivenSubtests(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		wantNames []string
+	}{
+		{
+			name: "local slice table with keyed name field",
+			source: `
+package test
+import "testing"
+func TestAdd(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b int
+	}{
+		{name: "positive", a: 1, b: 2},
+		{name: "negative", a: -1, b: -2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {})
+	}
+}
+`,
+			wantNames: []string{"positive", "negative"},
+		},
+		{
+			name: "package-level table with desc field",
+			source: `
+package test
+import "testing"
+var cases = []struct {
+	desc string
+	in   int
+}{
+	{desc: "zero", in: 0},
+	{desc: "one", in: 1},
+}
+func TestCases(t *testing.T) {
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {})
+	}
+}
+`,
+			wantNames: []string{"zero", "one"},
+		},
+		{
+			name: "positional elements resolved by struct field order",
+			source: `
+package test
+import "testing"
+type testCase struct {
+	in   int
+	name string
+}
+func TestPositional(t *testing.T) {
+	for _, tt := range []testCase{
+		{1, "first"},
+		{2, "second"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {})
+	}
+}
+`,
+			wantNames: []string{"first", "second"},
+		},
+		{
+			name: "map table keyed by name",
+			source: `
+package test
+import "testing"
+func TestMap(t *testing.T) {
+	cases := map[string]struct{ in int }{
+		"alpha": {1},
+		"beta":  {2},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) { _ = tc })
+	}
+}
+`,
+			wantNames: []string{"alpha", "beta"},
+		},
+		{
+			name: "non-literal name falls back to index",
+			source: `
+package test
+import "testing"
+func TestFallback(t *testing.T) {
+	tests := []struct{ name string }{
+		{name: "literal"},
+		{name: prefix + "computed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {})
+	}
+}
+`,
+			wantNames: []string{"literal", "#01"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parser := &GoTestingParser{}
+
+			testFile, err := parser.Parse(context.Background(), []byte(tt.source), "table_test.go")
+
+			require.NoError(t, err)
+			require.Len(t, testFile.Suites, 1)
+
+			var names []string
+			for _, test := range testFile.Suites[0].Tests {
+				names = append(names, test.Name)
+			}
+			assert.Equal(t, tt.wantNames, names)
+		})
+	}
+}
+
+func TestGoTestingParser_UnresolvedTable(t *testing.T) {
+	testSource := `
+package test
+import "testing"
+func TestExternal(t *testing.T) {
+	for _, tt := range loadCases() {
+		t.Run(tt.name, func(t *testing.T) {})
+	}
+}
+`
+
+	parser := &GoTestingParser{}
+
+	testFile, err := parser.Parse(context.Background(), []byte(testSource), "external_test.go")
+
+	require.NoError(t, err)
+	assert.Empty(t, testFile.Suites)
+	require.Len(t, testFile.Tests, 1)
+	assert.Equal(t, "TestExternal", testFile.Tests[0].Name)
+}
+
+func TestGoConfigParser_Parse(t *testing.T) {
+	ctx := context.Background()
+	parser := &GoConfigParser{}
+
+	t.Run("go.mod", func(t *testing.T) {
+		content := `// Shop services.
+module "example.com/shop" // deprecated: use example.com/store
+
+go 1.24
+
+require github.com/stretchr/testify v1.11.1
+`
+		scope, err := parser.Parse(ctx, "/repo/shop/go.mod", []byte(content))
+
+		require.NoError(t, err)
+		assert.Equal(t, frameworkName, scope.Framework)
+		assert.False(t, scope.Passive)
+		assert.Equal(t, "/repo/shop", scope.BaseDir)
+		assert.Equal(t, "example.com/shop", scope.Settings[settingModulePath])
+	})
+
+	t.Run("go.work", func(t *testing.T) {
+		content := `go 1.24
+
+use ./tools
+use (
+	./shop
+	"./shop/plugins" // nested module
+	../shared
+)
+`
+		scope, err := parser.Parse(ctx, "/repo/go.work", []byte(content))
+
+		require.NoError(t, err)
+		assert.Equal(t, frameworkName, scope.Framework)
+		assert.True(t, scope.Passive)
+		assert.Equal(t, []string{"tools", "shop", "shop/plugins"}, scope.ProjectPatterns)
+	})
+}
+
+func TestGoTestingParser_ParseWithScope(t *testing.T) {
+	scope := framework.NewConfigScope("/repo/shop/go.mod", "")
+	scope.Settings[settingModulePath] = "example.com/shop"
+	scope.SourceRoot = "/repo"
+
+	tests := []struct {
+		name         string
+		filename     string
+		pkg          string
+		wantPackage  string
+		wantExternal bool
+	}{
+		{
+			name:        "package in module root",
+			filename:    "shop/shop_test.go",
+			pkg:         "shop",
+			wantPackage: "example.com/shop",
+		},
+		{
+			name:        "nested package",
+			filename:    "shop/internal/orders/orders_test.go",
+			pkg:         "orders",
+			wantPackage: "example.com/shop/internal/orders",
+		},
+		{
+			name:         "external test package",
+			filename:     "shop/internal/orders/example_test.go",
+			pkg:          "orders_test",
+			wantPackage:  "example.com/shop/internal/orders",
+			wantExternal: true,
+		},
+	}
+
+	parser := &GoTestingParser{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := "package " + tt.pkg + "\nimport \"testing\"\nfunc TestCheckout(t *testing.T) {}\n"
+
+			testFile, err := parser.ParseWithScope(context.Background(), []byte(source), tt.filename, scope)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPackage, testFile.Package)
+			assert.Equal(t, tt.wantExternal, testFile.ExternalPackage)
+		})
+	}
+
+	t.Run("without go.mod", func(t *testing.T) {
+		testFile, err := parser.Parse(context.Background(), []byte("package orders_test\n"), "orders/example_test.go")
+
+		require.NoError(t, err)
+		assert.Empty(t, testFile.Package)
+		assert.True(t, testFile.ExternalPackage)
+	})
+}
+
+func TestQualifiedName(t *testing.T) {
+	tests := []struct {
+		name       string
+		importPath string
+		test       string
+		subtests   []string
+		want       string
+	}{
+		{"top-level test", "example.com/shop/orders", "TestCheckout", nil, "example.com/shop/orders.TestCheckout"},
+		{"subtests", "example.com/shop/orders", "TestCheckout", []string{"empty cart", "with\tcoupon"}, "example.com/shop/orders.TestCheckout/empty_cart/with_coupon"},
+		{"unprintable rune", "example.com/shop", "TestParse", []string{"nul\x00byte"}, `example.com/shop.TestParse/nul\x00byte`},
+		{"slash in subtest", "example.com/shop", "TestRoutes", []string{"GET /orders"}, "example.com/shop.TestRoutes/GET_/orders"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, qualifiedName(tt.importPath, tt.test, tt.subtests...))
+		})
+	}
+}
+
+func TestGoTestingParser_TestIDs(t *testing.T) {
+	testSource := `
+package orders_test
+
+import "testing"
+
+func TestCheckout(t *testing.T) {
+	t.Run("empty cart", func(t *testing.T) {
+		t.Run("with coupon", func(t *testing.T) {})
+	})
+	for _, tt := range []struct{ name string }{{name: "one item"}} {
+		t.Run(tt.name, func(t *testing.T) {})
+	}
+}
+
+func TestRefund(t *testing.T) {}
+`
+	scope := framework.NewConfigScope("/repo/go.mod", "")
+	scope.Settings[settingModulePath] = "example.com/shop"
+	scope.SourceRoot = "/repo"
+
+	parser := &GoTestingParser{}
+
+	testFile, err := parser.ParseWithScope(context.Background(), []byte(testSource), "orders/orders_test.go", scope)
+
+	require.NoError(t, err)
+	require.Len(t, testFile.Suites, 1)
+	var ids []string
+	for _, test := range testFile.Suites[0].Tests {
+		ids = append(ids, test.ID)
+	}
+	assert.Equal(t, []string{
+		"example.com/shop/orders.TestCheckout/empty_cart",
+		"example.com/shop/orders.TestCheckout/empty_cart/with_coupon",
+		"example.com/shop/orders.TestCheckout/one_item",
+	}, ids)
+	require.Len(t, testFile.Tests, 1)
+	assert.Equal(t, "example.com/shop/orders.TestRefund", testFile.Tests[0].ID)
+
+	t.Run("without go.mod", func(t *testing.T) {
+		testFile, err := parser.Parse(context.Background(), []byte(testSource), "orders/orders_test.go")
+
+		require.NoError(t, err)
+		assert.Empty(t, testFile.Tests[0].ID)
+		assert.Empty(t, testFile.Suites[0].Tests[0].ID)
+	})
+}
@@ -0,0 +1,35 @@
+package gotesting
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// qualifiedName returns the fully qualified name go test reports for a test of the
+// package at importPath ("example.com/shop/orders.TestCheckout/empty_cart"). Subtest
+// names are rewritten the way t.Run does: spaces become underscores and unprintable
+// runes are escaped.
+func qualifiedName(importPath, test string, subtests ...string) string {
+	name := importPath + "." + test
+	for _, subtest := range subtests {
+		name += "/" + rewriteSubtestName(subtest)
+	}
+	return name
+}
+
+func rewriteSubtestName(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		switch {
+		case unicode.IsSpace(r):
+			b.WriteByte('_')
+		case !strconv.IsPrint(r):
+			quoted := strconv.QuoteRune(r)
+			b.WriteString(quoted[1 : len(quoted)-1])
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,378 @@
+package gotesting
+
+import (
+	"fmt"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+)
+
+// tableRange describes a `for key, value := range target` loop enclosing a t.Run call.
+type tableRange struct {
+	keyVar   string
+	valueVar string
+	target   *sitter.Node
+	loop     *sitter.Node
+}
+
+// expandTableSubtests expands table-driven subtests into one test per table element.
+// Handles t.Run(tt.name, ...) within `for _, tt := range tests` and
+// t.Run(name, ...) within `for name, tc := range cases` over map literals.
+// Returns nil when the table cannot be resolved statically.
+func expandTableSubtests(call, args, root *sitter.Node, source []byte, filename string) []domain.Test {
+	if args.NamedChildCount() == 0 {
+		return nil
+	}
+	nameArg := args.NamedChild(0)
+
+	loop := findEnclosingRange(call, source)
+	if loop == nil {
+		return nil
+	}
+
+	var field string
+	useKey := false
+	switch nameArg.Type() {
+	case nodeSelectorExpression:
+		operand := nameArg.ChildByFieldName("operand")
+		fieldNode := nameArg.ChildByFieldName("field")
+		if operand == nil || fieldNode == nil || loop.valueVar == "" ||
+			parser.GetNodeText(operand, source) != loop.valueVar {
+			return nil
+		}
+		field = parser.GetNodeText(fieldNode, source)
+	case nodeIdentifier:
+		if loop.keyVar == "" || parser.GetNodeText(nameArg, source) != loop.keyVar {
+			return nil
+		}
+		useKey = true
+	default:
+		return nil
+	}
+
+	table := resolveTableLiteral(loop, root, source)
+	if table == nil {
+		return nil
+	}
+
+	body := table.ChildByFieldName("body")
+	if body == nil {
+		return nil
+	}
+
+	tableType := table.ChildByFieldName("type")
+	isMap := tableType != nil && tableType.Type() == nodeMapType
+	if useKey && !isMap {
+		return nil
+	}
+
+	fieldIndex := -1
+	if field != "" {
+		fieldIndex = structFieldIndex(tableElementType(tableType), field, root, source)
+	}
+
+	var subtests []domain.Test
+	index := 0
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		element := body.NamedChild(i)
+
+		var key, value *sitter.Node
+		switch element.Type() {
+		case nodeKeyedElement:
+			key, value = keyedElementParts(element)
+		case nodeLiteralElement:
+			value = element
+		default:
+			continue
+		}
+
+		var name string
+		if useKey {
+			name = stringLiteralValue(key, source)
+		} else {
+			name = elementFieldValue(value, field, fieldIndex, source)
+		}
+		if name == "" {
+			name = fmt.Sprintf("#%02d", index)
+		}
+
+		subtests = append(subtests, domain.Test{
+			Name:     name,
+			Status:   domain.TestStatusActive,
+			Location: parser.GetLocation(element, filename),
+		})
+		index++
+	}
+
+	return subtests
+}
+
+// findEnclosingRange returns the nearest enclosing range loop of node.
+func findEnclosingRange(node *sitter.Node, source []byte) *tableRange {
+	for current := node.Parent(); current != nil; current = current.Parent() {
+		if current.Type() == nodeFunctionDeclaration {
+			return nil
+		}
+		if current.Type() != nodeForStatement {
+			continue
+		}
+
+		clause := parser.FindChildByType(current, nodeRangeClause)
+		if clause == nil {
+			continue
+		}
+
+		right := clause.ChildByFieldName("right")
+		if right == nil {
+			continue
+		}
+
+		loop := &tableRange{target: right, loop: current}
+		if left := clause.ChildByFieldName("left"); left != nil {
+			idents := parser.FindChildrenByType(left, nodeIdentifier)
+			if len(idents) > 0 {
+				loop.keyVar = identName(idents[0], source)
+			}
+			if len(idents) > 1 {
+				loop.valueVar = identName(idents[1], source)
+			}
+		}
+		return loop
+	}
+	return nil
+}
+
+func identName(node *sitter.Node, source []byte) string {
+	name := parser.GetNodeText(node, source)
+	if name == "_" {
+		return ""
+	}
+	return name
+}
+
+// resolveTableLiteral resolves the range target to a composite literal,
+// looking first in the enclosing function and then at package scope.
+func resolveTableLiteral(loop *tableRange, root *sitter.Node, source []byte) *sitter.Node {
+	if loop.target.Type() == nodeCompositeLiteral {
+		return loop.target
+	}
+	if loop.target.Type() != nodeIdentifier {
+		return nil
+	}
+	name := parser.GetNodeText(loop.target, source)
+
+	for current := loop.loop.Parent(); current != nil; current = current.Parent() {
+		if current.Type() != nodeFunctionDeclaration {
+			continue
+		}
+		body := current.ChildByFieldName("body")
+		if body == nil {
+			break
+		}
+		var found *sitter.Node
+		parser.WalkTree(body, func(node *sitter.Node) bool {
+			if node.StartByte() >= loop.loop.StartByte() {
+				return false
+			}
+			if lit := declaredLiteral(node, name, source); lit != nil {
+				found = lit
+			}
+			return true
+		})
+		if found != nil {
+			return found
+		}
+		break
+	}
+
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		child := root.NamedChild(i)
+		if child.Type() != nodeVarDeclaration {
+			continue
+		}
+		var found *sitter.Node
+		parser.WalkTree(child, func(node *sitter.Node) bool {
+			if lit := declaredLiteral(node, name, source); lit != nil {
+				found = lit
+				return false
+			}
+			return true
+		})
+		if found != nil {
+			return found
+		}
+	}
+
+	return nil
+}
+
+// declaredLiteral returns the composite literal assigned to name by a
+// short var declaration or var spec, or nil if node declares something else.
+func declaredLiteral(node *sitter.Node, name string, source []byte) *sitter.Node {
+	var names, values *sitter.Node
+	switch node.Type() {
+	case nodeShortVarDeclaration:
+		names = node.ChildByFieldName("left")
+		values = node.ChildByFieldName("right")
+	case nodeVarSpec:
+		names = node
+		values = node.ChildByFieldName("value")
+	default:
+		return nil
+	}
+	if names == nil || values == nil {
+		return nil
+	}
+
+	position := -1
+	idents := parser.FindChildrenByType(names, nodeIdentifier)
+	for i, ident := range idents {
+		if parser.GetNodeText(ident, source) == name {
+			position = i
+			break
+		}
+	}
+	if position < 0 {
+		return nil
+	}
+
+	if values.Type() == nodeExpressionList {
+		if position >= int(values.NamedChildCount()) {
+			return nil
+		}
+		values = values.NamedChild(position)
+	}
+	if values.Type() != nodeCompositeLiteral {
+		return nil
+	}
+	return values
+}
+
+// tableElementType returns the element type of a slice, array or map table type.
+func tableElementType(tableType *sitter.Node) *sitter.Node {
+	if tableType == nil {
+		return nil
+	}
+	switch tableType.Type() {
+	case nodeMapType:
+		return tableType.ChildByFieldName("value")
+	case nodeSliceType, nodeArrayType, nodeImplicitLengthArrayType:
+		return tableType.ChildByFieldName("element")
+	}
+	return nil
+}
+
+// structFieldIndex returns the declaration position of field within a struct type,
+// following a package-level type declaration for named types. Returns -1 if unknown.
+func structFieldIndex(elemType *sitter.Node, field string, root *sitter.Node, source []byte) int {
+	if elemType == nil {
+		return -1
+	}
+	if elemType.Type() == nodePointerType && elemType.NamedChildCount() > 0 {
+		elemType = elemType.NamedChild(0)
+	}
+	if elemType.Type() == nodeTypeIdentifier {
+		elemType = findNamedType(parser.GetNodeText(elemType, source), root, source)
+	}
+	if elemType == nil || elemType.Type() != nodeStructType {
+		return -1
+	}
+
+	fields := parser.FindChildByType(elemType, nodeFieldDeclarationList)
+	if fields == nil {
+		return -1
+	}
+
+	index := 0
+	for _, decl := range parser.FindChildrenByType(fields, nodeFieldDeclaration) {
+		names := 0
+		for i := 0; i < int(decl.ChildCount()); i++ {
+			if decl.FieldNameForChild(i) != "name" {
+				continue
+			}
+			if parser.GetNodeText(decl.Child(i), source) == field {
+				return index
+			}
+			index++
+			names++
+		}
+		if names == 0 {
+			// Embedded field
+			index++
+		}
+	}
+	return -1
+}
+
+func findNamedType(name string, root *sitter.Node, source []byte) *sitter.Node {
+	for _, decl := range parser.FindChildrenByType(root, nodeTypeDeclaration) {
+		for _, spec := range parser.FindChildrenByType(decl, nodeTypeSpec) {
+			specName := spec.ChildByFieldName("name")
+			if specName != nil && parser.GetNodeText(specName, source) == name {
+				return spec.ChildByFieldName("type")
+			}
+		}
+	}
+	return nil
+}
+
+// keyedElementParts splits a keyed_element into its key and value nodes.
+func keyedElementParts(element *sitter.Node) (key, value *sitter.Node) {
+	var parts []*sitter.Node
+	for i := 0; i < int(element.NamedChildCount()); i++ {
+		parts = append(parts, element.NamedChild(i))
+	}
+	if len(parts) != 2 {
+		return nil, nil
+	}
+	return parts[0], parts[1]
+}
+
+// elementFieldValue returns the string literal assigned to field in a table element,
+// matching keyed fields by name and positional fields by fieldIndex.
+func elementFieldValue(value *sitter.Node, field string, fieldIndex int, source []byte) string {
+	if value == nil {
+		return ""
+	}
+	if value.Type() == nodeLiteralElement && value.NamedChildCount() > 0 {
+		value = value.NamedChild(0)
+	}
+	if value.Type() != nodeLiteralValue {
+		return ""
+	}
+
+	position := 0
+	for i := 0; i < int(value.NamedChildCount()); i++ {
+		child := value.NamedChild(i)
+		switch child.Type() {
+		case nodeKeyedElement:
+			key, val := keyedElementParts(child)
+			if key != nil && parser.GetNodeText(key, source) == field {
+				return stringLiteralValue(val, source)
+			}
+		case nodeLiteralElement:
+			if position == fieldIndex {
+				return stringLiteralValue(child, source)
+			}
+			position++
+		}
+	}
+	return ""
+}
+
+// stringLiteralValue returns the unquoted value if node is a string literal.
+func stringLiteralValue(node *sitter.Node, source []byte) string {
+	if node == nil {
+		return ""
+	}
+	if node.Type() == nodeLiteralElement && node.NamedChildCount() > 0 {
+		node = node.NamedChild(0)
+	}
+	switch node.Type() {
+	case nodeInterpretedStringLiteral, nodeRawStringLiteral:
+		return trimQuotes(parser.GetNodeText(node, source))
+	}
+	return ""
+}
@@ -2,13 +2,16 @@ package jest
 
 import (
 	"context"
+	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/specvital/core/pkg/domain"
 	"github.com/specvital/core/pkg/parser/framework"
 	"github.com/specvital/core/pkg/parser/framework/matchers"
+	"github.com/specvital/core/pkg/parser/strategies/shared/jsconfig"
 	"github.com/specvital/core/pkg/parser/strategies/shared/jstest"
 )
 
@@ -28,6 +31,7 @@ func NewDefinition() *framework.Definition {
 				"jest.config.mjs",
 				"jest.config.cjs",
 				"jest.config.json",
+				packageJSONFile,
 			),
 			&JestContentMatcher{},
 		},
@@ -83,81 +87,196 @@ func (m *JestContentMatcher) Match(ctx context.Context, signal framework.Signal)
 
 type JestConfigParser struct{}
 
+const (
+	// packageJSONFile holds a Jest config under its "jest" key.
+	packageJSONFile = "package.json"
+
+	rootDirToken = "<rootDir>"
+)
+
+// defaultTestMatch is Jest's default testMatch.
+var defaultTestMatch = []string{
+	"**/__tests__/**/*.{js,jsx,ts,tsx,mjs,cjs,mts,cts}",
+	"**/{*.,}{spec,test}.{js,jsx,ts,tsx,mjs,cjs,mts,cts}",
+}
+
+// Parse reads jest.config.* files and the "jest" key of package.json; package.json
+// files without one are declined. testMatch, testRegex and the ignore patterns select
+// the files, with <rootDir> standing for the resolved rootDir. Each projects entry
+// becomes a project: inline configs named by their displayName, and path or glob
+// entries as ProjectPatterns for the scanner to expand. A config with a displayName
+// is itself a project, which names the files of a projects entry pointing at it.
 func (p *JestConfigParser) Parse(ctx context.Context, configPath string, content []byte) (*framework.ConfigScope, error) {
-	rootDir := parseRootDir(content)
+	config, err := jsconfig.Evaluate(ctx, configPath, content)
+	if filepath.Base(configPath) == packageJSONFile {
+		// package.json belongs to npm; only a valid "jest" object is ours.
+		if config = config.Get("jest"); err != nil || !config.IsObject() {
+			return nil, nil
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	rootDir := parseRootDir(config)
 	scope := framework.NewConfigScope(configPath, rootDir)
 	scope.Framework = frameworkName
-	scope.GlobalsMode = !parseInjectGlobalsFalse(content) // Jest defaults to true
+	scope.GlobalsMode = !parseInjectGlobalsFalse(config) // Jest defaults to true
+	scope.IncludeCollects = true
 
 	configDir := filepath.Dir(configPath)
-	roots := parseRoots(content, configDir, rootDir)
+	roots := parseRoots(config, configDir, rootDir)
 	if len(roots) > 0 {
 		scope.Roots = roots
 	}
 
 	// Parse test match patterns as include patterns
-	if testMatch := parseTestMatch(content); len(testMatch) > 0 {
+	if testMatch := rootDirPatterns(config.Get("testMatch").Strings()); len(testMatch) > 0 {
 		scope.Include = testMatch
 	}
+	scope.IncludeRegexps = parseTestRegex(config, scope.BaseDir)
 
 	// Parse ignore patterns as exclude patterns
-	var excludePatterns []string
-	if testPathIgnore := parseTestPathIgnorePatterns(content); len(testPathIgnore) > 0 {
-		excludePatterns = append(excludePatterns, testPathIgnore...)
-	}
-	if modulePathIgnore := parseModulePathIgnorePatterns(content); len(modulePathIgnore) > 0 {
-		excludePatterns = append(excludePatterns, modulePathIgnore...)
-	}
-	if len(excludePatterns) > 0 {
+	if excludePatterns := parseIgnorePatterns(config); len(excludePatterns) > 0 {
 		scope.Exclude = excludePatterns
 	}
 
+	if entries := config.Get("projects").Items(); len(entries) > 0 {
+		parseProjects(scope, entries)
+	} else if name := parseDisplayName(config); name != "" {
+		scope.Projects = append(scope.Projects, framework.ProjectScope{
+			Name:           name,
+			BaseDir:        scope.BaseDir,
+			Include:        scope.Include,
+			IncludeRegexps: scope.IncludeRegexps,
+			Exclude:        scope.Exclude,
+		})
+	}
+
 	return scope, nil
 }
 
-type JestParser struct{}
+// parseProjects adds the projects entries to scope. Entries are relative to rootDir.
+func parseProjects(scope *framework.ConfigScope, entries []jsconfig.Value) {
+	for _, entry := range entries {
+		if pattern, ok := entry.AsString(); ok {
+			scope.ProjectPatterns = append(scope.ProjectPatterns, projectPattern(scope.BaseDir, pattern))
+			continue
+		}
+		if !entry.IsObject() {
+			continue
+		}
 
-func (p *JestParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
-	return jstest.Parse(ctx, source, filename, frameworkName)
+		baseDir := scope.BaseDir
+		if rootDir := parseRootDir(entry); rootDir != "" {
+			baseDir = resolveRootDir(scope.BaseDir, strings.ReplaceAll(rootDir, rootDirToken, scope.BaseDir))
+		}
+		scope.Projects = append(scope.Projects, framework.ProjectScope{
+			Name:           parseDisplayName(entry),
+			BaseDir:        baseDir,
+			Include:        rootDirPatterns(entry.Get("testMatch").Strings()),
+			IncludeRegexps: parseTestRegex(entry, baseDir),
+			Exclude:        parseIgnorePatterns(entry),
+		})
+	}
+
+	// The root filters would hide the files only a project includes, and a root without
+	// filters would not name them as tests: the root then keeps Jest's default testMatch
+	// and adds the filters of every project.
+	if len(scope.Include) == 0 && len(scope.IncludeRegexps) == 0 {
+		if !slices.ContainsFunc(scope.Projects, hasFilters) {
+			return
+		}
+		scope.Include = slices.Clone(defaultTestMatch)
+	}
+	for _, project := range scope.Projects {
+		rel, err := filepath.Rel(scope.BaseDir, project.BaseDir)
+		if err == nil && !strings.HasPrefix(filepath.ToSlash(rel), "..") {
+			for _, pattern := range project.Include {
+				scope.Include = append(scope.Include, path.Join(filepath.ToSlash(rel), pattern))
+			}
+		}
+		scope.IncludeRegexps = append(scope.IncludeRegexps, project.IncludeRegexps...)
+	}
 }
 
-var (
-	configRootDirPattern          = regexp.MustCompile(`rootDir\s*:\s*['"]([^'"]+)['"]`)
-	configRootsPattern            = regexp.MustCompile(`roots\s*:\s*\[([^\]]+)\]`)
-	configRootItemPattern         = regexp.MustCompile(`['"]([^'"]+)['"]`)
-	injectGlobalsFalsePattern     = regexp.MustCompile(`injectGlobals\s*:\s*false`)
-	configTestMatchPattern        = regexp.MustCompile(`testMatch\s*:\s*\[([^\]]+)\]`)
-	configTestPathIgnorePattern   = regexp.MustCompile(`testPathIgnorePatterns\s*:\s*\[([^\]]+)\]`)
-	configModulePathIgnorePattern = regexp.MustCompile(`modulePathIgnorePatterns\s*:\s*\[([^\]]+)\]`)
-)
+func hasFilters(project framework.ProjectScope) bool {
+	return len(project.Include) > 0 || len(project.IncludeRegexps) > 0
+}
 
-func parseRootDir(content []byte) string {
-	if match := configRootDirPattern.FindSubmatch(content); match != nil {
-		return string(match[1])
+// projectPattern makes a projects entry relative to rootDir, keeping a "!" prefix.
+func projectPattern(rootDir, pattern string) string {
+	negated := strings.HasPrefix(pattern, "!")
+	pattern = strings.TrimPrefix(pattern, "!")
+	pattern = resolveRootDir(rootDir, strings.ReplaceAll(pattern, rootDirToken, rootDir))
+	if rel, err := filepath.Rel(rootDir, pattern); err == nil {
+		pattern = filepath.ToSlash(rel)
 	}
-	return ""
+	if negated {
+		return "!" + pattern
+	}
+	return pattern
 }
 
-func parseRoots(content []byte, configDir string, rootDir string) []string {
-	match := configRootsPattern.FindSubmatch(content)
-	if match == nil {
-		return nil
+// parseDisplayName returns displayName, which Jest also accepts as { name, color }.
+func parseDisplayName(config jsconfig.Value) string {
+	displayName := config.Get("displayName")
+	if name, ok := displayName.Get("name").AsString(); ok {
+		return name
+	}
+	name, _ := displayName.AsString()
+	return name
+}
+
+// parseTestRegex compiles testRegex, a pattern or array of patterns matched against
+// absolute paths. Patterns Go cannot compile (e.g. lookaheads) are skipped.
+func parseTestRegex(config jsconfig.Value, rootDir string) []*regexp.Regexp {
+	var regexps []*regexp.Regexp
+	for _, pattern := range config.Get("testRegex").Strings() {
+		pattern = strings.ReplaceAll(pattern, rootDirToken, regexp.QuoteMeta(filepath.ToSlash(rootDir)))
+		if re, err := regexp.Compile(pattern); err == nil {
+			regexps = append(regexps, re)
+		}
 	}
+	return regexps
+}
+
+func parseIgnorePatterns(config jsconfig.Value) []string {
+	var patterns []string
+	patterns = append(patterns, config.Get("testPathIgnorePatterns").Strings()...)
+	patterns = append(patterns, config.Get("modulePathIgnorePatterns").Strings()...)
+	return rootDirPatterns(patterns)
+}
+
+// rootDirPatterns makes "<rootDir>/src/**" patterns relative to rootDir.
+func rootDirPatterns(patterns []string) []string {
+	var result []string
+	for _, pattern := range patterns {
+		result = append(result, strings.TrimPrefix(pattern, rootDirToken+"/"))
+	}
+	return result
+}
+
+type JestParser struct{}
+
+func (p *JestParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	return jstest.Parse(ctx, source, filename, frameworkName)
+}
+
+func parseRootDir(config jsconfig.Value) string {
+	rootDir, _ := config.Get("rootDir").AsString()
+	return rootDir
+}
 
-	rootsContent := match[1]
-	items := configRootItemPattern.FindAllSubmatch(rootsContent, -1)
+func parseRoots(config jsconfig.Value, configDir string, rootDir string) []string {
+	items := config.Get("roots").Strings()
 	if len(items) == 0 {
 		return nil
 	}
 
-	resolvedRootDir := configDir
-	if rootDir != "" {
-		resolvedRootDir = filepath.Clean(filepath.Join(configDir, rootDir))
-	}
+	resolvedRootDir := resolveRootDir(configDir, rootDir)
 
 	var roots []string
-	for _, item := range items {
-		root := string(item[1])
+	for _, root := range items {
 		hadRootDirPlaceholder := strings.Contains(root, "<rootDir>")
 		root = strings.ReplaceAll(root, "<rootDir>", resolvedRootDir)
 
@@ -172,42 +291,15 @@ func parseRoots(content []byte, configDir string, rootDir string) []string {
 	return roots
 }
 
-func parseInjectGlobalsFalse(content []byte) bool {
-	return injectGlobalsFalsePattern.Match(content)
-}
-
-func parseTestMatch(content []byte) []string {
-	match := configTestMatchPattern.FindSubmatch(content)
-	if match == nil {
-		return nil
-	}
-	return extractJestPatterns(match[1])
-}
-
-func parseTestPathIgnorePatterns(content []byte) []string {
-	match := configTestPathIgnorePattern.FindSubmatch(content)
-	if match == nil {
-		return nil
-	}
-	return extractJestPatterns(match[1])
-}
-
-func parseModulePathIgnorePatterns(content []byte) []string {
-	match := configModulePathIgnorePattern.FindSubmatch(content)
-	if match == nil {
-		return nil
+// resolveRootDir resolves dir, which may be absolute, against base.
+func resolveRootDir(base, dir string) string {
+	if filepath.IsAbs(dir) {
+		return filepath.Clean(dir)
 	}
-	return extractJestPatterns(match[1])
+	return filepath.Clean(filepath.Join(base, dir))
 }
 
-func extractJestPatterns(content []byte) []string {
-	items := configRootItemPattern.FindAllSubmatch(content, -1)
-	if len(items) == 0 {
-		return nil
-	}
-	var patterns []string
-	for _, item := range items {
-		patterns = append(patterns, string(item[1]))
-	}
-	return patterns
+func parseInjectGlobalsFalse(config jsconfig.Value) bool {
+	injectGlobals, ok := config.Get("injectGlobals").AsBool()
+	return ok && !injectGlobals
 }
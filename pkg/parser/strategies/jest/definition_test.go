@@ -10,6 +10,7 @@ import (
 
 	"github.com/specvital/core/pkg/domain"
 	"github.com/specvital/core/pkg/parser/framework"
+	"github.com/specvital/core/pkg/parser/strategies/shared/jsconfig"
 )
 
 func TestNewDefinition(t *testing.T) {
@@ -316,6 +317,151 @@ module.exports = {
 	}
 }
 
+func TestJestConfigParser_ParseModule(t *testing.T) {
+	configContent := `
+import path from 'path';
+import type { Config } from 'jest';
+import base from '../../jest.base';
+
+const sharedIgnores = ['/node_modules/', '/dist/'];
+
+const config: Config = {
+  ...base,
+  rootDir: path.resolve(__dirname, 'src'),
+  // testMatch: ['**/old/*.test.ts'],
+  testMatch: [` + "`**/*.${'spec'}.ts`" + `],
+  testPathIgnorePatterns: [...sharedIgnores, '<rootDir>/fixtures/'],
+  globals: { injectGlobals: false },
+};
+
+export default config;
+`
+
+	scope, err := (&JestConfigParser{}).Parse(context.Background(), "/project/apps/web/jest.config.ts", []byte(configContent))
+
+	require.NoError(t, err)
+	assert.Equal(t, "/project/apps/web/src", scope.BaseDir)
+	assert.Equal(t, []string{"**/*.spec.ts"}, scope.Include)
+	assert.Equal(t, []string{"/node_modules/", "/dist/", "fixtures/"}, scope.Exclude)
+	assert.True(t, scope.GlobalsMode, "nested injectGlobals is not the top-level option")
+}
+
+func TestJestConfigParser_ParsePackageJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantScope bool
+	}{
+		{"jest key", `{"name": "web", "jest": {"testMatch": ["<rootDir>/src/**/*.test.js"], "injectGlobals": false}}`, true},
+		{"no jest key", `{"name": "web", "scripts": {"test": "vitest"}}`, false},
+		{"invalid json", `{"name": `, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope, err := (&JestConfigParser{}).Parse(context.Background(), "/project/package.json", []byte(tt.content))
+
+			require.NoError(t, err)
+			if !tt.wantScope {
+				assert.Nil(t, scope)
+				return
+			}
+			require.NotNil(t, scope)
+			assert.Equal(t, "/project", scope.BaseDir)
+			assert.Equal(t, []string{"src/**/*.test.js"}, scope.Include)
+			assert.False(t, scope.GlobalsMode)
+		})
+	}
+}
+
+func TestJestConfigParser_ParseTestRegex(t *testing.T) {
+	configContent := `module.exports = {
+  rootDir: 'app',
+  testRegex: ['(/__tests__/.*|(\\.|/)(test|spec))\\.[jt]sx?$', '<rootDir>/checks/.*\\.js$', '(?=lookahead)'],
+};`
+
+	scope, err := (&JestConfigParser{}).Parse(context.Background(), "/project/jest.config.js", []byte(configContent))
+
+	require.NoError(t, err)
+	require.Len(t, scope.IncludeRegexps, 2, "patterns Go cannot compile are skipped")
+	assert.True(t, scope.Contains("/project/app/src/__tests__/util.js"))
+	assert.True(t, scope.Contains("/project/app/src/util.spec.tsx"))
+	assert.True(t, scope.Contains("/project/app/checks/smoke.js"))
+	assert.False(t, scope.Contains("/project/app/src/util.js"))
+}
+
+func TestJestConfigParser_ParseProjects(t *testing.T) {
+	configContent := `module.exports = {
+  testMatch: ['**/*.test.js'],
+  projects: [
+    '<rootDir>/packages/*',
+    'tools/jest.config.js',
+    {
+      displayName: 'lint',
+      runner: 'jest-runner-eslint',
+      testMatch: ['<rootDir>/src/**/*.js'],
+    },
+    {
+      displayName: { name: 'e2e', color: 'blue' },
+      rootDir: '<rootDir>/e2e',
+      testRegex: '\\.e2e\\.js$',
+      testPathIgnorePatterns: ['<rootDir>/fixtures/'],
+    },
+  ],
+};`
+
+	scope, err := (&JestConfigParser{}).Parse(context.Background(), "/project/jest.config.js", []byte(configContent))
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"packages/*", "tools/jest.config.js"}, scope.ProjectPatterns)
+	require.Len(t, scope.Projects, 2)
+
+	lint := scope.Projects[0]
+	assert.Equal(t, "lint", lint.Name)
+	assert.Equal(t, "/project", lint.BaseDir)
+	assert.Equal(t, []string{"src/**/*.js"}, lint.Include)
+
+	e2e := scope.Projects[1]
+	assert.Equal(t, "e2e", e2e.Name)
+	assert.Equal(t, "/project/e2e", e2e.BaseDir)
+	assert.Len(t, e2e.IncludeRegexps, 1)
+	assert.Equal(t, []string{"fixtures/"}, e2e.Exclude)
+
+	assert.Equal(t, "e2e", scope.FindMatchingProject("/project/e2e/login.e2e.js").Name)
+	assert.Equal(t, "lint", scope.FindMatchingProject("/project/src/index.js").Name)
+	assert.True(t, scope.Contains("/project/src/index.js"), "project testMatch extends the root filters")
+}
+
+func TestJestConfigParser_ParseProjectsWithoutRootFilters(t *testing.T) {
+	configContent := `module.exports = {
+  projects: [
+    { displayName: 'lint', testMatch: ['<rootDir>/tools/**/*.check.js'] },
+    { displayName: 'probes', rootDir: '<rootDir>/probes', testRegex: '\\.probe\\.js$' },
+    { displayName: 'unit' },
+  ],
+};`
+
+	scope, err := (&JestConfigParser{}).Parse(context.Background(), "/project/jest.config.js", []byte(configContent))
+
+	require.NoError(t, err)
+	assert.True(t, scope.Collects("/project/tools/lint.check.js"), "project testMatch")
+	assert.True(t, scope.Collects("/project/probes/health.probe.js"), "project testRegex")
+	assert.True(t, scope.Collects("/project/src/args.test.js"), "a project without filters keeps the defaults")
+	assert.False(t, scope.Collects("/project/tools/helpers.js"))
+}
+
+func TestJestConfigParser_ParseDisplayName(t *testing.T) {
+	configContent := `{"displayName": "api", "testMatch": ["**/*.spec.ts"]}`
+
+	scope, err := (&JestConfigParser{}).Parse(context.Background(), "/project/packages/api/jest.config.json", []byte(configContent))
+
+	require.NoError(t, err)
+	require.Len(t, scope.Projects, 1)
+	assert.Equal(t, "api", scope.Projects[0].Name)
+	assert.Equal(t, "/project/packages/api", scope.Projects[0].BaseDir)
+	assert.Equal(t, []string{"**/*.spec.ts"}, scope.Projects[0].Include)
+}
+
 func TestJestParser_Parse(t *testing.T) {
 	testSource := `
 import { describe, test, expect } from '@jest/globals';
@@ -363,6 +509,14 @@ test('top-level test', () => {
 	assert.Equal(t, "top-level test", testFile.Tests[0].Name)
 }
 
+// evalConfigFragment evaluates config properties as the body of module.exports.
+func evalConfigFragment(t *testing.T, fragment string) jsconfig.Value {
+	t.Helper()
+	config, err := jsconfig.Evaluate(context.Background(), "/project/jest.config.js", []byte("module.exports = {\n"+fragment+"\n};"))
+	require.NoError(t, err)
+	return config
+}
+
 func TestParseRootDir(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -398,7 +552,7 @@ func TestParseRootDir(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := parseRootDir([]byte(tt.content))
+			result := parseRootDir(evalConfigFragment(t, tt.content))
 			assert.Equal(t, tt.expected, result)
 		})
 	}
@@ -434,7 +588,7 @@ func TestParseInjectGlobalsFalse(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := parseInjectGlobalsFalse([]byte(tt.content))
+			result := parseInjectGlobalsFalse(evalConfigFragment(t, tt.content))
 			assert.Equal(t, tt.expected, result)
 		})
 	}
@@ -524,7 +678,7 @@ func TestParseRoots(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := parseRoots([]byte(tt.content), tt.configDir, tt.rootDir)
+			result := parseRoots(evalConfigFragment(t, tt.content), tt.configDir, tt.rootDir)
 			assert.Equal(t, tt.expected, result)
 		})
 	}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 
 	sitter "github.com/smacker/go-tree-sitter"
@@ -15,6 +16,7 @@ import (
 	"github.com/specvital/core/pkg/parser/framework"
 	"github.com/specvital/core/pkg/parser/framework/matchers"
 	"github.com/specvital/core/pkg/parser/strategies/shared/javaast"
+	"github.com/specvital/core/pkg/parser/strategies/shared/jvmbuild"
 )
 
 func init() {
@@ -34,8 +36,9 @@ func NewDefinition() *framework.Definition {
 			&javaast.JavaTestFileMatcher{},
 			&KotlinTestFileMatcher{},
 			&JUnit5ContentMatcher{},
+			matchers.NewConfigMatcher(jvmbuild.PomFile, jvmbuild.GradleFile, jvmbuild.GradleKotlinDSL),
 		},
-		ConfigParser: nil,
+		ConfigParser: &jvmbuild.ConfigParser{},
 		Parser:       &JUnit5Parser{},
 		Priority:     framework.PriorityGeneric,
 	}
@@ -122,7 +125,7 @@ func parseTestClasses(root *sitter.Node, source []byte, filename string) []domai
 	parser.WalkTree(root, func(node *sitter.Node) bool {
 		switch node.Type() {
 		case javaast.NodeClassDeclaration:
-			if suite := parseTestClassWithDepth(node, source, filename, 0); suite != nil {
+			if suite := parseTestClassWithDepth(node, source, filename, 0, nil); suite != nil {
 				suites = append(suites, *suite)
 			}
 			return false // Don't recurse into nested classes here
@@ -130,7 +133,7 @@ func parseTestClasses(root *sitter.Node, source []byte, filename string) []domai
 		case javaast.NodeMethodDeclaration:
 			// Handle Java 21+ implicit classes: methods directly under program node
 			if node.Parent() != nil && node.Parent().Type() == "program" {
-				if test := parseTestMethod(node, source, filename, domain.TestStatusActive, ""); test != nil {
+				if test := parseTestMethod(node, source, filename, domain.TestStatusActive, "", nil); test != nil {
 					implicitClassTests = append(implicitClassTests, *test)
 				}
 			}
@@ -156,7 +159,7 @@ func getImplicitClassName(filename string) string {
 	return strings.TrimSuffix(filepath.Base(filename), ".java")
 }
 
-func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string, depth int) *domain.TestSuite {
+func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string, depth int, outerTags []string) *domain.TestSuite {
 	if depth > maxNestedDepth {
 		return nil
 	}
@@ -167,6 +170,7 @@ func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string,
 
 	modifiers := javaast.GetModifiers(node)
 	classStatus, classModifier := getClassStatusAndModifier(modifiers, source)
+	classTags := append(slices.Clone(outerTags), getTags(modifiers, source)...)
 
 	body := javaast.GetClassBody(node)
 	if body == nil {
@@ -181,7 +185,7 @@ func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string,
 
 		switch child.Type() {
 		case javaast.NodeMethodDeclaration:
-			if test := parseTestMethod(child, source, filename, classStatus, classModifier); test != nil {
+			if test := parseTestMethod(child, source, filename, classStatus, classModifier, classTags); test != nil {
 				tests = append(tests, *test)
 			}
 
@@ -189,7 +193,7 @@ func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string,
 			// Handle @Nested classes
 			nestedModifiers := javaast.GetModifiers(child)
 			if javaast.HasAnnotation(nestedModifiers, source, "Nested") {
-				if nested := parseTestClassWithDepth(child, source, filename, depth+1); nested != nil {
+				if nested := parseTestClassWithDepth(child, source, filename, depth+1, classTags); nested != nil {
 					nestedSuites = append(nestedSuites, *nested)
 				}
 			}
@@ -210,7 +214,7 @@ func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string,
 	}
 }
 
-func parseTestMethod(node *sitter.Node, source []byte, filename string, classStatus domain.TestStatus, classModifier string) *domain.Test {
+func parseTestMethod(node *sitter.Node, source []byte, filename string, classStatus domain.TestStatus, classModifier string, classTags []string) *domain.Test {
 	modifiers := javaast.GetModifiers(node)
 	if modifiers == nil {
 		return nil
@@ -260,10 +264,28 @@ func parseTestMethod(node *sitter.Node, source []byte, filename string, classSta
 		Name:     testName,
 		Status:   status,
 		Modifier: modifier,
+		Tags:     append(slices.Clone(classTags), getTags(modifiers, source)...),
 		Location: parser.GetLocation(node, filename),
 	}
 }
 
+// getTags returns the values of the @Tag annotations, including those grouped in @Tags.
+func getTags(modifiers *sitter.Node, source []byte) []string {
+	var tags []string
+	for _, ann := range javaast.GetAnnotations(modifiers) {
+		parser.WalkTree(ann, func(node *sitter.Node) bool {
+			if node.Type() == javaast.NodeAnnotation && javaast.GetAnnotationName(node, source) == "Tag" {
+				if tag := javaast.GetAnnotationArgument(node, source); tag != "" {
+					tags = append(tags, tag)
+				}
+				return false
+			}
+			return true
+		})
+	}
+	return tags
+}
+
 func getClassStatusAndModifier(modifiers *sitter.Node, source []byte) (domain.TestStatus, string) {
 	if modifiers == nil {
 		return domain.TestStatusActive, ""
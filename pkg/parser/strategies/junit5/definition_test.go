@@ -2,6 +2,7 @@ package junit5
 
 import (
 	"context"
+	"reflect"
 	"testing"
 
 	"github.com/specvital/core/pkg/domain"
@@ -27,8 +28,11 @@ func TestNewDefinition(t *testing.T) {
 	if def.Parser == nil {
 		t.Error("expected Parser to be non-nil")
 	}
-	if len(def.Matchers) != 4 {
-		t.Errorf("expected 4 Matchers, got %d", len(def.Matchers))
+	if len(def.Matchers) != 5 {
+		t.Errorf("expected 5 Matchers, got %d", len(def.Matchers))
+	}
+	if def.ConfigParser == nil {
+		t.Error("expected ConfigParser to be non-nil")
 	}
 }
 
@@ -914,6 +918,95 @@ class KotestSpec : StringSpec({
 	})
 }
 
+func TestJUnit5Parser_ParseTags(t *testing.T) {
+	p := &JUnit5Parser{}
+	ctx := context.Background()
+
+	t.Run("Java @Tag and @Tags", func(t *testing.T) {
+		source := `
+package com.example;
+
+import org.junit.jupiter.api.*;
+
+@Tag("integration")
+class OrderTest {
+    @Test
+    @Tags({@Tag("slow"), @Tag("db")})
+    void persistsOrder() {}
+
+    @Test
+    void validatesOrder() {}
+
+    @Nested
+    @Tag("api")
+    class Rest {
+        @Test
+        void postsOrder() {}
+    }
+}
+
+class HelperTest {
+    @Test
+    void untagged() {}
+}
+`
+		testFile, err := p.Parse(ctx, []byte(source), "OrderTest.java")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(testFile.Suites) != 2 {
+			t.Fatalf("expected 2 Suites, got %d", len(testFile.Suites))
+		}
+
+		suite := testFile.Suites[0]
+		if want := []string{"integration", "slow", "db"}; !reflect.DeepEqual(suite.Tests[0].Tags, want) {
+			t.Errorf("expected Tags=%v, got %v", want, suite.Tests[0].Tags)
+		}
+		if want := []string{"integration"}; !reflect.DeepEqual(suite.Tests[1].Tags, want) {
+			t.Errorf("expected Tags=%v, got %v", want, suite.Tests[1].Tags)
+		}
+		if want := []string{"integration", "api"}; !reflect.DeepEqual(suite.Suites[0].Tests[0].Tags, want) {
+			t.Errorf("expected nested Tags=%v, got %v", want, suite.Suites[0].Tests[0].Tags)
+		}
+		if tags := testFile.Suites[1].Tests[0].Tags; tags != nil {
+			t.Errorf("expected no Tags, got %v", tags)
+		}
+	})
+
+	t.Run("Kotlin @Tag", func(t *testing.T) {
+		source := `
+package com.example
+
+import org.junit.jupiter.api.Tag
+import org.junit.jupiter.api.Test
+
+class OrderTest {
+    @Test
+    @Tag("slow")
+    fun persistsOrder() {}
+
+    @Test
+    fun validatesOrder() {}
+}
+`
+		testFile, err := p.Parse(ctx, []byte(source), "OrderTest.kt")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(testFile.Suites) != 1 || len(testFile.Suites[0].Tests) != 2 {
+			t.Fatalf("expected 1 Suite with 2 Tests, got %+v", testFile.Suites)
+		}
+
+		tests := testFile.Suites[0].Tests
+		if want := []string{"slow"}; !reflect.DeepEqual(tests[0].Tags, want) {
+			t.Errorf("expected Tags=%v, got %v", want, tests[0].Tags)
+		}
+		if tests[1].Tags != nil {
+			t.Errorf("expected no Tags, got %v", tests[1].Tags)
+		}
+	})
+}
+
 func TestJUnit5Parser_ImplicitClass(t *testing.T) {
 	p := &JUnit5Parser{}
 	ctx := context.Background()
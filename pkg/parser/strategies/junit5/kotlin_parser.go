@@ -2,6 +2,7 @@ package junit5
 
 import (
 	"context"
+	"slices"
 	"strings"
 
 	sitter "github.com/smacker/go-tree-sitter"
@@ -101,6 +102,7 @@ func parseKotlinTestClass(node *sitter.Node, source []byte, filename string) *do
 
 	modifiers := kotlinast.GetModifiers(node)
 	classStatus, classModifier := getKotlinClassStatus(modifiers, source)
+	classTags := getKotlinTags(modifiers, source)
 
 	body := kotlinast.GetClassBody(node)
 	if body == nil {
@@ -112,7 +114,7 @@ func parseKotlinTestClass(node *sitter.Node, source []byte, filename string) *do
 	for i := 0; i < int(body.ChildCount()); i++ {
 		child := body.Child(i)
 		if child.Type() == kotlinast.NodeFunctionDeclaration {
-			if test := parseKotlinTestMethod(child, source, filename, classStatus, classModifier); test != nil {
+			if test := parseKotlinTestMethod(child, source, filename, classStatus, classModifier, classTags); test != nil {
 				tests = append(tests, *test)
 			}
 		}
@@ -131,7 +133,7 @@ func parseKotlinTestClass(node *sitter.Node, source []byte, filename string) *do
 	}
 }
 
-func parseKotlinTestMethod(node *sitter.Node, source []byte, filename string, classStatus domain.TestStatus, classModifier string) *domain.Test {
+func parseKotlinTestMethod(node *sitter.Node, source []byte, filename string, classStatus domain.TestStatus, classModifier string, classTags []string) *domain.Test {
 	modifiers := kotlinast.GetModifiers(node)
 
 	// Check for test annotations
@@ -172,6 +174,7 @@ func parseKotlinTestMethod(node *sitter.Node, source []byte, filename string, cl
 		Name:     testName,
 		Status:   status,
 		Modifier: modifier,
+		Tags:     append(slices.Clone(classTags), getKotlinTags(modifiers, source)...),
 		Location: parser.GetLocation(node, filename),
 	}
 }
@@ -214,6 +217,30 @@ func getDisplayNameFromAnnotations(modifiers *sitter.Node, source []byte) string
 	return ""
 }
 
+// getKotlinTags returns the values of the @Tag annotations.
+func getKotlinTags(modifiers *sitter.Node, source []byte) []string {
+	if modifiers == nil {
+		return nil
+	}
+	var tags []string
+	for i := 0; i < int(modifiers.ChildCount()); i++ {
+		child := modifiers.Child(i)
+		if child.Type() != kotlinast.NodeAnnotation || kotlinast.GetAnnotationName(child, source) != "Tag" {
+			continue
+		}
+		parser.WalkTree(child, func(node *sitter.Node) bool {
+			if node.Type() == kotlinast.NodeStringLiteral {
+				if tag := kotlinast.ExtractStringContent(node, source); tag != "" {
+					tags = append(tags, tag)
+				}
+				return false
+			}
+			return true
+		})
+	}
+	return tags
+}
+
 func getKotlinClassStatus(modifiers *sitter.Node, source []byte) (domain.TestStatus, string) {
 	if modifiers == nil {
 		return domain.TestStatusActive, ""
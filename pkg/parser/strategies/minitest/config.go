@@ -0,0 +1,110 @@
+package minitest
+
+import (
+	"context"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+const (
+	testTaskMarker = "Rake::TestTask.new"
+
+	// defaultTestTaskPattern is the pattern of a Rake::TestTask without pattern or test_files.
+	defaultTestTaskPattern = "test/test*.rb"
+)
+
+var (
+	patternAssignment   = regexp.MustCompile(`\.pattern\s*=\s*["']([^"']+)["']`)
+	testFilesAssignment = regexp.MustCompile(`\.test_files\s*=\s*(FileList\s*(?:\[[^\]]*\]|\.new\s*\([^)]*\))|\[[^\]]*\]|["'][^"']*["'])`)
+	quotedString        = regexp.MustCompile(`["']([^"']+)["']`)
+)
+
+// MinitestConfigParser reads the Rake::TestTask definitions of a Rakefile.
+//
+// The pattern and test_files of every task are the collected files. The static
+// directories they start with (test/ for "test/**/*_test.rb") become the roots, and
+// their Ruby files are decided by the tasks alone, so helpers such as
+// test/test_helper.rb are no longer taken for tests. Rakefiles without a
+// Rake::TestTask are declined.
+type MinitestConfigParser struct{}
+
+func (p *MinitestConfigParser) Parse(ctx context.Context, configPath string, content []byte) (*framework.ConfigScope, error) {
+	text := string(content)
+	if !strings.Contains(text, testTaskMarker) {
+		return nil, nil
+	}
+
+	scope := framework.NewConfigScope(configPath, "")
+	scope.Framework = frameworkName
+
+	var patterns []string
+	tasks := strings.Split(text, testTaskMarker)
+	for _, task := range tasks[1:] {
+		patterns = append(patterns, testTaskPatterns(task)...)
+	}
+
+	seen := make(map[string]bool)
+	rooted := true
+	var dirs []string
+	for _, pattern := range patterns {
+		scope.CollectPatterns = append(scope.CollectPatterns, pattern)
+
+		dir := staticDir(pattern)
+		if dir == "" {
+			rooted = false
+			continue
+		}
+		if !seen[dir] {
+			seen[dir] = true
+			dirs = append(dirs, dir)
+			scope.ExclusivePatterns = append(scope.ExclusivePatterns, path.Join(dir, "**", "*.rb"))
+		}
+	}
+
+	// A pattern searching the whole project (e.g. "**/*_test.rb") keeps the scope at BaseDir.
+	if rooted {
+		for _, dir := range dirs {
+			scope.Roots = append(scope.Roots, filepath.Join(scope.BaseDir, filepath.FromSlash(dir)))
+		}
+	}
+
+	return scope, nil
+}
+
+// testTaskPatterns returns the pattern and test_files globs of one task's definition.
+func testTaskPatterns(task string) []string {
+	var patterns []string
+	for _, m := range patternAssignment.FindAllStringSubmatch(task, -1) {
+		patterns = append(patterns, cleanPattern(m[1]))
+	}
+	for _, m := range testFilesAssignment.FindAllStringSubmatch(task, -1) {
+		for _, s := range quotedString.FindAllStringSubmatch(m[1], -1) {
+			patterns = append(patterns, cleanPattern(s[1]))
+		}
+	}
+	if len(patterns) == 0 {
+		patterns = []string{defaultTestTaskPattern}
+	}
+	return patterns
+}
+
+func cleanPattern(pattern string) string {
+	return strings.TrimPrefix(strings.TrimSpace(pattern), "./")
+}
+
+// staticDir returns the leading directories of pattern that contain no glob characters.
+func staticDir(pattern string) string {
+	parts := strings.Split(pattern, "/")
+	var static []string
+	for _, part := range parts[:len(parts)-1] {
+		if strings.ContainsAny(part, "*?[{") {
+			break
+		}
+		static = append(static, part)
+	}
+	return strings.Join(static, "/")
+}
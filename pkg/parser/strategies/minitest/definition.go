@@ -45,10 +45,11 @@ func NewDefinition() *framework.Definition {
 				"minitest",
 				"minitest/",
 			),
+			matchers.NewConfigMatcher("Rakefile"),
 			&MinitestFileMatcher{},
 			&MinitestContentMatcher{},
 		},
-		ConfigParser: nil,
+		ConfigParser: &MinitestConfigParser{},
 		Parser:       &MinitestParser{},
 		Priority:     framework.PriorityGeneric,
 	}
@@ -2,6 +2,8 @@ package minitest
 
 import (
 	"context"
+	"path/filepath"
+	"reflect"
 	"testing"
 
 	"github.com/specvital/core/pkg/domain"
@@ -317,6 +319,90 @@ end
 	}
 }
 
+func TestMinitestConfigParser_Parse(t *testing.T) {
+	parser := &MinitestConfigParser{}
+	ctx := context.Background()
+	configPath := filepath.FromSlash("/project/Rakefile")
+
+	t.Run("test tasks", func(t *testing.T) {
+		content := `require "rake/testtask"
+
+Rake::TestTask.new(:test) do |t|
+  t.libs << "test"
+  t.pattern = "test/**/*_test.rb"
+end
+
+Rake::TestTask.new(:integration) do |t|
+  t.test_files = FileList[
+    "integration/**/*_check.rb",
+    "integration/smoke.rb"
+  ]
+end
+
+Rake::TestTask.new(:legacy)
+
+task default: :test
+`
+		scope, err := parser.Parse(ctx, configPath, []byte(content))
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		if scope.Framework != frameworkName {
+			t.Errorf("expected framework %q, got %q", frameworkName, scope.Framework)
+		}
+		if want := []string{"test/**/*_test.rb", "integration/**/*_check.rb", "integration/smoke.rb", "test/test*.rb"}; !reflect.DeepEqual(scope.CollectPatterns, want) {
+			t.Errorf("CollectPatterns = %v, want %v", scope.CollectPatterns, want)
+		}
+		if want := []string{"test/**/*.rb", "integration/**/*.rb"}; !reflect.DeepEqual(scope.ExclusivePatterns, want) {
+			t.Errorf("ExclusivePatterns = %v, want %v", scope.ExclusivePatterns, want)
+		}
+		wantRoots := []string{filepath.FromSlash("/project/test"), filepath.FromSlash("/project/integration")}
+		if !reflect.DeepEqual(scope.Roots, wantRoots) {
+			t.Errorf("Roots = %v, want %v", scope.Roots, wantRoots)
+		}
+
+		tests := []struct {
+			path string
+			want bool
+		}{
+			{"/project/test/models/user_test.rb", true},
+			{"/project/test/test_helper.rb", true},
+			{"/project/test/support/factories.rb", false},
+			{"/project/integration/api/login_check.rb", true},
+			{"/project/integration/helpers.rb", false},
+		}
+		for _, tt := range tests {
+			if got := scope.Collects(filepath.FromSlash(tt.path)); got != tt.want {
+				t.Errorf("Collects(%s) = %v, want %v", tt.path, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("project-wide pattern keeps the scope at the Rakefile", func(t *testing.T) {
+		content := "Rake::TestTask.new { |t| t.test_files = ['lib/**/*_test.rb', './**/*_spec.rb'] }\n"
+		scope, err := parser.Parse(ctx, configPath, []byte(content))
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		if len(scope.Roots) != 0 {
+			t.Errorf("expected no roots, got %v", scope.Roots)
+		}
+		if want := []string{"lib/**/*.rb"}; !reflect.DeepEqual(scope.ExclusivePatterns, want) {
+			t.Errorf("ExclusivePatterns = %v, want %v", scope.ExclusivePatterns, want)
+		}
+	})
+
+	t.Run("Rakefile without test tasks", func(t *testing.T) {
+		scope, err := parser.Parse(ctx, configPath, []byte("require 'rspec/core/rake_task'\nRSpec::Core::RakeTask.new(:spec)\n"))
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		if scope != nil {
+			t.Errorf("expected the Rakefile to be declined, got %+v", scope)
+		}
+	})
+}
+
 func TestMinitestFileMatcher_Match(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 
 	sitter "github.com/smacker/go-tree-sitter"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/specvital/core/pkg/parser/framework"
 	"github.com/specvital/core/pkg/parser/framework/matchers"
 	"github.com/specvital/core/pkg/parser/strategies/shared/dotnetast"
+	"github.com/specvital/core/pkg/parser/strategies/shared/dotnetproj"
 )
 
 const frameworkName = "mstest"
@@ -32,8 +34,9 @@ func NewDefinition() *framework.Definition {
 			),
 			&MSTestFileMatcher{},
 			&MSTestContentMatcher{},
+			matchers.NewConfigMatcher(dotnetproj.ConfigPatterns...),
 		},
-		ConfigParser: nil,
+		ConfigParser: &dotnetproj.ConfigParser{},
 		Parser:       &MSTestParser{},
 		Priority:     framework.PriorityGeneric,
 	}
@@ -181,6 +184,7 @@ func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string,
 
 	attrLists := dotnetast.GetAttributeLists(node)
 	classStatus, classModifier := getClassStatusAndModifier(attrLists, source)
+	classTraits := dotnetast.GetTraits(attrLists, source)
 
 	body := dotnetast.GetDeclarationList(node)
 	if body == nil {
@@ -194,6 +198,7 @@ func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string,
 		switch child.Type() {
 		case dotnetast.NodeMethodDeclaration:
 			if test := parseTestMethod(child, source, filename, classStatus, classModifier); test != nil {
+				test.Tags = append(slices.Clone(classTraits), dotnetast.GetTraits(dotnetast.GetAttributeLists(child), source)...)
 				tests = append(tests, *test)
 			}
 
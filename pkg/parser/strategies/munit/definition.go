@@ -0,0 +1,229 @@
+// Package munit implements MUnit framework support for Scala test files.
+package munit
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+	"github.com/specvital/core/pkg/parser/framework"
+	"github.com/specvital/core/pkg/parser/framework/matchers"
+	"github.com/specvital/core/pkg/parser/strategies/shared/scalaast"
+)
+
+const frameworkName = framework.FrameworkMUnit
+
+// suiteTypes are the MUnit base suites, including the ScalaCheck and cats-effect integrations.
+var suiteTypes = map[string]bool{
+	"FunSuite":        true,
+	"munit.FunSuite":  true,
+	"ScalaCheckSuite": true,
+	"CatsEffectSuite": true,
+	"ZSuite":          true,
+}
+
+// testFuncs register a test; property comes from munit-scalacheck.
+var testFuncs = map[string]bool{
+	"test":     true,
+	"property": true,
+}
+
+// TestOptions modifiers applied to the test name: test("name".ignore) { }
+const (
+	optionIgnore  = "ignore"
+	optionOnly    = "only"
+	optionFail    = "fail"
+	optionFlaky   = "flaky"
+	optionPending = "pending"
+	optionTag     = "tag"
+
+	tagIgnore = "Ignore"
+)
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageScala},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher("munit", "munit."),
+			&MUnitContentMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &MUnitParser{},
+		Priority:     framework.PriorityGeneric,
+	}
+}
+
+// MUnitContentMatcher matches MUnit-specific patterns in file content.
+type MUnitContentMatcher struct{}
+
+var munitPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`import\s+munit\b`), "MUnit import"},
+	{regexp.MustCompile(`extends\s+munit\.\w+`), "extends munit suite"},
+	{regexp.MustCompile(`extends\s+(?:ScalaCheckSuite|CatsEffectSuite)\b`), "extends MUnit integration suite"},
+	{regexp.MustCompile(`"\s*\.\s*(?:ignore|only|flaky)\s*\)`), "test options on name"},
+}
+
+func (m *MUnitContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range munitPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found MUnit pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+// MUnitParser extracts test definitions from Scala MUnit files.
+// Each suite class or object becomes a suite of its test("...") and property("...")
+// registrations, including fixture.test("...") calls.
+type MUnitParser struct{}
+
+func (p *MUnitParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageScala, source)
+	if err != nil {
+		return nil, fmt.Errorf("munit parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	file := &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageScala,
+		Framework: frameworkName,
+	}
+
+	for _, spec := range scalaast.Specs(tree.RootNode(), source) {
+		if !spec.Extends(suiteTypes) || spec.Body == nil {
+			continue
+		}
+
+		suite := domain.TestSuite{
+			Name:     spec.Name,
+			Status:   domain.TestStatusActive,
+			Location: parser.GetLocation(spec.Node, filename),
+		}
+		if scalaast.HasAnnotation(spec.Node, source, "IgnoreSuite") {
+			suite.Status = domain.TestStatusSkipped
+			suite.Modifier = "@IgnoreSuite"
+		}
+
+		collectTests(spec.Body, source, filename, &suite)
+
+		if len(suite.Tests) > 0 {
+			file.Suites = append(file.Suites, suite)
+		}
+	}
+
+	return file, nil
+}
+
+func collectTests(body *sitter.Node, source []byte, filename string, suite *domain.TestSuite) {
+	parser.WalkTree(body, func(node *sitter.Node) bool {
+		if node.Type() == scalaast.NodeClassDefinition || node.Type() == scalaast.NodeObjectDefinition {
+			return false
+		}
+		if node.Type() != scalaast.NodeCallExpression {
+			return true
+		}
+
+		call, ok := scalaast.CurriedCall(node, source)
+		if !ok || !testFuncs[call.Name] || len(call.Args) == 0 {
+			return true
+		}
+		test, ok := buildTest(call.Args[0], source)
+		if !ok {
+			return true
+		}
+		test.Location = parser.GetLocation(node, filename)
+		suite.Tests = append(suite.Tests, test)
+		return false
+	})
+}
+
+// buildTest reads the test name and its TestOptions chain, e.g. "name".tag(Slow).ignore.
+func buildTest(nameArg *sitter.Node, source []byte) (domain.Test, bool) {
+	test := domain.Test{Status: domain.TestStatusActive}
+
+	node := nameArg
+	for {
+		if name, ok := scalaast.StringValue(node, source); ok {
+			test.Name = name
+			return test, true
+		}
+
+		switch node.Type() {
+		case scalaast.NodeFieldExpression:
+			field := node.ChildByFieldName("field")
+			if field == nil {
+				return test, false
+			}
+			applyOption(&test, parser.GetNodeText(field, source))
+			node = node.ChildByFieldName("value")
+
+		case scalaast.NodeCallExpression:
+			fn := node.ChildByFieldName("function")
+			if fn == nil || fn.Type() != scalaast.NodeFieldExpression {
+				return test, false
+			}
+			if field := fn.ChildByFieldName("field"); field != nil && parser.GetNodeText(field, source) == optionTag {
+				for _, arg := range scalaast.NamedChildren(node.ChildByFieldName("arguments")) {
+					tag := parser.GetNodeText(arg, source)
+					test.Tags = append([]string{tag}, test.Tags...)
+					if tag == tagIgnore && test.Status == domain.TestStatusActive {
+						test.Status = domain.TestStatusSkipped
+						test.Modifier = optionTag + "(" + tagIgnore + ")"
+					}
+				}
+			}
+			node = fn.ChildByFieldName("value")
+
+		default:
+			return test, false
+		}
+
+		if node == nil {
+			return test, false
+		}
+	}
+}
+
+// applyOption maps a TestOptions modifier to a status. The outermost modifier wins.
+func applyOption(test *domain.Test, option string) {
+	if test.Status != domain.TestStatusActive {
+		return
+	}
+	switch option {
+	case optionIgnore:
+		test.Status = domain.TestStatusSkipped
+	case optionOnly:
+		test.Status = domain.TestStatusFocused
+	case optionFail:
+		test.Status = domain.TestStatusXfail
+	case optionPending:
+		test.Status = domain.TestStatusTodo
+	case optionFlaky:
+	default:
+		return
+	}
+	test.Modifier = "." + option
+}
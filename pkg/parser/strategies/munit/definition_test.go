@@ -0,0 +1,176 @@
+package munit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+func TestMUnitParser_Parse(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		checkFunc func(t *testing.T, file *domain.TestFile)
+	}{
+		{
+			name: "FunSuite with test options",
+			source: `
+package example
+
+class MySuite extends munit.FunSuite {
+  test("hello") {
+    assertEquals(1 + 1, 2)
+  }
+  test("ignored".ignore) {}
+  test("focused".only) {}
+  test("known bug".fail) {}
+  test("flaky network".flaky) {}
+  test("tagged".tag(Slow).ignore) {}
+  test("ignored by tag".tag(Ignore)) {}
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				suite := file.Suites[0]
+				if suite.Name != "MySuite" {
+					t.Errorf("expected suite 'MySuite', got %q", suite.Name)
+				}
+				want := []struct {
+					name     string
+					status   domain.TestStatus
+					modifier string
+				}{
+					{"hello", domain.TestStatusActive, ""},
+					{"ignored", domain.TestStatusSkipped, ".ignore"},
+					{"focused", domain.TestStatusFocused, ".only"},
+					{"known bug", domain.TestStatusXfail, ".fail"},
+					{"flaky network", domain.TestStatusActive, ".flaky"},
+					{"tagged", domain.TestStatusSkipped, ".ignore"},
+					{"ignored by tag", domain.TestStatusSkipped, "tag(Ignore)"},
+				}
+				if len(suite.Tests) != len(want) {
+					t.Fatalf("expected %d tests, got %d", len(want), len(suite.Tests))
+				}
+				for i, w := range want {
+					got := suite.Tests[i]
+					if got.Name != w.name || got.Status != w.status || got.Modifier != w.modifier {
+						t.Errorf("test %d: expected %q/%q/%q, got %q/%q/%q", i, w.name, w.status, w.modifier, got.Name, got.Status, got.Modifier)
+					}
+				}
+				if tags := suite.Tests[5].Tags; len(tags) != 1 || tags[0] != "Slow" {
+					t.Errorf("expected tags [Slow], got %v", tags)
+				}
+			},
+		},
+		{
+			name: "ScalaCheckSuite properties and fixtures",
+			source: `
+import munit.ScalaCheckSuite
+import org.scalacheck.Prop._
+
+object IntegerSuite extends ScalaCheckSuite {
+  property("addition is commutative") {
+    forAll { (n1: Int, n2: Int) => n1 + n2 == n2 + n1 }
+  }
+
+  val files = FunFixture[Path](setup = _ => Files.createTempFile("tmp", null), teardown = Files.delete)
+
+  files.test("exists") { file =>
+    assert(Files.exists(file))
+  }
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				tests := file.Suites[0].Tests
+				if len(tests) != 2 {
+					t.Fatalf("expected 2 tests, got %d", len(tests))
+				}
+				if tests[0].Name != "addition is commutative" || tests[1].Name != "exists" {
+					t.Errorf("unexpected tests %q, %q", tests[0].Name, tests[1].Name)
+				}
+			},
+		},
+		{
+			name: "ignored suite and Scala 3 syntax",
+			source: `
+@munit.IgnoreSuite
+class SlowSuite extends FunSuite:
+  test("braceless"):
+    assert(true)
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				if file.Suites[0].Status != domain.TestStatusSkipped {
+					t.Errorf("expected skipped suite, got %q", file.Suites[0].Status)
+				}
+				if len(file.Suites[0].Tests) != 1 {
+					t.Errorf("expected 1 test, got %d", len(file.Suites[0].Tests))
+				}
+			},
+		},
+	}
+
+	parser := &MUnitParser{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := parser.Parse(ctx, []byte(tt.source), "MySuite.scala")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if file.Framework != frameworkName {
+				t.Errorf("expected framework %q, got %q", frameworkName, file.Framework)
+			}
+
+			if file.Language != domain.LanguageScala {
+				t.Errorf("expected language Scala, got %q", file.Language)
+			}
+
+			if tt.checkFunc != nil {
+				tt.checkFunc(t, file)
+			}
+		})
+	}
+}
+
+func TestMUnitContentMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantMatch bool
+	}{
+		{"munit import", "import munit._", true},
+		{"extends munit.FunSuite", "class A extends munit.FunSuite", true},
+		{"ScalaCheckSuite", "class A extends ScalaCheckSuite", true},
+		{"ignore option", `test("a".ignore) {}`, true},
+		{"scalatest", "class A extends AnyFunSuite", false},
+	}
+
+	matcher := &MUnitContentMatcher{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := framework.Signal{
+				Type:    framework.SignalFileContent,
+				Value:   tt.content,
+				Context: []byte(tt.content),
+			}
+			result := matcher.Match(ctx, signal)
+			if (result.Confidence > 0) != tt.wantMatch {
+				t.Errorf("Match() confidence = %v, want match = %v", result.Confidence, tt.wantMatch)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 
 	sitter "github.com/smacker/go-tree-sitter"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/specvital/core/pkg/parser/framework"
 	"github.com/specvital/core/pkg/parser/framework/matchers"
 	"github.com/specvital/core/pkg/parser/strategies/shared/dotnetast"
+	"github.com/specvital/core/pkg/parser/strategies/shared/dotnetproj"
 )
 
 const frameworkName = "nunit"
@@ -32,8 +34,9 @@ func NewDefinition() *framework.Definition {
 			),
 			&NUnitFileMatcher{},
 			&NUnitContentMatcher{},
+			matchers.NewConfigMatcher(dotnetproj.ConfigPatterns...),
 		},
-		ConfigParser: nil,
+		ConfigParser: &dotnetproj.ConfigParser{},
 		Parser:       &NUnitParser{},
 		Priority:     framework.PriorityGeneric,
 	}
@@ -173,6 +176,7 @@ func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string,
 
 	attrLists := dotnetast.GetAttributeLists(node)
 	classStatus, classModifier := getClassStatusAndModifier(attrLists, source)
+	classTraits := dotnetast.GetTraits(attrLists, source)
 
 	body := dotnetast.GetDeclarationList(node)
 	if body == nil {
@@ -185,7 +189,11 @@ func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string,
 	for _, child := range dotnetast.GetDeclarationChildren(body) {
 		switch child.Type() {
 		case dotnetast.NodeMethodDeclaration:
-			tests = append(tests, parseTestMethod(child, source, filename, classStatus, classModifier)...)
+			methodTests := parseTestMethod(child, source, filename, classStatus, classModifier)
+			for i := range methodTests {
+				methodTests[i].Tags = append(slices.Clone(classTraits), dotnetast.GetTraits(dotnetast.GetAttributeLists(child), source)...)
+			}
+			tests = append(tests, methodTests...)
 
 		case dotnetast.NodeClassDeclaration:
 			if nested := parseTestClassWithDepth(child, source, filename, depth+1); nested != nil {
@@ -23,8 +23,11 @@ func TestNewDefinition(t *testing.T) {
 	if def.Parser == nil {
 		t.Error("expected Parser to be non-nil")
 	}
-	if len(def.Matchers) != 3 {
-		t.Errorf("expected 3 Matchers, got %d", len(def.Matchers))
+	if def.ConfigParser == nil {
+		t.Error("expected ConfigParser to be non-nil")
+	}
+	if len(def.Matchers) != 4 {
+		t.Errorf("expected 4 Matchers, got %d", len(def.Matchers))
 	}
 }
 
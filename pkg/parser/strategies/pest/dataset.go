@@ -0,0 +1,119 @@
+package pest
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+)
+
+// dataRow is one data set of a test, labelled the way Pest names it.
+type dataRow struct {
+	label string
+	node  *sitter.Node
+}
+
+// dataRows returns the data sets of a test's ->with() calls. Every dataset must be
+// statically known: a literal array or a dataset() defined in the same file. Several
+// datasets, whether in one ->with() or chained, combine into their cartesian product.
+func (w *walker) dataRows(chain []call) ([]dataRow, bool) {
+	var rows []dataRow
+	found := false
+	for _, c := range chain {
+		if c.name != modifierWith {
+			continue
+		}
+		for _, arg := range c.args {
+			dataset, ok := w.dataset(arg)
+			if !ok {
+				return nil, false
+			}
+			if !found {
+				rows, found = dataset, true
+				continue
+			}
+			rows = combine(rows, dataset)
+		}
+	}
+	return rows, found && len(rows) > 0
+}
+
+func (w *walker) dataset(arg *sitter.Node) ([]dataRow, bool) {
+	if name, ok := w.stringLiteral(arg); ok {
+		if array, defined := w.datasets[name]; defined {
+			arg = array
+		}
+	}
+	if arg.Type() != nodeArrayCreation {
+		return nil, false
+	}
+
+	var rows []dataRow
+	for i := 0; i < int(arg.NamedChildCount()); i++ {
+		element := arg.NamedChild(i)
+		if element.Type() != nodeArrayElement {
+			continue
+		}
+		switch element.NamedChildCount() {
+		case 1:
+			rows = append(rows, dataRow{label: w.valueLabel(element.NamedChild(0)), node: element})
+		case 2:
+			key := element.NamedChild(0)
+			name, ok := w.stringLiteral(key)
+			if !ok {
+				name = parser.GetNodeText(key, w.source)
+			}
+			rows = append(rows, dataRow{label: `data set "` + name + `"`, node: element})
+		default:
+			// Spread elements hide the data set count.
+			return nil, false
+		}
+	}
+	return rows, true
+}
+
+// valueLabel renders an unnamed data set as Pest does: its values in parentheses.
+func (w *walker) valueLabel(value *sitter.Node) string {
+	if value.Type() != nodeArrayCreation {
+		return "(" + parser.GetNodeText(value, w.source) + ")"
+	}
+	var values []string
+	for i := 0; i < int(value.NamedChildCount()); i++ {
+		if element := value.NamedChild(i); element.Type() == nodeArrayElement {
+			values = append(values, parser.GetNodeText(element, w.source))
+		}
+	}
+	return "(" + strings.Join(values, ", ") + ")"
+}
+
+func combine(left, right []dataRow) []dataRow {
+	rows := make([]dataRow, 0, len(left)*len(right))
+	for _, l := range left {
+		for _, r := range right {
+			rows = append(rows, dataRow{label: l.label + " / " + r.label, node: l.node})
+		}
+	}
+	return rows
+}
+
+// unroll returns a suite named after the test with one test per data set.
+func unroll(test domain.Test, rows []dataRow, filename string) domain.TestSuite {
+	suite := domain.TestSuite{
+		Name:     test.Name,
+		Status:   test.Status,
+		Modifier: test.Modifier,
+		Location: test.Location,
+	}
+	for _, row := range rows {
+		suite.Tests = append(suite.Tests, domain.Test{
+			Name:     test.Name + " with " + row.label,
+			Status:   test.Status,
+			Modifier: test.Modifier,
+			Location: parser.GetLocation(row.node, filename),
+			Tags:     test.Tags,
+		})
+	}
+	return suite
+}
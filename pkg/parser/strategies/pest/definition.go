@@ -0,0 +1,100 @@
+// Package pest implements Pest test framework support for PHP test files.
+package pest
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"regexp"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/framework"
+	"github.com/specvital/core/pkg/parser/framework/matchers"
+)
+
+const frameworkName = framework.FrameworkPest
+
+const (
+	configPest     = "Pest.php"
+	configComposer = "composer.json"
+)
+
+// pestDependency is the Composer package every Pest project requires.
+var pestDependency = []byte("pestphp/pest")
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+// NewDefinition returns the Pest definition. It has a higher priority than PHPUnit so
+// Pest projects, whose files often use no imports at all, are not claimed by PHPUnit.
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguagePHP},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher(
+				"Pest\\",
+				"function Pest\\",
+			),
+			matchers.NewConfigMatcher(configPest, configComposer),
+			&PestContentMatcher{},
+		},
+		ConfigParser: &PestConfigParser{},
+		Parser:       &PestParser{},
+		Priority:     framework.PrioritySpecialized,
+	}
+}
+
+// PestContentMatcher matches Pest's functional test API.
+type PestContentMatcher struct{}
+
+var pestPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`(?m)^\s*(?:it|test|describe)\(\s*['"]`), "it/test/describe call"},
+	{regexp.MustCompile(`expect\(.*\)->(?:not->)?to[A-Z]\w*\(`), "expect()->toX expectation"},
+	{regexp.MustCompile(`(?m)^\s*(?:uses|pest\(\)->extend)\(`), "uses() binding"},
+	{regexp.MustCompile(`(?m)^\s*dataset\(\s*['"]`), "dataset() definition"},
+}
+
+func (m *PestContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range pestPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found Pest pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+// PestConfigParser scopes Pest to projects with a Pest.php bootstrap file or a
+// composer.json requiring pestphp/pest. Other composer.json files are declined with a nil scope.
+type PestConfigParser struct{}
+
+func (p *PestConfigParser) Parse(ctx context.Context, configPath string, content []byte) (*framework.ConfigScope, error) {
+	if filepath.Base(configPath) == configComposer && !bytes.Contains(content, pestDependency) {
+		return nil, nil
+	}
+
+	scope := framework.NewConfigScope(configPath, "")
+	scope.Framework = frameworkName
+	return scope, nil
+}
+
+// PestParser extracts test definitions from Pest files.
+type PestParser struct{}
+
+func (p *PestParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	return parseFile(ctx, source, filename)
+}
@@ -0,0 +1,97 @@
+package pest
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+func TestPestConfigParser_Parse(t *testing.T) {
+	tests := []struct {
+		name       string
+		configPath string
+		content    string
+		wantScope  bool
+		wantBase   string
+	}{
+		{
+			name:       "Pest.php bootstrap",
+			configPath: "/project/tests/Pest.php",
+			content:    "<?php\n\npest()->extend(Tests\\TestCase::class)->in('Feature');\n",
+			wantScope:  true,
+			wantBase:   "/project/tests",
+		},
+		{
+			name:       "composer.json requiring pest",
+			configPath: "/project/composer.json",
+			content:    `{"require-dev": {"pestphp/pest": "^3.0"}}`,
+			wantScope:  true,
+			wantBase:   "/project",
+		},
+		{
+			name:       "composer.json without pest",
+			configPath: "/project/composer.json",
+			content:    `{"require-dev": {"phpunit/phpunit": "^11.0"}}`,
+		},
+	}
+
+	parser := &PestConfigParser{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope, err := parser.Parse(ctx, filepath.FromSlash(tt.configPath), []byte(tt.content))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !tt.wantScope {
+				if scope != nil {
+					t.Errorf("expected config to be declined, got %+v", scope)
+				}
+				return
+			}
+			if scope == nil {
+				t.Fatal("expected scope, got nil")
+			}
+			if scope.Framework != frameworkName {
+				t.Errorf("expected framework %q, got %q", frameworkName, scope.Framework)
+			}
+			if scope.BaseDir != filepath.FromSlash(tt.wantBase) {
+				t.Errorf("BaseDir = %q, want %q", scope.BaseDir, tt.wantBase)
+			}
+		})
+	}
+}
+
+func TestPestContentMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantMatch bool
+	}{
+		{"it call", "<?php\n\nit('adds', function () {});", true},
+		{"describe call", "<?php\ndescribe(\"sum\", function () {});", true},
+		{"expectation", "<?php\n$fn = fn () => expect($x)->not->toBeNull();", true},
+		{"uses binding", "<?php\nuses(Tests\\TestCase::class);", true},
+		{"phpunit class", "<?php\nclass SumTest extends TestCase {\n  public function testAdd() { $this->assertSame(3, sum(1, 2)); }\n}", false},
+	}
+
+	matcher := &PestContentMatcher{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := framework.Signal{
+				Type:    framework.SignalFileContent,
+				Value:   tt.content,
+				Context: []byte(tt.content),
+			}
+			result := matcher.Match(ctx, signal)
+			if (result.Confidence > 0) != tt.wantMatch {
+				t.Errorf("Match() confidence = %v, want match = %v", result.Confidence, tt.wantMatch)
+			}
+		})
+	}
+}
@@ -0,0 +1,326 @@
+package pest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+	"github.com/specvital/core/pkg/parser/strategies/shared/phpast"
+)
+
+// PHP AST node types used by Pest files.
+const (
+	nodeAnonymousFunction = "anonymous_function_creation_expression"
+	nodeArgument          = "argument"
+	nodeArguments         = "arguments"
+	nodeArrayCreation     = "array_creation_expression"
+	nodeArrayElement      = "array_element_initializer"
+	nodeArrowFunction     = "arrow_function"
+	nodeBoolean           = "boolean"
+	nodeEncapsedString    = "encapsed_string"
+	nodeFunctionCall      = "function_call_expression"
+	nodeMemberCall        = "member_call_expression"
+	nodeString            = "string"
+)
+
+// Pest functions.
+const (
+	funcArch     = "arch"
+	funcDataset  = "dataset"
+	funcDescribe = "describe"
+	funcIt       = "it"
+	funcTest     = "test"
+)
+
+// Chained test modifiers.
+const (
+	modifierGroup = "group"
+	modifierOnly  = "only"
+	modifierSkip  = "skip"
+	modifierTodo  = "todo"
+	modifierWith  = "with"
+)
+
+var hookFunctions = map[string]bool{
+	"beforeAll": true, "afterAll": true, "beforeEach": true, "afterEach": true,
+}
+
+// parseFile extracts Pest tests and any PHPUnit-style classes, which Pest runs as well.
+// it()/test()/arch() become tests and describe() becomes a suite. A test whose ->with()
+// data is a literal array, or a dataset() defined in the same file, is unrolled into one
+// test per data set.
+func parseFile(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguagePHP, source)
+	if err != nil {
+		return nil, fmt.Errorf("pest parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	root := tree.RootNode()
+	w := &walker{source: source, filename: filename, datasets: map[string]*sitter.Node{}}
+	w.collectDatasets(root, 0)
+
+	var file domain.TestSuite
+	w.walk(root, &file, 0)
+
+	return &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguagePHP,
+		Framework: frameworkName,
+		Suites:    append(file.Suites, phpast.ParseTestClasses(root, source, filename)...),
+		Tests:     file.Tests,
+	}, nil
+}
+
+type walker struct {
+	source   []byte
+	filename string
+	// datasets maps dataset('name', [...]) definitions to their literal array.
+	datasets map[string]*sitter.Node
+}
+
+// call is one link of a Pest chain: it('...', fn)->skip()->with([...]).
+type call struct {
+	name string
+	args []*sitter.Node
+	node *sitter.Node
+}
+
+func (w *walker) collectDatasets(node *sitter.Node, depth int) {
+	if depth > parser.MaxTreeDepth {
+		return
+	}
+	if node.Type() == nodeFunctionCall {
+		c := w.functionCall(node)
+		if c.name == funcDataset && len(c.args) >= 2 && c.args[1].Type() == nodeArrayCreation {
+			if name, ok := w.stringLiteral(c.args[0]); ok {
+				w.datasets[name] = c.args[1]
+			}
+			return
+		}
+	}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		w.collectDatasets(node.NamedChild(i), depth+1)
+	}
+}
+
+func (w *walker) walk(node *sitter.Node, parent *domain.TestSuite, depth int) {
+	if depth > parser.MaxTreeDepth {
+		return
+	}
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() != nodeFunctionCall && child.Type() != nodeMemberCall {
+			if child.Type() != phpast.NodeClassDeclaration {
+				w.walk(child, parent, depth+1)
+			}
+			continue
+		}
+
+		base, chain, ok := w.unwrapChain(child)
+		if !ok {
+			w.walk(child, parent, depth+1)
+			continue
+		}
+		switch {
+		case base.name == funcDescribe:
+			w.suite(child, base, chain, parent, depth)
+		case base.name == funcIt || base.name == funcTest || base.name == funcArch:
+			w.test(child, base, chain, parent)
+		case hookFunctions[base.name] || base.name == funcDataset:
+			// Hooks and dataset definitions declare no tests.
+		default:
+			w.walk(child, parent, depth+1)
+		}
+	}
+}
+
+func (w *walker) suite(node *sitter.Node, base call, chain []call, parent *domain.TestSuite, depth int) {
+	description, _ := w.description(base)
+	status, modifier := w.chainStatus(chain)
+
+	suite := domain.TestSuite{
+		Name:     description,
+		Status:   status,
+		Modifier: modifier,
+		Location: parser.GetLocation(node, w.filename),
+	}
+	if body := closureBody(base.args); body != nil {
+		w.walk(body, &suite, depth+1)
+	}
+	parent.Suites = append(parent.Suites, suite)
+}
+
+func (w *walker) test(node *sitter.Node, base call, chain []call, parent *domain.TestSuite) {
+	description, ok := w.description(base)
+	if !ok {
+		return
+	}
+	if base.name == funcIt {
+		// Pest reports it() tests with the "it" prefix.
+		description = funcIt + " " + description
+	}
+
+	test := domain.Test{
+		Name:     description,
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(node, w.filename),
+		Tags:     w.groups(chain),
+	}
+	if base.name != funcArch && closure(base.args) == nil {
+		test.Status = domain.TestStatusTodo
+		test.Modifier = modifierTodo
+	}
+	if status, modifier := w.chainStatus(chain); status != domain.TestStatusActive {
+		test.Status = status
+		test.Modifier = modifier
+	}
+
+	rows, ok := w.dataRows(chain)
+	if !ok {
+		parent.Tests = append(parent.Tests, test)
+		return
+	}
+	parent.Suites = append(parent.Suites, unroll(test, rows, w.filename))
+}
+
+// unwrapChain splits a call chain into its base function call and the chained method
+// calls in source order. Only chains rooted at a plain function call are recognized.
+func (w *walker) unwrapChain(node *sitter.Node) (call, []call, bool) {
+	var chain []call
+	for node.Type() == nodeMemberCall {
+		name := node.ChildByFieldName("name")
+		object := node.ChildByFieldName("object")
+		if name == nil || object == nil {
+			return call{}, nil, false
+		}
+		chain = append(chain, call{
+			name: parser.GetNodeText(name, w.source),
+			args: callArguments(node),
+			node: node,
+		})
+		node = object
+	}
+	if node.Type() != nodeFunctionCall {
+		return call{}, nil, false
+	}
+
+	for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+		chain[i], chain[j] = chain[j], chain[i]
+	}
+	return w.functionCall(node), chain, true
+}
+
+func (w *walker) functionCall(node *sitter.Node) call {
+	c := call{args: callArguments(node), node: node}
+	if fn := node.ChildByFieldName("function"); fn != nil && fn.Type() == phpast.NodeName {
+		c.name = parser.GetNodeText(fn, w.source)
+	}
+	return c
+}
+
+// chainStatus applies ->skip(), ->todo() and ->only(). A skip whose first argument is
+// a condition other than true is decided at runtime and leaves the test active.
+func (w *walker) chainStatus(chain []call) (domain.TestStatus, string) {
+	status, modifier := domain.TestStatusActive, ""
+	for _, c := range chain {
+		switch c.name {
+		case modifierSkip:
+			if len(c.args) == 0 || w.isUnconditional(c.args[0]) {
+				return domain.TestStatusSkipped, modifierSkip
+			}
+		case modifierTodo:
+			return domain.TestStatusTodo, modifierTodo
+		case modifierOnly:
+			status, modifier = domain.TestStatusFocused, modifierOnly
+		}
+	}
+	return status, modifier
+}
+
+// isUnconditional reports whether a skip argument always skips: a reason string or true.
+func (w *walker) isUnconditional(arg *sitter.Node) bool {
+	if _, ok := w.stringLiteral(arg); ok {
+		return true
+	}
+	return arg.Type() == nodeBoolean && strings.EqualFold(parser.GetNodeText(arg, w.source), "true")
+}
+
+// groups returns the ->group() names of a test as tags.
+func (w *walker) groups(chain []call) []string {
+	var tags []string
+	for _, c := range chain {
+		if c.name != modifierGroup {
+			continue
+		}
+		for _, arg := range c.args {
+			if name, ok := w.stringLiteral(arg); ok {
+				tags = append(tags, name)
+			}
+		}
+	}
+	return tags
+}
+
+func (w *walker) description(c call) (string, bool) {
+	if len(c.args) == 0 {
+		return "", false
+	}
+	if text, ok := w.stringLiteral(c.args[0]); ok {
+		return text, true
+	}
+	// Computed descriptions are kept as written.
+	return parser.GetNodeText(c.args[0], w.source), true
+}
+
+// stringLiteral returns the value of a single- or double-quoted string as written.
+func (w *walker) stringLiteral(node *sitter.Node) (string, bool) {
+	if node.Type() != nodeString && node.Type() != nodeEncapsedString {
+		return "", false
+	}
+	text := parser.GetNodeText(node, w.source)
+	if len(text) < 2 {
+		return "", false
+	}
+	return text[1 : len(text)-1], true
+}
+
+// callArguments returns the argument values of a call.
+func callArguments(node *sitter.Node) []*sitter.Node {
+	args := node.ChildByFieldName("arguments")
+	if args == nil || args.Type() != nodeArguments {
+		return nil
+	}
+	var result []*sitter.Node
+	for i := 0; i < int(args.NamedChildCount()); i++ {
+		arg := args.NamedChild(i)
+		if arg.Type() != nodeArgument || arg.NamedChildCount() == 0 {
+			continue
+		}
+		result = append(result, arg.NamedChild(int(arg.NamedChildCount())-1))
+	}
+	return result
+}
+
+// closure returns the test closure of it()/test()/describe().
+func closure(args []*sitter.Node) *sitter.Node {
+	for _, arg := range args {
+		if arg.Type() == nodeAnonymousFunction || arg.Type() == nodeArrowFunction {
+			return arg
+		}
+	}
+	return nil
+}
+
+func closureBody(args []*sitter.Node) *sitter.Node {
+	fn := closure(args)
+	if fn == nil {
+		return nil
+	}
+	return fn.ChildByFieldName("body")
+}
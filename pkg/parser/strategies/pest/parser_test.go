@@ -0,0 +1,208 @@
+package pest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specvital/core/pkg/domain"
+)
+
+func TestPestParser_Parse(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		checkFunc func(t *testing.T, file *domain.TestFile)
+	}{
+		{
+			name: "describe, it and test",
+			source: `<?php
+
+use function Pest\Laravel\get;
+
+beforeEach(function () {
+    it('not a test', function () {});
+});
+
+describe('sum', function () {
+    it('adds numbers', function () {
+        expect(sum(1, 2))->toBe(3);
+    });
+
+    test('negative numbers', fn () => expect(sum(-1, -2))->toBe(-3));
+});
+
+arch('app')->expect('App')->not->toUse('dd');
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				sum := file.Suites[0]
+				if sum.Name != "sum" || sum.Location.StartLine != 9 {
+					t.Errorf("unexpected suite %q at line %d", sum.Name, sum.Location.StartLine)
+				}
+				if len(sum.Tests) != 2 {
+					t.Fatalf("expected 2 tests, got %+v", sum.Tests)
+				}
+				if sum.Tests[0].Name != "it adds numbers" || sum.Tests[1].Name != "negative numbers" {
+					t.Errorf("unexpected test names %q, %q", sum.Tests[0].Name, sum.Tests[1].Name)
+				}
+				if len(file.Tests) != 1 || file.Tests[0].Name != "app" {
+					t.Errorf("expected arch test, got %+v", file.Tests)
+				}
+			},
+		},
+		{
+			name: "chained modifiers",
+			source: `<?php
+
+it('is skipped', function () {})->skip();
+it('has a reason', function () {})->skip('flaky');
+it('skips on a condition', function () {})->skip(fn () => PHP_OS === 'WINNT');
+it('is pending');
+test('is marked todo', function () {})->todo();
+test('is focused', function () {})->only()->group('slow', 'db');
+describe('disabled', function () {
+    it('runs', function () {});
+})->skip();
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				want := []struct {
+					status   domain.TestStatus
+					modifier string
+				}{
+					{domain.TestStatusSkipped, "skip"},
+					{domain.TestStatusSkipped, "skip"},
+					{domain.TestStatusActive, ""},
+					{domain.TestStatusTodo, "todo"},
+					{domain.TestStatusTodo, "todo"},
+					{domain.TestStatusFocused, "only"},
+				}
+				if len(file.Tests) != len(want) {
+					t.Fatalf("expected %d tests, got %d", len(want), len(file.Tests))
+				}
+				for i, w := range want {
+					got := file.Tests[i]
+					if got.Status != w.status || got.Modifier != w.modifier {
+						t.Errorf("%q: expected %s/%q, got %s/%q", got.Name, w.status, w.modifier, got.Status, got.Modifier)
+					}
+				}
+				tags := file.Tests[5].Tags
+				if len(tags) != 2 || tags[0] != "slow" || tags[1] != "db" {
+					t.Errorf("expected tags [slow db], got %v", tags)
+				}
+				if len(file.Suites) != 1 || file.Suites[0].Status != domain.TestStatusSkipped {
+					t.Errorf("expected skipped describe, got %+v", file.Suites)
+				}
+			},
+		},
+		{
+			name: "datasets",
+			source: `<?php
+
+it('adds', function (int $a, int $b) {
+    expect($a + $b)->toBeInt();
+})->with([[1, 2], [3, 4]]);
+
+it('has emails', function (string $email) {
+    expect($email)->not->toBeEmpty();
+})->with('emails');
+
+dataset('emails', ['a@x.com', 'b@x.com']);
+
+test('keyed', fn ($n) => expect($n)->toBeInt())->with(['one' => 1, 'two' => 2])->with([true, false]);
+
+it('uses a shared dataset', function ($user) {})->with('users');
+
+it('uses a generator', function ($n) {})->with(function () {
+    yield 1;
+});
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 3 {
+					t.Fatalf("expected 3 unrolled suites, got %d", len(file.Suites))
+				}
+
+				adds := file.Suites[0]
+				if adds.Name != "it adds" || len(adds.Tests) != 2 {
+					t.Fatalf("expected 2 adds data sets, got %+v", adds)
+				}
+				if adds.Tests[0].Name != "it adds with (1, 2)" {
+					t.Errorf("unexpected data set name %q", adds.Tests[0].Name)
+				}
+				if adds.Tests[1].Location.StartLine != 5 {
+					t.Errorf("expected data set at line 5, got %d", adds.Tests[1].Location.StartLine)
+				}
+
+				emails := file.Suites[1]
+				if len(emails.Tests) != 2 || emails.Tests[1].Name != "it has emails with ('b@x.com')" {
+					t.Errorf("expected named dataset to be unrolled, got %+v", emails.Tests)
+				}
+
+				keyed := file.Suites[2]
+				if len(keyed.Tests) != 4 {
+					t.Fatalf("expected cartesian product of 4, got %d", len(keyed.Tests))
+				}
+				if keyed.Tests[1].Name != `keyed with data set "one" / (false)` {
+					t.Errorf("unexpected combined name %q", keyed.Tests[1].Name)
+				}
+
+				if len(file.Tests) != 2 {
+					t.Errorf("expected unknown datasets to count as 1 each, got %d", len(file.Tests))
+				}
+				if file.CountTests() != 10 {
+					t.Errorf("expected 10 tests, got %d", file.CountTests())
+				}
+			},
+		},
+		{
+			name: "test classes",
+			source: `<?php
+
+namespace Tests\Feature;
+
+use Tests\TestCase;
+
+class ExampleTest extends TestCase
+{
+    public function test_the_application_returns_a_successful_response(): void
+    {
+        $this->get('/')->assertStatus(200);
+    }
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 || file.Suites[0].Name != "ExampleTest" {
+					t.Fatalf("expected class suite, got %+v", file.Suites)
+				}
+				if len(file.Suites[0].Tests) != 1 {
+					t.Errorf("expected 1 test method, got %d", len(file.Suites[0].Tests))
+				}
+			},
+		},
+	}
+
+	parser := &PestParser{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := parser.Parse(ctx, []byte(tt.source), "tests/Unit/SumTest.php")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if file.Framework != frameworkName {
+				t.Errorf("expected framework %q, got %q", frameworkName, file.Framework)
+			}
+
+			if file.Language != domain.LanguagePHP {
+				t.Errorf("expected language PHP, got %q", file.Language)
+			}
+
+			if tt.checkFunc != nil {
+				tt.checkFunc(t, file)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package phpunit
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+// defaultSuffix is the suffix PHPUnit uses for <directory> elements without one.
+const defaultSuffix = "Test.php"
+
+type phpunitConfig struct {
+	TestSuites []phpunitTestSuite `xml:"testsuites>testsuite"`
+	// TestSuite is the legacy form with a single <testsuite> under <phpunit>.
+	TestSuite []phpunitTestSuite `xml:"testsuite"`
+}
+
+type phpunitTestSuite struct {
+	Name        string             `xml:"name,attr"`
+	Directories []phpunitDirectory `xml:"directory"`
+	Files       []string           `xml:"file"`
+	Excludes    []string           `xml:"exclude"`
+}
+
+type phpunitDirectory struct {
+	Path   string `xml:",chardata"`
+	Prefix string `xml:"prefix,attr"`
+	Suffix string `xml:"suffix,attr"`
+}
+
+// PHPUnitConfigParser reads phpunit.xml, phpunit.xml.dist and phpunit.dist.xml.
+//
+// Each <testsuite> becomes a project collecting the files of its <directory>
+// elements (by prefix and suffix, "Test.php" by default) and its <file> elements,
+// minus its <exclude> paths. The PHP files under the suite directories are then
+// decided by the suites alone, so a suffix="Spec.php" suite collects FooSpec.php
+// and no longer FooTest.php. The suites are the projects of the Pest and other
+// PHPUnit-based tests they run too.
+type PHPUnitConfigParser struct{}
+
+func (p *PHPUnitConfigParser) Parse(ctx context.Context, configPath string, content []byte) (*framework.ConfigScope, error) {
+	var config phpunitConfig
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	// Configs in the wild declare encodings such as ISO-8859-1; element and
+	// attribute values are ASCII paths, so the raw bytes are read as is.
+	decoder.CharsetReader = func(charset string, input io.Reader) (io.Reader, error) { return input, nil }
+	if err := decoder.Decode(&config); err != nil {
+		return nil, fmt.Errorf("phpunit config: failed to parse %s: %w", configPath, err)
+	}
+
+	scope := framework.NewConfigScope(configPath, "")
+	scope.Framework = frameworkName
+	scope.SharedProjects = true
+
+	for _, suite := range append(config.TestSuites, config.TestSuite...) {
+		project := framework.ProjectScope{
+			Name:    suite.Name,
+			BaseDir: scope.BaseDir,
+		}
+
+		for _, dir := range suite.Directories {
+			dirPath := cleanConfigPath(dir.Path)
+			if dirPath == "" {
+				continue
+			}
+			suffix := strings.TrimSpace(dir.Suffix)
+			if suffix == "" {
+				suffix = defaultSuffix
+			}
+			project.Include = append(project.Include, path.Join(dirPath, "**", strings.TrimSpace(dir.Prefix)+"*"+suffix))
+			scope.ExclusivePatterns = append(scope.ExclusivePatterns, path.Join(dirPath, "**", "*.php"))
+		}
+		for _, file := range suite.Files {
+			if filePath := cleanConfigPath(file); filePath != "" {
+				project.Include = append(project.Include, filePath)
+			}
+		}
+		for _, exclude := range suite.Excludes {
+			if excludePath := cleanConfigPath(exclude); excludePath != "" {
+				project.Exclude = append(project.Exclude, excludePath, excludePath+"/**")
+			}
+		}
+
+		scope.CollectPatterns = append(scope.CollectPatterns, project.Include...)
+		scope.Exclude = append(scope.Exclude, project.Exclude...)
+		scope.Projects = append(scope.Projects, project)
+	}
+
+	return scope, nil
+}
+
+// cleanConfigPath normalizes a path of the config ("./tests/Unit/") to a glob
+// relative to the config directory ("tests/Unit").
+func cleanConfigPath(p string) string {
+	p = strings.TrimSpace(p)
+	if p == "" {
+		return ""
+	}
+	p = path.Clean(strings.ReplaceAll(p, "\\", "/"))
+	if p == "." {
+		return "."
+	}
+	return strings.TrimPrefix(p, "./")
+}
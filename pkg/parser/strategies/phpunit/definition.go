@@ -5,9 +5,6 @@ import (
 	"context"
 	"fmt"
 	"regexp"
-	"strings"
-
-	sitter "github.com/smacker/go-tree-sitter"
 
 	"github.com/specvital/core/pkg/domain"
 	"github.com/specvital/core/pkg/parser"
@@ -39,7 +36,7 @@ func NewDefinition() *framework.Definition {
 			&PHPUnitFileMatcher{},
 			&PHPUnitContentMatcher{},
 		},
-		ConfigParser: nil,
+		ConfigParser: &PHPUnitConfigParser{},
 		Parser:       &PHPUnitParser{},
 		Priority:     framework.PriorityGeneric,
 	}
@@ -107,7 +104,7 @@ func (p *PHPUnitParser) Parse(ctx context.Context, source []byte, filename strin
 	defer tree.Close()
 
 	root := tree.RootNode()
-	suites := parseTestClasses(root, source, filename)
+	suites := phpast.ParseTestClasses(root, source, filename)
 
 	return &domain.TestFile{
 		Path:      filename,
@@ -116,103 +113,3 @@ func (p *PHPUnitParser) Parse(ctx context.Context, source []byte, filename strin
 		Suites:    suites,
 	}, nil
 }
-
-func parseTestClasses(root *sitter.Node, source []byte, filename string) []domain.TestSuite {
-	var suites []domain.TestSuite
-
-	parser.WalkTree(root, func(node *sitter.Node) bool {
-		if node.Type() == phpast.NodeClassDeclaration {
-			if suite := parseTestClass(node, source, filename); suite != nil {
-				suites = append(suites, *suite)
-			}
-			return false
-		}
-		return true
-	})
-
-	return suites
-}
-
-func parseTestClass(node *sitter.Node, source []byte, filename string) *domain.TestSuite {
-	className := phpast.GetClassName(node, source)
-	if className == "" {
-		return nil
-	}
-
-	if !phpast.ExtendsTestCase(node, source) {
-		return nil
-	}
-
-	body := phpast.GetDeclarationList(node)
-	if body == nil {
-		return nil
-	}
-
-	var tests []domain.Test
-	var prevComment *sitter.Node
-
-	for i := 0; i < int(body.ChildCount()); i++ {
-		child := body.Child(i)
-
-		switch child.Type() {
-		case phpast.NodeComment:
-			prevComment = child
-
-		case phpast.NodeMethodDeclaration:
-			if test := parseTestMethod(child, source, filename, prevComment); test != nil {
-				tests = append(tests, *test)
-			}
-			prevComment = nil
-
-		default:
-			prevComment = nil
-		}
-	}
-
-	if len(tests) == 0 {
-		return nil
-	}
-
-	return &domain.TestSuite{
-		Name:     className,
-		Status:   domain.TestStatusActive,
-		Location: parser.GetLocation(node, filename),
-		Tests:    tests,
-	}
-}
-
-func parseTestMethod(node *sitter.Node, source []byte, filename string, prevComment *sitter.Node) *domain.Test {
-	methodName := phpast.GetMethodName(node, source)
-	if methodName == "" {
-		return nil
-	}
-
-	attrs := phpast.GetAttributes(node)
-	hasTestAttr := phpast.HasTestAttribute(attrs, source)
-
-	hasTestAnnotation := false
-	if prevComment != nil {
-		commentText := prevComment.Content(source)
-		hasTestAnnotation = phpast.HasTestAnnotation(commentText)
-	}
-
-	hasTestPrefix := strings.HasPrefix(methodName, "test")
-
-	if !hasTestAttr && !hasTestAnnotation && !hasTestPrefix {
-		return nil
-	}
-
-	status := domain.TestStatusActive
-	modifier := ""
-	if skipped, skipMod := phpast.HasSkipAttribute(attrs, source); skipped {
-		status = domain.TestStatusSkipped
-		modifier = skipMod
-	}
-
-	return &domain.Test{
-		Name:     methodName,
-		Status:   status,
-		Modifier: modifier,
-		Location: parser.GetLocation(node, filename),
-	}
-}
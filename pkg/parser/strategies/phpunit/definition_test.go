@@ -2,6 +2,8 @@ package phpunit
 
 import (
 	"context"
+	"path/filepath"
+	"reflect"
 	"testing"
 
 	"github.com/specvital/core/pkg/domain"
@@ -23,11 +25,109 @@ func TestNewDefinition(t *testing.T) {
 	if def.Parser == nil {
 		t.Error("expected Parser to be non-nil")
 	}
+	if def.ConfigParser == nil {
+		t.Error("expected ConfigParser to be non-nil")
+	}
 	if len(def.Matchers) != 4 {
 		t.Errorf("expected 4 Matchers, got %d", len(def.Matchers))
 	}
 }
 
+func TestPHPUnitConfigParser_Parse(t *testing.T) {
+	content := `<?xml version="1.0" encoding="UTF-8"?>
+<phpunit bootstrap="vendor/autoload.php" colors="true">
+    <testsuites>
+        <testsuite name="Unit">
+            <directory suffix="Spec.php">./tests/Unit</directory>
+            <exclude>./tests/Unit/Fixtures</exclude>
+        </testsuite>
+        <testsuite name="Feature">
+            <directory>tests/Feature/</directory>
+            <file>tests/SmokeCheck.php</file>
+        </testsuite>
+    </testsuites>
+</phpunit>
+`
+	configPath := filepath.FromSlash("/project/phpunit.xml")
+	scope, err := (&PHPUnitConfigParser{}).Parse(context.Background(), configPath, []byte(content))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if scope.Framework != frameworkName {
+		t.Errorf("expected framework %q, got %q", frameworkName, scope.Framework)
+	}
+	if len(scope.Projects) != 2 {
+		t.Fatalf("expected 2 projects, got %d", len(scope.Projects))
+	}
+
+	unit := scope.Projects[0]
+	if unit.Name != "Unit" || unit.BaseDir != filepath.FromSlash("/project") {
+		t.Errorf("unexpected Unit project %+v", unit)
+	}
+	if want := []string{"tests/Unit/**/*Spec.php"}; !reflect.DeepEqual(unit.Include, want) {
+		t.Errorf("Unit Include = %v, want %v", unit.Include, want)
+	}
+	if want := []string{"tests/Unit/Fixtures", "tests/Unit/Fixtures/**"}; !reflect.DeepEqual(unit.Exclude, want) {
+		t.Errorf("Unit Exclude = %v, want %v", unit.Exclude, want)
+	}
+	if want := []string{"tests/Feature/**/*Test.php", "tests/SmokeCheck.php"}; !reflect.DeepEqual(scope.Projects[1].Include, want) {
+		t.Errorf("Feature Include = %v, want %v", scope.Projects[1].Include, want)
+	}
+	if want := []string{"tests/Unit/**/*.php", "tests/Feature/**/*.php"}; !reflect.DeepEqual(scope.ExclusivePatterns, want) {
+		t.Errorf("ExclusivePatterns = %v, want %v", scope.ExclusivePatterns, want)
+	}
+
+	tests := []struct {
+		path        string
+		wantDecided bool
+		wantCollect bool
+	}{
+		{"/project/tests/Unit/UserSpec.php", true, true},
+		{"/project/tests/Unit/UserTest.php", true, false},
+		{"/project/tests/Unit/Fixtures/FakeSpec.php", true, false},
+		{"/project/tests/Feature/Api/LoginTest.php", true, true},
+		{"/project/tests/SmokeCheck.php", false, true},
+		{"/project/src/UserTest.php", false, false},
+	}
+	for _, tt := range tests {
+		filePath := filepath.FromSlash(tt.path)
+		if got := scope.Decides(filePath); got != tt.wantDecided {
+			t.Errorf("Decides(%s) = %v, want %v", tt.path, got, tt.wantDecided)
+		}
+		if got := scope.Collects(filePath); got != tt.wantCollect {
+			t.Errorf("Collects(%s) = %v, want %v", tt.path, got, tt.wantCollect)
+		}
+	}
+}
+
+func TestPHPUnitConfigParser_Parse_LegacyAndEmpty(t *testing.T) {
+	parser := &PHPUnitConfigParser{}
+	ctx := context.Background()
+	configPath := filepath.FromSlash("/project/phpunit.xml.dist")
+
+	legacy := `<?xml version="1.0" encoding="ISO-8859-1"?>
+<phpunit><testsuite name="All"><directory prefix="check_" suffix=".php">src</directory></testsuite></phpunit>`
+	scope, err := parser.Parse(ctx, configPath, []byte(legacy))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(scope.Projects) != 1 || !reflect.DeepEqual(scope.Projects[0].Include, []string{"src/**/check_*.php"}) {
+		t.Errorf("unexpected projects %+v", scope.Projects)
+	}
+
+	scope, err = parser.Parse(ctx, configPath, []byte(`<phpunit colors="true"/>`))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(scope.ExclusivePatterns) != 0 || len(scope.Projects) != 0 {
+		t.Errorf("expected no testsuites, got %+v", scope)
+	}
+
+	if _, err := parser.Parse(ctx, configPath, []byte(`<phpunit><testsuites>`)); err == nil {
+		t.Error("expected an error for invalid XML")
+	}
+}
+
 func TestPHPUnitFileMatcher_Match(t *testing.T) {
 	matcher := &PHPUnitFileMatcher{}
 	ctx := context.Background()
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
-	"regexp"
 
 	sitter "github.com/smacker/go-tree-sitter"
 
@@ -12,6 +11,7 @@ import (
 	"github.com/specvital/core/pkg/parser"
 	"github.com/specvital/core/pkg/parser/framework"
 	"github.com/specvital/core/pkg/parser/framework/matchers"
+	"github.com/specvital/core/pkg/parser/strategies/shared/jsconfig"
 	"github.com/specvital/core/pkg/parser/strategies/shared/jstest"
 )
 
@@ -50,112 +50,45 @@ func NewDefinition() *framework.Definition {
 type PlaywrightConfigParser struct{}
 
 func (p *PlaywrightConfigParser) Parse(ctx context.Context, configPath string, content []byte) (*framework.ConfigScope, error) {
-	testDir := parseTestDir(content)
+	config, err := jsconfig.Evaluate(ctx, configPath, content)
+	if err != nil {
+		return nil, err
+	}
+
+	testDir, _ := config.Get("testDir").AsString()
 	scope := framework.NewConfigScope(configPath, testDir)
 	scope.Framework = frameworkName
 	scope.GlobalsMode = false // Playwright always requires explicit imports
 
 	configDir := filepath.Dir(configPath)
-	if projects := parseProjects(content, configDir); len(projects) > 0 {
+	if projects := parseProjects(config, configDir); len(projects) > 0 {
 		scope.Projects = projects
 	}
 
 	return scope, nil
 }
 
-var (
-	testDirStringPattern   = regexp.MustCompile(`testDir\s*[=:]\s*['"]([^'"]+)['"]`)
-	testDirPathJoinPattern = regexp.MustCompile(`(?:const\s+)?testDir\s*[=:]\s*path\.join\s*\(\s*__dirname\s*,\s*['"]([^'"]+)['"]\s*\)`)
-	// Fallback: matches testDirRoot variable declaration (e.g., const testDirRoot = 'e2e-playwright')
-	testDirRootVarPattern = regexp.MustCompile(`(?:const|let|var|export\s+const)\s+testDirRoot\s*=\s*['"]([^'"]+)['"]`)
-
-	projectsArrayPattern = regexp.MustCompile(`projects\s*:\s*\[`)
-	// Limitation: only handles up to 2 levels of nesting (sufficient for name/testDir extraction)
-	projectBlockPattern      = regexp.MustCompile(`\{\s*(?:[^{}]*(?:\{[^{}]*\})?)*\s*\}`)
-	projectNamePattern       = regexp.MustCompile(`name\s*:\s*['"]([^'"]+)['"]`)
-	projectTestDirPattern    = regexp.MustCompile(`testDir\s*:\s*['"]([^'"]+)['"]`)
-	projectTestDirJoinSimple = regexp.MustCompile(`testDir\s*:\s*path\.join\s*\([^)]+,\s*['"]([^'"]+)['"]\s*\)`)
-)
-
-func parseTestDir(content []byte) string {
-	// Priority 1: testDirRoot variable (e.g., const testDirRoot = 'e2e-playwright')
-	// This is more reliable as it's always at root level
-	if match := testDirRootVarPattern.FindSubmatch(content); match != nil {
-		return string(match[1])
-	}
-	// Priority 2: root-level testDir property
-	if match := testDirStringPattern.FindSubmatch(content); match != nil {
-		return string(match[1])
-	}
-	if match := testDirPathJoinPattern.FindSubmatch(content); match != nil {
-		return string(match[1])
-	}
-	return ""
-}
-
-func parseProjects(content []byte, configDir string) []framework.ProjectScope {
-	loc := projectsArrayPattern.FindIndex(content)
-	if loc == nil {
-		return nil
-	}
-
-	startIdx := loc[1]
-	depth := 1
-	endIdx := startIdx
-
-	for i := startIdx; i < len(content) && depth > 0; i++ {
-		switch content[i] {
-		case '[':
-			depth++
-		case ']':
-			depth--
-		}
-		endIdx = i
-	}
-
-	if depth != 0 {
-		return nil
-	}
-
-	projectsContent := content[startIdx:endIdx]
-	return extractProjectScopes(projectsContent, configDir)
-}
-
-func extractProjectScopes(projectsContent []byte, configDir string) []framework.ProjectScope {
+// parseProjects returns the projects that set their own testDir; the others
+// run from the config's testDir, which the scope already covers.
+func parseProjects(config jsconfig.Value, configDir string) []framework.ProjectScope {
 	var projects []framework.ProjectScope
-
-	blocks := projectBlockPattern.FindAll(projectsContent, -1)
-	for _, block := range blocks {
-		project := parseProjectBlock(block, configDir)
-		if project.BaseDir != "" {
-			projects = append(projects, project)
+	for _, item := range config.Get("projects").Items() {
+		testDir, ok := item.Get("testDir").AsString()
+		if !ok || testDir == "" {
+			continue
 		}
+		name, _ := item.Get("name").AsString()
+		projects = append(projects, framework.ProjectScope{
+			Name:    name,
+			BaseDir: resolveTestDir(configDir, testDir),
+		})
 	}
-
 	return projects
 }
 
-func parseProjectBlock(block []byte, configDir string) framework.ProjectScope {
-	var project framework.ProjectScope
-
-	if match := projectNamePattern.FindSubmatch(block); match != nil {
-		project.Name = string(match[1])
-	}
-
-	if match := projectTestDirPattern.FindSubmatch(block); match != nil {
-		testDir := string(match[1])
-		project.BaseDir = resolveTestDir(configDir, testDir)
-	} else if match := projectTestDirJoinSimple.FindSubmatch(block); match != nil {
-		testDir := string(match[1])
-		project.BaseDir = resolveTestDir(configDir, testDir)
-	}
-
-	return project
-}
-
 func resolveTestDir(configDir, testDir string) string {
-	if testDir == "" {
-		return ""
+	if filepath.IsAbs(testDir) {
+		return filepath.Clean(testDir)
 	}
 	return filepath.Clean(filepath.Join(configDir, testDir))
 }
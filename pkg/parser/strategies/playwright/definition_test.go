@@ -78,6 +78,8 @@ export default defineConfig({
 		{
 			name: "testDirRoot variable (grafana pattern)",
 			configContent: `
+import path from 'path';
+
 export const testDirRoot = 'e2e-playwright';
 
 export default defineConfig({
@@ -88,7 +90,26 @@ export default defineConfig({
 `,
 			configPath:          "/project/playwright.config.ts",
 			expectedGlobalsMode: false,
-			expectedBaseDir:     "/project/e2e-playwright",
+			// Without a top-level testDir, Playwright runs from the config directory.
+			expectedBaseDir: "/project",
+		},
+		{
+			name: "testDir from path.join(__dirname) after project testDir",
+			configContent: `
+import { join } from 'node:path';
+
+const config: PlaywrightTestConfig = {
+  projects: [
+    { name: 'setup', testDir: './setup' },
+  ],
+  testDir: join(__dirname, 'e2e'),
+};
+
+export default config;
+`,
+			configPath:          "/project/apps/web/playwright.config.ts",
+			expectedGlobalsMode: false,
+			expectedBaseDir:     "/project/apps/web/e2e",
 		},
 	}
 
@@ -141,6 +162,8 @@ export default defineConfig({
 		{
 			name: "projects with path.join testDir",
 			configContent: `
+import path from 'path';
+
 const testDirRoot = 'e2e/plugin-e2e/';
 
 export default defineConfig({
@@ -159,7 +182,7 @@ export default defineConfig({
 			configPath:       "/project/playwright.config.ts",
 			expectedProjects: 2,
 			expectedNames:    []string{"api-admin", "api-viewer"},
-			expectedBaseDirs: []string{"/project/api-tests/as-admin-user", "/project/api-tests/as-viewer-user"},
+			expectedBaseDirs: []string{"/project/e2e/plugin-e2e/api-tests/as-admin-user", "/project/e2e/plugin-e2e/api-tests/as-viewer-user"},
 		},
 		{
 			name: "projects without testDir should be ignored",
@@ -0,0 +1,246 @@
+package pytest
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+// Settings stored on the pytest ConfigScope.
+const (
+	// settingDoctestModules is true when addopts contains --doctest-modules.
+	settingDoctestModules = "doctestModules"
+	// settingPythonFiles, settingPythonClasses and settingPythonFunctions hold the
+	// python_files, python_classes and python_functions options ([]string).
+	settingPythonFiles     = "pythonFiles"
+	settingPythonClasses   = "pythonClasses"
+	settingPythonFunctions = "pythonFunctions"
+	// settingMarkers holds the names of the markers registered with the markers option.
+	settingMarkers = "markers"
+)
+
+const (
+	flagDoctestModules = "--doctest-modules"
+	flagDoctestGlob    = "--doctest-glob"
+
+	// defaultDoctestGlob is collected by pytest's doctest plugin without any flag.
+	defaultDoctestGlob = "test*.txt"
+)
+
+// iniSections maps each ini-format config file to the section pytest reads.
+var iniSections = map[string]string{
+	"pytest.ini": "pytest",
+	"tox.ini":    "pytest",
+	"setup.cfg":  "tool:pytest",
+}
+
+// configPrecedence ranks the config files pytest looks for in a directory: it reads
+// the first of pytest.ini, pyproject.toml, tox.ini and setup.cfg that configures it.
+var configPrecedence = map[string]int{
+	"pytest.ini":     4,
+	"pyproject.toml": 3,
+	"tox.ini":        2,
+	"setup.cfg":      1,
+}
+
+const (
+	pyprojectFile  = "pyproject.toml"
+	pyprojectTable = "tool.pytest.ini_options"
+	conftestFile   = "conftest.py"
+)
+
+type PytestConfigParser struct{}
+
+// Parse reads the pytest options of pytest.ini, tox.ini [pytest], setup.cfg [tool:pytest]
+// and pyproject.toml [tool.pytest.ini_options]; the shared files are declined when
+// they have no pytest section. Of several config files in one directory, only the one
+// pytest reads applies. conftest.py only marks a pytest project, so its scope is
+// passive and never hides the options of an enclosing config.
+//
+// testpaths become the scope's roots and norecursedirs its excluded directories.
+// python_files name the collected modules, python_classes and python_functions the
+// collected tests. --doctest-modules in addopts makes every module a doctest
+// candidate, and --doctest-glob names the text files collected as doctests.
+func (p *PytestConfigParser) Parse(ctx context.Context, configPath string, content []byte) (*framework.ConfigScope, error) {
+	base := filepath.Base(configPath)
+
+	var option func(key string) ([]string, bool)
+	switch {
+	case base == pyprojectFile:
+		if !tomlHasTable(content, pyprojectTable) {
+			return nil, nil
+		}
+		option = func(key string) ([]string, bool) { return tomlValues(content, pyprojectTable, key) }
+	case iniSections[base] != "":
+		section := iniSections[base]
+		if base != "pytest.ini" && !iniHasSection(content, section) {
+			return nil, nil
+		}
+		option = func(key string) ([]string, bool) { return iniValues(content, section, key) }
+	default:
+		option = func(string) ([]string, bool) { return nil, false }
+	}
+
+	scope := framework.NewConfigScope(configPath, "")
+	scope.Framework = frameworkName
+	scope.Passive = base == conftestFile
+	scope.Precedence = configPrecedence[base]
+
+	addopts, _ := option("addopts")
+	applyDoctestOptions(scope, strings.Join(addopts, " "))
+
+	configDir := filepath.Dir(configPath)
+	for _, testPath := range argsOption(option, "testpaths") {
+		scope.Roots = append(scope.Roots, filepath.Join(configDir, testPath))
+	}
+	for _, dir := range argsOption(option, "norecursedirs") {
+		scope.Exclude = append(scope.Exclude, "**/"+dir+"/**")
+	}
+
+	if files := argsOption(option, "python_files"); len(files) > 0 {
+		scope.Settings[settingPythonFiles] = files
+		for _, glob := range files {
+			scope.CollectPatterns = append(scope.CollectPatterns, "**/"+glob)
+		}
+	}
+	if classes := argsOption(option, "python_classes"); len(classes) > 0 {
+		scope.Settings[settingPythonClasses] = classes
+	}
+	if functions := argsOption(option, "python_functions"); len(functions) > 0 {
+		scope.Settings[settingPythonFunctions] = functions
+	}
+	if markers := parseMarkers(option); len(markers) > 0 {
+		scope.Settings[settingMarkers] = markers
+	}
+
+	return scope, nil
+}
+
+// argsOption splits an "args" option (e.g. python_files) on whitespace.
+func argsOption(option func(string) ([]string, bool), key string) []string {
+	values, _ := option(key)
+	var args []string
+	for _, value := range values {
+		for _, arg := range strings.Fields(value) {
+			args = append(args, unquote(arg))
+		}
+	}
+	return args
+}
+
+// parseMarkers returns the marker names of "name: description" or "name(args): ..." lines.
+func parseMarkers(option func(string) ([]string, bool)) []string {
+	values, _ := option("markers")
+	var markers []string
+	for _, value := range values {
+		for _, line := range strings.Split(value, "\n") {
+			name, _, _ := strings.Cut(line, ":")
+			name, _, _ = strings.Cut(name, "(")
+			if name = strings.TrimSpace(name); name != "" {
+				markers = append(markers, name)
+			}
+		}
+	}
+	return markers
+}
+
+func applyDoctestOptions(scope *framework.ConfigScope, addopts string) {
+	var globs []string
+	args := strings.Fields(addopts)
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == flagDoctestModules:
+			scope.Settings[settingDoctestModules] = true
+		case arg == flagDoctestGlob && i+1 < len(args):
+			i++
+			globs = append(globs, unquote(args[i]))
+		case strings.HasPrefix(arg, flagDoctestGlob+"="):
+			globs = append(globs, unquote(strings.TrimPrefix(arg, flagDoctestGlob+"=")))
+		}
+	}
+
+	if scope.Settings[settingDoctestModules] == true {
+		scope.CollectPatterns = append(scope.CollectPatterns, "**/*.py")
+	}
+	if len(globs) == 0 {
+		globs = []string{defaultDoctestGlob}
+	}
+	for _, glob := range globs {
+		scope.CollectPatterns = append(scope.CollectPatterns, "**/"+glob)
+	}
+}
+
+// iniHasSection reports whether content has a [section] header.
+func iniHasSection(content []byte, section string) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		if name, ok := iniSectionName(strings.TrimSpace(scanner.Text())); ok && name == section {
+			return true
+		}
+	}
+	return false
+}
+
+func iniSectionName(line string) (string, bool) {
+	if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+		return strings.TrimSpace(line[1 : len(line)-1]), true
+	}
+	return "", false
+}
+
+// iniValues returns the value of key in section as lines: the value after the
+// separator followed by its indented continuation lines.
+func iniValues(content []byte, section, key string) ([]string, bool) {
+	var (
+		inSection bool
+		found     bool
+		values    []string
+	)
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+
+		if found {
+			if trimmed != "" && (line[0] == ' ' || line[0] == '\t') {
+				values = append(values, trimmed)
+				continue
+			}
+			break
+		}
+
+		if trimmed == "" || trimmed[0] == '#' || trimmed[0] == ';' {
+			continue
+		}
+		if name, ok := iniSectionName(trimmed); ok {
+			inSection = name == section
+			continue
+		}
+		if !inSection {
+			continue
+		}
+
+		name, value, ok := strings.Cut(trimmed, "=")
+		if !ok {
+			name, value, ok = strings.Cut(trimmed, ":")
+		}
+		if ok && strings.TrimSpace(name) == key {
+			found = true
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+
+	return values, found
+}
+
+func unquote(s string) string {
+	return strings.Trim(s, `"'`)
+}
@@ -3,6 +3,8 @@ package pytest
 import (
 	"context"
 	"fmt"
+	"path"
+	"path/filepath"
 	"regexp"
 	"strings"
 
@@ -29,6 +31,9 @@ func NewDefinition() *framework.Definition {
 			matchers.NewImportMatcher("pytest"),
 			matchers.NewConfigMatcher(
 				"pytest.ini",
+				"pyproject.toml",
+				"tox.ini",
+				"setup.cfg",
 				"conftest.py",
 			),
 			&PytestConfigContentMatcher{},
@@ -41,14 +46,6 @@ func NewDefinition() *framework.Definition {
 	}
 }
 
-type PytestConfigParser struct{}
-
-func (p *PytestConfigParser) Parse(ctx context.Context, configPath string, content []byte) (*framework.ConfigScope, error) {
-	scope := framework.NewConfigScope(configPath, "")
-	scope.Framework = frameworkName
-	return scope, nil
-}
-
 // PytestConfigContentMatcher matches pyproject.toml with [tool.pytest] section.
 type PytestConfigContentMatcher struct{}
 
@@ -137,6 +134,30 @@ func (m *PytestContentMatcher) Match(ctx context.Context, signal framework.Signa
 type PytestParser struct{}
 
 func (p *PytestParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	return p.parse(ctx, source, filename, false, defaultRules)
+}
+
+// ParseWithScope applies the config's python_files, python_classes and
+// python_functions, collects docstring examples when the config enables
+// --doctest-modules, and parses text files matched by --doctest-glob.
+func (p *PytestParser) ParseWithScope(ctx context.Context, source []byte, filename string, scope *framework.ConfigScope) (*domain.TestFile, error) {
+	if filepath.Ext(filename) != ".py" {
+		return parseDoctestTextFile(source, filename), nil
+	}
+	doctestModules, _ := scope.Settings[settingDoctestModules].(bool)
+	return p.parse(ctx, source, filename, doctestModules, rulesFromScope(scope))
+}
+
+func (p *PytestParser) parse(ctx context.Context, source []byte, filename string, doctestModules bool, rules collectionRules) (*domain.TestFile, error) {
+	// Configured python_files decide which modules pytest collects tests from.
+	collectsTests := true
+	if doctestModules || len(rules.files) > 0 {
+		collectsTests = rules.isTestModule(filename)
+	}
+	if !collectsTests && !doctestModules {
+		return nil, nil
+	}
+
 	tree, err := parser.ParseWithPool(ctx, domain.LanguagePython, source)
 	if err != nil {
 		return nil, fmt.Errorf("pytest parser: failed to parse %s: %w", filename, err)
@@ -144,7 +165,16 @@ func (p *PytestParser) Parse(ctx context.Context, source []byte, filename string
 	defer tree.Close()
 
 	root := tree.RootNode()
-	suites, tests := parseTestModule(root, source, filename)
+
+	var suites []domain.TestSuite
+	var tests []domain.Test
+	// Modules collected only for their doctests contribute no test functions.
+	if collectsTests {
+		suites, tests = parseTestModule(root, source, filename, rules)
+	}
+	if doctestModules {
+		tests = append(tests, parseDoctests(root, source, filename)...)
+	}
 
 	return &domain.TestFile{
 		Path:      filename,
@@ -155,7 +185,78 @@ func (p *PytestParser) Parse(ctx context.Context, source []byte, filename string
 	}, nil
 }
 
-func parseTestModule(root *sitter.Node, source []byte, filename string) ([]domain.TestSuite, []domain.Test) {
+// collectionRules are pytest's python_files, python_classes and python_functions.
+// Class and function entries are name prefixes unless they contain glob characters.
+type collectionRules struct {
+	// files are basename globs; without them, test file discovery rules apply.
+	files     []string
+	classes   []string
+	functions []string
+}
+
+// defaultRules apply without configured options. Functions need the test_ prefix,
+// the common convention, rather than pytest's looser "test" default.
+var defaultRules = collectionRules{
+	classes:   []string{"Test"},
+	functions: []string{"test_"},
+}
+
+func rulesFromScope(scope *framework.ConfigScope) collectionRules {
+	rules := defaultRules
+	if files, ok := scope.Settings[settingPythonFiles].([]string); ok {
+		rules.files = files
+	}
+	if classes, ok := scope.Settings[settingPythonClasses].([]string); ok {
+		rules.classes = classes
+	}
+	if functions, ok := scope.Settings[settingPythonFunctions].([]string); ok {
+		rules.functions = functions
+	}
+	return rules
+}
+
+// isTestModule matches python_files, or mirrors test file discovery without them:
+// test_*.py, *_test.py and modules under tests/.
+func (r collectionRules) isTestModule(filename string) bool {
+	base := filepath.Base(filename)
+	if len(r.files) > 0 {
+		for _, glob := range r.files {
+			if match, err := path.Match(glob, base); err == nil && match {
+				return true
+			}
+		}
+		return false
+	}
+
+	if strings.HasPrefix(base, "test_") || strings.HasSuffix(base, "_test.py") {
+		return true
+	}
+	slashed := filepath.ToSlash(filename)
+	return base != conftestFile && (strings.Contains(slashed, "/tests/") || strings.HasPrefix(slashed, "tests/"))
+}
+
+func (r collectionRules) isTestFunction(name string) bool {
+	return matchesNamePattern(name, r.functions)
+}
+
+func (r collectionRules) isTestClass(name string) bool {
+	return matchesNamePattern(name, r.classes)
+}
+
+func matchesNamePattern(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if strings.ContainsAny(pattern, "*?[") {
+			if match, err := path.Match(pattern, name); err == nil && match {
+				return true
+			}
+		} else if strings.HasPrefix(name, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseTestModule(root *sitter.Node, source []byte, filename string, rules collectionRules) ([]domain.TestSuite, []domain.Test) {
 	var suites []domain.TestSuite
 	var tests []domain.Test
 
@@ -164,12 +265,12 @@ func parseTestModule(root *sitter.Node, source []byte, filename string) ([]domai
 
 		switch child.Type() {
 		case pyast.NodeFunctionDefinition:
-			if test := parseTestFunction(child, source, filename); test != nil {
+			if test := parseTestFunction(child, source, filename, rules); test != nil {
 				tests = append(tests, *test)
 			}
 
 		case pyast.NodeClassDefinition:
-			if suite := parseTestClass(child, source, filename); suite != nil {
+			if suite := parseTestClass(child, source, filename, rules); suite != nil {
 				suites = append(suites, *suite)
 			}
 
@@ -185,11 +286,12 @@ func parseTestModule(root *sitter.Node, source []byte, filename string) ([]domai
 
 			switch definition.Type() {
 			case pyast.NodeFunctionDefinition:
-				if test := parseTestFunctionWithStatus(definition, source, filename, status, modifier); test != nil {
+				if test := parseTestFunctionWithStatus(definition, source, filename, rules, status, modifier); test != nil {
+					test.Kind = getKindFromDecorators(decorators, source)
 					tests = append(tests, *test)
 				}
 			case pyast.NodeClassDefinition:
-				if suite := parseTestClassWithStatus(definition, source, filename, status, modifier); suite != nil {
+				if suite := parseTestClassWithStatus(definition, source, filename, rules, status, modifier); suite != nil {
 					suites = append(suites, *suite)
 				}
 			}
@@ -199,18 +301,18 @@ func parseTestModule(root *sitter.Node, source []byte, filename string) ([]domai
 	return suites, tests
 }
 
-func parseTestFunction(node *sitter.Node, source []byte, filename string) *domain.Test {
-	return parseTestFunctionWithStatus(node, source, filename, domain.TestStatusActive, "")
+func parseTestFunction(node *sitter.Node, source []byte, filename string, rules collectionRules) *domain.Test {
+	return parseTestFunctionWithStatus(node, source, filename, rules, domain.TestStatusActive, "")
 }
 
-func parseTestFunctionWithStatus(node *sitter.Node, source []byte, filename string, status domain.TestStatus, modifier string) *domain.Test {
+func parseTestFunctionWithStatus(node *sitter.Node, source []byte, filename string, rules collectionRules, status domain.TestStatus, modifier string) *domain.Test {
 	nameNode := node.ChildByFieldName("name")
 	if nameNode == nil {
 		return nil
 	}
 
 	name := parser.GetNodeText(nameNode, source)
-	if !isTestFunction(name) {
+	if !rules.isTestFunction(name) {
 		return nil
 	}
 
@@ -222,18 +324,18 @@ func parseTestFunctionWithStatus(node *sitter.Node, source []byte, filename stri
 	}
 }
 
-func parseTestClass(node *sitter.Node, source []byte, filename string) *domain.TestSuite {
-	return parseTestClassWithStatus(node, source, filename, domain.TestStatusActive, "")
+func parseTestClass(node *sitter.Node, source []byte, filename string, rules collectionRules) *domain.TestSuite {
+	return parseTestClassWithStatus(node, source, filename, rules, domain.TestStatusActive, "")
 }
 
-func parseTestClassWithStatus(node *sitter.Node, source []byte, filename string, classStatus domain.TestStatus, classModifier string) *domain.TestSuite {
+func parseTestClassWithStatus(node *sitter.Node, source []byte, filename string, rules collectionRules, classStatus domain.TestStatus, classModifier string) *domain.TestSuite {
 	nameNode := node.ChildByFieldName("name")
 	if nameNode == nil {
 		return nil
 	}
 
 	name := parser.GetNodeText(nameNode, source)
-	if !isTestClass(name) {
+	if !rules.isTestClass(name) {
 		return nil
 	}
 
@@ -248,7 +350,7 @@ func parseTestClassWithStatus(node *sitter.Node, source []byte, filename string,
 
 		switch child.Type() {
 		case pyast.NodeFunctionDefinition:
-			if test := parseTestFunction(child, source, filename); test != nil {
+			if test := parseTestFunction(child, source, filename, rules); test != nil {
 				// Inherit class status/modifier if method has default (active) status
 				if test.Status == domain.TestStatusActive && classStatus != domain.TestStatusActive {
 					test.Status = classStatus
@@ -271,7 +373,8 @@ func parseTestClassWithStatus(node *sitter.Node, source []byte, filename string,
 				modifier = classModifier
 			}
 
-			if test := parseTestFunctionWithStatus(definition, source, filename, status, modifier); test != nil {
+			if test := parseTestFunctionWithStatus(definition, source, filename, rules, status, modifier); test != nil {
+				test.Kind = getKindFromDecorators(decorators, source)
 				tests = append(tests, *test)
 			}
 		}
@@ -304,11 +407,15 @@ func getStatusAndModifierFromDecorators(decorators []*sitter.Node, source []byte
 	return domain.TestStatusActive, ""
 }
 
-func isTestFunction(name string) bool {
-	// pytest convention: test_* is the standard pattern
-	return strings.HasPrefix(name, "test_")
-}
+// hypothesisGivenPattern matches Hypothesis's @given(...) and @hypothesis.given(...).
+var hypothesisGivenPattern = regexp.MustCompile(`^@\s*(?:hypothesis\.)?given\s*\(`)
 
-func isTestClass(name string) bool {
-	return strings.HasPrefix(name, "Test")
+// getKindFromDecorators marks Hypothesis @given tests as property-based.
+func getKindFromDecorators(decorators []*sitter.Node, source []byte) domain.TestKind {
+	for _, dec := range decorators {
+		if hypothesisGivenPattern.MatchString(parser.GetNodeText(dec, source)) {
+			return domain.TestKindProperty
+		}
+	}
+	return ""
 }
@@ -429,4 +429,396 @@ def test_another():
 			t.Errorf("expected Suites[0].Name='TestGroup', got '%s'", testFile.Suites[0].Name)
 		}
 	})
+	t.Run("hypothesis given is property-based", func(t *testing.T) {
+		source := `
+from hypothesis import given, strategies as st
+
+@given(st.integers())
+def test_abs_non_negative(x):
+    assert abs(x) >= 0
+
+class TestSort:
+    @hypothesis.given(st.lists(st.integers()))
+    def test_idempotent(self, xs):
+        assert sorted(sorted(xs)) == sorted(xs)
+
+@pytest.mark.parametrize("x", [1])
+def test_example(x):
+    pass
+`
+		testFile, err := p.Parse(ctx, []byte(source), "test_props.py")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(testFile.Tests) != 2 || len(testFile.Suites) != 1 {
+			t.Fatalf("expected 2 Tests and 1 Suite, got %d and %d", len(testFile.Tests), len(testFile.Suites))
+		}
+		if testFile.Tests[0].Kind != domain.TestKindProperty {
+			t.Errorf("expected Tests[0].Kind=property, got '%s'", testFile.Tests[0].Kind)
+		}
+		if testFile.Suites[0].Tests[0].Kind != domain.TestKindProperty {
+			t.Errorf("expected method Kind=property, got '%s'", testFile.Suites[0].Tests[0].Kind)
+		}
+		if testFile.Tests[1].Kind != "" {
+			t.Errorf("expected Tests[1].Kind='', got '%s'", testFile.Tests[1].Kind)
+		}
+	})
+}
+
+func TestPytestParser_ParseWithScope(t *testing.T) {
+	p := &PytestParser{}
+	ctx := context.Background()
+
+	source := `"""Money helpers.
+
+>>> Money(1) + Money(2)
+Money(3)
+"""
+
+class Money:
+    """A value.
+
+    >>> Money(1).amount
+    1
+    """
+
+    def split(self, n):
+        """
+        >>> Money(4).split(2)
+        [Money(2), Money(2)]
+        """
+
+    def undocumented(self):
+        pass
+
+def test_money():
+    """No examples here."""
+`
+
+	t.Run("doctest modules enabled", func(t *testing.T) {
+		scope := framework.NewConfigScope("/project/pytest.ini", "")
+		scope.Settings[settingDoctestModules] = true
+
+		testFile, err := p.ParseWithScope(ctx, []byte(source), "src/money.py", scope)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expected := []string{"money", "money.Money", "money.Money.split"}
+		if len(testFile.Tests) != len(expected) {
+			t.Fatalf("expected %d Tests, got %d", len(expected), len(testFile.Tests))
+		}
+		for i, name := range expected {
+			if testFile.Tests[i].Name != name {
+				t.Errorf("expected Tests[%d].Name='%s', got '%s'", i, name, testFile.Tests[i].Name)
+			}
+			if testFile.Tests[i].Kind != domain.TestKindDoctest {
+				t.Errorf("expected Tests[%d].Kind=doctest, got '%s'", i, testFile.Tests[i].Kind)
+			}
+		}
+	})
+
+	t.Run("test module keeps test functions", func(t *testing.T) {
+		scope := framework.NewConfigScope("/project/pytest.ini", "")
+		scope.Settings[settingDoctestModules] = true
+
+		testFile, err := p.ParseWithScope(ctx, []byte(source), "test_money.py", scope)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(testFile.Tests) != 4 {
+			t.Errorf("expected 4 Tests, got %d", len(testFile.Tests))
+		}
+	})
+
+	t.Run("doctest modules disabled", func(t *testing.T) {
+		scope := framework.NewConfigScope("/project/pytest.ini", "")
+
+		testFile, err := p.ParseWithScope(ctx, []byte(source), "test_money.py", scope)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(testFile.Tests) != 1 || testFile.Tests[0].Name != "test_money" {
+			t.Errorf("expected only test_money, got %+v", testFile.Tests)
+		}
+	})
+
+	t.Run("doctest text file", func(t *testing.T) {
+		scope := framework.NewConfigScope("/project/pytest.ini", "")
+		text := "Usage\n=====\n\n    >>> 1 + 1\n    2\n"
+
+		testFile, err := p.ParseWithScope(ctx, []byte(text), "docs/usage.rst", scope)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(testFile.Tests) != 1 || testFile.Tests[0].Name != "usage.rst" {
+			t.Fatalf("expected 1 Test named usage.rst, got %+v", testFile.Tests)
+		}
+		if testFile.Tests[0].Kind != domain.TestKindDoctest {
+			t.Errorf("expected Kind=doctest, got '%s'", testFile.Tests[0].Kind)
+		}
+	})
+}
+
+func TestPytestConfigParser_Parse(t *testing.T) {
+	p := &PytestConfigParser{}
+	ctx := context.Background()
+
+	tests := []struct {
+		name            string
+		content         string
+		doctestModules  bool
+		collectPatterns []string
+	}{
+		{
+			name:            "no addopts",
+			content:         "[pytest]\ntestpaths = tests\n",
+			collectPatterns: []string{"**/test*.txt"},
+		},
+		{
+			name:            "doctest modules",
+			content:         "[pytest]\naddopts = -ra --doctest-modules\n",
+			doctestModules:  true,
+			collectPatterns: []string{"**/*.py", "**/test*.txt"},
+		},
+		{
+			name:            "multiline addopts with globs",
+			content:         "[pytest]\naddopts =\n    --doctest-glob='*.rst'\n    --doctest-glob *.md\n",
+			collectPatterns: []string{"**/*.rst", "**/*.md"},
+		},
+		{
+			name:            "other section ignored",
+			content:         "[coverage]\naddopts = --doctest-modules\n",
+			collectPatterns: []string{"**/test*.txt"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope, err := p.Parse(ctx, "/project/pytest.ini", []byte(tt.content))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			doctestModules, _ := scope.Settings[settingDoctestModules].(bool)
+			if doctestModules != tt.doctestModules {
+				t.Errorf("expected doctestModules=%v, got %v", tt.doctestModules, doctestModules)
+			}
+			if len(scope.CollectPatterns) != len(tt.collectPatterns) {
+				t.Fatalf("expected CollectPatterns=%v, got %v", tt.collectPatterns, scope.CollectPatterns)
+			}
+			for i, pattern := range tt.collectPatterns {
+				if scope.CollectPatterns[i] != pattern {
+					t.Errorf("expected CollectPatterns[%d]=%s, got %s", i, pattern, scope.CollectPatterns[i])
+				}
+			}
+		})
+	}
+}
+
+func TestPytestConfigParser_CollectionOptions(t *testing.T) {
+	p := &PytestConfigParser{}
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		configPath string
+		content    string
+	}{
+		{
+			name:       "pytest.ini",
+			configPath: "/project/pytest.ini",
+			content: `[pytest]
+testpaths = checks integration
+python_files = check_*.py
+python_classes = Check *Suite
+python_functions = check_
+norecursedirs = fixtures
+markers =
+    slow: marks tests as slow
+    serial(reason): run alone
+`,
+		},
+		{
+			name:       "tox.ini",
+			configPath: "/project/tox.ini",
+			content: `[tox]
+envlist = py312
+
+[pytest]
+testpaths =
+    checks
+    integration
+python_files = check_*.py
+python_classes = Check *Suite
+python_functions = check_
+norecursedirs = fixtures
+markers =
+    slow: marks tests as slow
+    serial(reason): run alone
+`,
+		},
+		{
+			name:       "setup.cfg",
+			configPath: "/project/setup.cfg",
+			content: `[metadata]
+name = money
+
+[tool:pytest]
+testpaths = checks integration
+python_files = check_*.py
+python_classes = Check *Suite
+python_functions = check_
+norecursedirs = fixtures
+markers =
+    slow: marks tests as slow
+    serial(reason): run alone
+`,
+		},
+		{
+			name:       "pyproject.toml",
+			configPath: "/project/pyproject.toml",
+			content: `[project]
+name = "money"
+
+[tool.pytest.ini_options]
+testpaths = [
+    "checks",  # unit
+    "integration",
+]
+python_files = "check_*.py"
+python_classes = ["Check", "*Suite"]
+python_functions = ['check_']
+norecursedirs = ["fixtures"]
+markers = [
+    "slow: marks tests as slow",
+    "serial(reason): run alone",
+]
+
+[tool.coverage.run]
+python_files = "ignored_*.py"
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope, err := p.Parse(ctx, tt.configPath, []byte(tt.content))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if scope == nil {
+				t.Fatal("expected scope, got nil")
+			}
+
+			assertStrings(t, "Roots", scope.Roots, []string{"/project/checks", "/project/integration"})
+			assertStrings(t, "Exclude", scope.Exclude, []string{"**/fixtures/**"})
+			assertStrings(t, "CollectPatterns", scope.CollectPatterns, []string{"**/test*.txt", "**/check_*.py"})
+			assertStrings(t, "python_files", scope.Settings[settingPythonFiles].([]string), []string{"check_*.py"})
+			assertStrings(t, "python_classes", scope.Settings[settingPythonClasses].([]string), []string{"Check", "*Suite"})
+			assertStrings(t, "python_functions", scope.Settings[settingPythonFunctions].([]string), []string{"check_"})
+			assertStrings(t, "markers", scope.Settings[settingMarkers].([]string), []string{"slow", "serial"})
+			if scope.Passive {
+				t.Error("expected an active scope")
+			}
+		})
+	}
+
+	t.Run("shared files without pytest section are declined", func(t *testing.T) {
+		for configPath, content := range map[string]string{
+			"/project/tox.ini":        "[tox]\nenvlist = py312\n",
+			"/project/setup.cfg":      "[metadata]\nname = money\n",
+			"/project/pyproject.toml": "[tool.black]\nline-length = 88\n",
+		} {
+			scope, err := p.Parse(ctx, configPath, []byte(content))
+			if err != nil || scope != nil {
+				t.Errorf("%s: expected nil scope, got %+v (err %v)", configPath, scope, err)
+			}
+		}
+	})
+
+	t.Run("conftest is passive", func(t *testing.T) {
+		scope, err := p.Parse(ctx, "/project/tests/conftest.py", []byte("import pytest\n"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !scope.Passive || scope.BaseDir != "/project/tests" {
+			t.Errorf("expected passive scope at /project/tests, got %+v", scope)
+		}
+	})
+}
+
+func TestPytestParser_ParseWithScopeRules(t *testing.T) {
+	p := &PytestParser{}
+	ctx := context.Background()
+
+	scope := framework.NewConfigScope("/project/pytest.ini", "")
+	scope.Settings[settingPythonFiles] = []string{"check_*.py"}
+	scope.Settings[settingPythonClasses] = []string{"Check", "*Suite"}
+	scope.Settings[settingPythonFunctions] = []string{"check_", "*_should_*"}
+
+	source := `class CheckMoney:
+    def check_add(self):
+        pass
+
+    def test_ignored(self):
+        pass
+
+
+class MoneySuite:
+    def split_should_round(self):
+        pass
+
+
+class TestIgnored:
+    def check_ignored(self):
+        pass
+
+
+def check_split():
+    pass
+
+
+def test_ignored():
+    pass
+`
+
+	testFile, err := p.ParseWithScope(ctx, []byte(source), "checks/check_money.py", scope)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(testFile.Suites) != 2 || testFile.Suites[0].Name != "CheckMoney" || testFile.Suites[1].Name != "MoneySuite" {
+		t.Fatalf("expected suites CheckMoney and MoneySuite, got %+v", testFile.Suites)
+	}
+	if len(testFile.Suites[0].Tests) != 1 || testFile.Suites[0].Tests[0].Name != "check_add" {
+		t.Errorf("expected only check_add in CheckMoney, got %+v", testFile.Suites[0].Tests)
+	}
+	if len(testFile.Tests) != 1 || testFile.Tests[0].Name != "check_split" {
+		t.Errorf("expected only check_split, got %+v", testFile.Tests)
+	}
+
+	t.Run("module outside python_files", func(t *testing.T) {
+		testFile, err := p.ParseWithScope(ctx, []byte(source), "checks/test_money.py", scope)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if testFile != nil {
+			t.Errorf("expected nil file, got %+v", testFile)
+		}
+	})
+}
+
+func assertStrings(t *testing.T, name string, got, want []string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Errorf("expected %s=%v, got %v", name, want, got)
+		return
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("expected %s=%v, got %v", name, want, got)
+			return
+		}
+	}
 }
@@ -0,0 +1,132 @@
+package pytest
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+	"github.com/specvital/core/pkg/parser/strategies/shared/pyast"
+)
+
+// Python AST node types for docstrings.
+const (
+	nodeComment             = "comment"
+	nodeExpressionStatement = "expression_statement"
+	nodeString              = "string"
+)
+
+const doctestPrompt = ">>>"
+
+// parseDoctests returns one test per docstring holding >>> examples, as collected by
+// --doctest-modules: the module, its classes, functions and methods. Tests are named by
+// their dotted path from the module, like pytest's doctest items.
+func parseDoctests(root *sitter.Node, source []byte, filename string) []domain.Test {
+	module := strings.TrimSuffix(filepath.Base(filename), ".py")
+
+	var tests []domain.Test
+	if hasExamples(docstring(root, source)) {
+		tests = append(tests, doctest(module, root, filename))
+	}
+	collectDoctests(root, source, filename, module, &tests, 0)
+	return tests
+}
+
+func collectDoctests(body *sitter.Node, source []byte, filename, prefix string, tests *[]domain.Test, depth int) {
+	if depth > parser.MaxTreeDepth {
+		return
+	}
+
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		definition := body.NamedChild(i)
+		if definition.Type() == pyast.NodeDecoratedDefinition {
+			definition = pyast.GetDecoratedDefinition(definition)
+		}
+		if definition == nil {
+			continue
+		}
+		if definition.Type() != pyast.NodeFunctionDefinition && definition.Type() != pyast.NodeClassDefinition {
+			continue
+		}
+
+		nameNode := definition.ChildByFieldName("name")
+		block := definition.ChildByFieldName("body")
+		if nameNode == nil || block == nil {
+			continue
+		}
+
+		name := prefix + "." + parser.GetNodeText(nameNode, source)
+		if hasExamples(docstring(block, source)) {
+			*tests = append(*tests, doctest(name, definition, filename))
+		}
+		// The doctest finder descends into classes but not into function bodies.
+		if definition.Type() == pyast.NodeClassDefinition {
+			collectDoctests(block, source, filename, name, tests, depth+1)
+		}
+	}
+}
+
+// docstring returns the string literal opening a module or definition body.
+func docstring(body *sitter.Node, source []byte) []byte {
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		first := body.NamedChild(i)
+		if first.Type() == nodeComment {
+			continue
+		}
+		if first.Type() != nodeExpressionStatement || first.NamedChildCount() == 0 {
+			return nil
+		}
+		if str := first.NamedChild(0); str.Type() == nodeString {
+			return []byte(parser.GetNodeText(str, source))
+		}
+		return nil
+	}
+	return nil
+}
+
+func doctest(name string, node *sitter.Node, filename string) domain.Test {
+	return domain.Test{
+		Name:     name,
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(node, filename),
+		Kind:     domain.TestKindDoctest,
+	}
+}
+
+// parseDoctestTextFile returns the single test pytest collects from a --doctest-glob
+// text file, or nil when it holds no examples.
+func parseDoctestTextFile(source []byte, filename string) *domain.TestFile {
+	file := &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguagePython,
+		Framework: frameworkName,
+	}
+	if !hasExamples(source) {
+		return file
+	}
+
+	file.Tests = []domain.Test{{
+		Name:   filepath.Base(filename),
+		Status: domain.TestStatusActive,
+		Location: domain.Location{
+			File:      filename,
+			StartLine: 1,
+			EndLine:   bytes.Count(source, []byte("\n")) + 1,
+		},
+		Kind: domain.TestKindDoctest,
+	}}
+	return file
+}
+
+// hasExamples reports whether text contains an interactive example line.
+func hasExamples(text []byte) bool {
+	for _, line := range bytes.Split(text, []byte("\n")) {
+		if bytes.HasPrefix(bytes.TrimSpace(line), []byte(doctestPrompt)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,148 @@
+package pytest
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+)
+
+// tomlHasTable reports whether content declares the [table] header.
+func tomlHasTable(content []byte, table string) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		if name, ok := tomlTableName(scanner.Text()); ok && name == table {
+			return true
+		}
+	}
+	return false
+}
+
+// tomlTableName returns the name of a [table] or [[array]] header line.
+func tomlTableName(line string) (string, bool) {
+	line = strings.TrimSpace(line)
+	if i := strings.Index(line, "#"); i >= 0 && !strings.ContainsAny(line[:i], `"'`) {
+		line = strings.TrimSpace(line[:i])
+	}
+	if !strings.HasPrefix(line, "[") || !strings.HasSuffix(line, "]") {
+		return "", false
+	}
+	name := strings.Trim(line, "[]")
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		parts[i] = strings.Trim(strings.TrimSpace(part), `"'`)
+	}
+	return strings.Join(parts, "."), true
+}
+
+// tomlValues returns the value of key in table: the strings of an array (which may
+// span lines), a single string, or the raw text of any other scalar. This covers
+// the shapes pytest options take in pyproject.toml without a full TOML parser.
+func tomlValues(content []byte, table, key string) ([]string, bool) {
+	lines := strings.Split(string(content), "\n")
+	inTable := false
+
+	for i := 0; i < len(lines); i++ {
+		if name, ok := tomlTableName(lines[i]); ok {
+			inTable = name == table
+			continue
+		}
+		if !inTable {
+			continue
+		}
+
+		name, value, ok := strings.Cut(lines[i], "=")
+		if !ok || strings.Trim(strings.TrimSpace(name), `"'`) != key {
+			continue
+		}
+		value = strings.TrimSpace(value)
+
+		if !strings.HasPrefix(value, "[") && !strings.HasPrefix(value, `"`) && !strings.HasPrefix(value, "'") {
+			if j := strings.Index(value, "#"); j >= 0 {
+				value = strings.TrimSpace(value[:j])
+			}
+			return []string{value}, true
+		}
+
+		// Arrays and multi-line strings may continue on the following lines.
+		rest := value
+		for j := i + 1; j < len(lines); j++ {
+			rest += "\n" + lines[j]
+		}
+		return tomlStrings(rest), true
+	}
+
+	return nil, false
+}
+
+// tomlStrings reads the strings of the array or string literal at the start of s,
+// skipping comments and stopping at the end of the value.
+func tomlStrings(s string) []string {
+	var (
+		result []string
+		depth  int
+	)
+
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+			if depth <= 0 {
+				return result
+			}
+		case c == '#':
+			for i < len(s) && s[i] != '\n' {
+				i++
+			}
+		case c == '"' || c == '\'':
+			value, end := tomlString(s, i)
+			result = append(result, value)
+			i = end
+			if depth == 0 {
+				return result
+			}
+		case c == '\n' && depth == 0:
+			return result
+		}
+	}
+	return result
+}
+
+// tomlString reads the basic, literal or multi-line string starting at s[start]
+// and returns its value and the index of its closing quote.
+func tomlString(s string, start int) (string, int) {
+	quote := s[start : start+1]
+	if strings.HasPrefix(s[start:], strings.Repeat(quote, 3)) {
+		body := s[start+3:]
+		end := strings.Index(body, strings.Repeat(quote, 3))
+		if end < 0 {
+			return strings.TrimPrefix(body, "\n"), len(s)
+		}
+		return strings.TrimPrefix(body[:end], "\n"), start + 3 + end + 2
+	}
+
+	var sb strings.Builder
+	for i := start + 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == quote[0]:
+			return sb.String(), i
+		case c == '\\' && quote == `"` && i+1 < len(s):
+			i++
+			switch s[i] {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			default:
+				sb.WriteByte(s[i])
+			}
+		case c == '\n':
+			return sb.String(), i
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String(), len(s)
+}
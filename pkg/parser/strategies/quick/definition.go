@@ -0,0 +1,332 @@
+// Package quick implements Quick BDD framework support for Swift test files.
+package quick
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+	"github.com/specvital/core/pkg/parser/framework"
+	"github.com/specvital/core/pkg/parser/framework/matchers"
+	"github.com/specvital/core/pkg/parser/strategies/shared/swiftast"
+)
+
+const frameworkName = framework.FrameworkQuick
+
+const (
+	confidenceFileName = 20
+	confidenceContent  = 40
+)
+
+// Quick spec base classes and the class method holding the examples.
+const (
+	baseQuickSpec = "QuickSpec"
+	baseAsyncSpec = "AsyncSpec"
+	specFunction  = "spec"
+)
+
+// Quick DSL functions. x- and f-prefixed variants are disabled and focused.
+const (
+	funcDescribe      = "describe"
+	funcContext       = "context"
+	funcIt            = "it"
+	funcItBehavesLike = "itBehavesLike"
+	funcPending       = "pending"
+	prefixDisabled    = "x"
+	prefixFocused     = "f"
+)
+
+var hookFunctions = map[string]bool{
+	"beforeSuite": true, "afterSuite": true,
+	"beforeEach": true, "afterEach": true, "justBeforeEach": true, "aroundEach": true,
+}
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageSwift},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher("Quick"),
+			&QuickFileMatcher{},
+			&QuickContentMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &QuickParser{},
+		Priority:     framework.PrioritySpecialized,
+	}
+}
+
+// QuickFileMatcher matches *Spec.swift files.
+type QuickFileMatcher struct{}
+
+func (m *QuickFileMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileName {
+		return framework.NoMatch()
+	}
+
+	name := strings.TrimSuffix(signal.Value, ".swift")
+	if name != signal.Value && (strings.HasSuffix(name, "Spec") || strings.HasSuffix(name, "Specs")) {
+		return framework.PartialMatch(confidenceFileName, "Quick file naming convention")
+	}
+
+	return framework.NoMatch()
+}
+
+// QuickContentMatcher matches Quick-specific patterns.
+type QuickContentMatcher struct{}
+
+var quickPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`(?m)^\s*import\s+Quick\b`), "Quick import"},
+	{regexp.MustCompile(`:\s*(?:QuickSpec|AsyncSpec)\b`), "QuickSpec/AsyncSpec subclass"},
+	{regexp.MustCompile(`override\s+(?:class\s+)?func\s+spec\(\)`), "spec() override"},
+}
+
+func (m *QuickContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range quickPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(confidenceContent, "Found Quick pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+// QuickParser extracts test definitions from Quick spec files.
+type QuickParser struct{}
+
+func (p *QuickParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageSwift, source)
+	if err != nil {
+		return nil, fmt.Errorf("quick parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	var suites []domain.TestSuite
+	parser.WalkTree(tree.RootNode(), func(node *sitter.Node) bool {
+		if node.Type() == swiftast.NodeClassDeclaration {
+			if suite := parseSpecClass(node, source, filename); suite != nil {
+				suites = append(suites, *suite)
+			}
+			return false
+		}
+		return true
+	})
+
+	return &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageSwift,
+		Framework: frameworkName,
+		Suites:    suites,
+	}, nil
+}
+
+// parseSpecClass returns a suite for a QuickSpec or AsyncSpec subclass holding the
+// examples declared in its spec() method.
+func parseSpecClass(node *sitter.Node, source []byte, filename string) *domain.TestSuite {
+	if !isSpecClass(node, source) {
+		return nil
+	}
+	body := swiftast.GetClassBody(node)
+	if body == nil {
+		return nil
+	}
+
+	suite := &domain.TestSuite{
+		Name:     swiftast.GetClassName(node, source),
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(node, filename),
+	}
+
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		fn := body.NamedChild(i)
+		if fn.Type() != swiftast.NodeFunctionDeclaration || swiftast.GetFunctionName(fn, source) != specFunction {
+			continue
+		}
+		if fnBody := fn.ChildByFieldName("body"); fnBody != nil {
+			w := &walker{source: source, filename: filename}
+			w.walk(fnBody, suite, 0)
+		}
+	}
+
+	if len(suite.Tests) == 0 && len(suite.Suites) == 0 {
+		return nil
+	}
+	return suite
+}
+
+// isSpecClass reports whether a class inherits from QuickSpec or AsyncSpec, directly or
+// through a project base class named *Spec.
+func isSpecClass(node *sitter.Node, source []byte) bool {
+	for _, super := range swiftast.GetSuperTypes(node, source) {
+		if super == baseQuickSpec || super == baseAsyncSpec || strings.HasSuffix(super, "Spec") {
+			return true
+		}
+	}
+	return false
+}
+
+type walker struct {
+	source   []byte
+	filename string
+}
+
+func (w *walker) walk(node *sitter.Node, parent *domain.TestSuite, depth int) {
+	if depth > parser.MaxTreeDepth {
+		return
+	}
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() != swiftast.NodeCallExpression {
+			w.walk(child, parent, depth+1)
+			continue
+		}
+
+		name := w.callName(child)
+		base, status, modifier := dslFunction(name)
+		switch base {
+		case funcDescribe, funcContext:
+			w.suite(child, parent, status, modifier, depth)
+		case funcIt, funcItBehavesLike:
+			w.test(child, parent, status, modifier)
+		case funcPending:
+			w.test(child, parent, domain.TestStatusTodo, funcPending)
+		default:
+			if !hookFunctions[name] {
+				w.walk(child, parent, depth+1)
+			}
+		}
+	}
+}
+
+func (w *walker) suite(node *sitter.Node, parent *domain.TestSuite, status domain.TestStatus, modifier string, depth int) {
+	suite := domain.TestSuite{
+		Name:     w.description(node),
+		Status:   status,
+		Modifier: modifier,
+		Location: parser.GetLocation(node, w.filename),
+	}
+	if closure := closure(node); closure != nil {
+		w.walk(closure, &suite, depth+1)
+	}
+	parent.Suites = append(parent.Suites, suite)
+}
+
+// test adds an example. itBehavesLike runs shared examples that are only known at
+// runtime, so it is counted as a single test.
+func (w *walker) test(node *sitter.Node, parent *domain.TestSuite, status domain.TestStatus, modifier string) {
+	parent.Tests = append(parent.Tests, domain.Test{
+		Name:     w.description(node),
+		Status:   status,
+		Modifier: modifier,
+		Location: parser.GetLocation(node, w.filename),
+	})
+}
+
+// dslFunction maps a Quick function to its base function, status and modifier:
+// xit is a disabled it, fdescribe a focused describe.
+func dslFunction(name string) (string, domain.TestStatus, string) {
+	switch name {
+	case funcDescribe, funcContext, funcIt, funcItBehavesLike, funcPending:
+		return name, domain.TestStatusActive, ""
+	}
+	if base, ok := strings.CutPrefix(name, prefixDisabled); ok && isPrefixable(base) {
+		return base, domain.TestStatusSkipped, name
+	}
+	if base, ok := strings.CutPrefix(name, prefixFocused); ok && isPrefixable(base) {
+		return base, domain.TestStatusFocused, name
+	}
+	return "", domain.TestStatusActive, ""
+}
+
+func isPrefixable(name string) bool {
+	switch name {
+	case funcDescribe, funcContext, funcIt, funcItBehavesLike:
+		return true
+	}
+	return false
+}
+
+// callName returns the callee of a plain call such as describe(...).
+func (w *walker) callName(call *sitter.Node) string {
+	if call.NamedChildCount() == 0 {
+		return ""
+	}
+	callee := call.NamedChild(0)
+	if callee.Type() != swiftast.NodeIdentifier {
+		return ""
+	}
+	return callee.Content(w.source)
+}
+
+// description returns the first argument: the string text or, for interpolated and
+// computed descriptions, the expression as written.
+func (w *walker) description(call *sitter.Node) string {
+	args := arguments(call)
+	if len(args) == 0 {
+		return ""
+	}
+	value := args[0].ChildByFieldName("value")
+	if value == nil {
+		return ""
+	}
+	if value.Type() == swiftast.NodeLineStringLiteral {
+		text := value.Content(w.source)
+		return strings.TrimSuffix(strings.TrimPrefix(text, `"`), `"`)
+	}
+	return value.Content(w.source)
+}
+
+func callSuffix(call *sitter.Node) *sitter.Node {
+	return parser.FindChildByType(call, swiftast.NodeCallSuffix)
+}
+
+func arguments(call *sitter.Node) []*sitter.Node {
+	suffix := callSuffix(call)
+	if suffix == nil {
+		return nil
+	}
+	args := parser.FindChildByType(suffix, swiftast.NodeValueArguments)
+	if args == nil {
+		return nil
+	}
+	return parser.FindChildrenByType(args, swiftast.NodeValueArgument)
+}
+
+// closure returns the example closure: trailing describe("x") { } or passed as an argument.
+func closure(call *sitter.Node) *sitter.Node {
+	suffix := callSuffix(call)
+	if suffix == nil {
+		return nil
+	}
+	if lambda := parser.FindChildByType(suffix, swiftast.NodeLambdaLiteral); lambda != nil {
+		return lambda
+	}
+	for _, arg := range arguments(call) {
+		if value := arg.ChildByFieldName("value"); value != nil && value.Type() == swiftast.NodeLambdaLiteral {
+			return value
+		}
+	}
+	return nil
+}
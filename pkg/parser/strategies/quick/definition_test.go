@@ -0,0 +1,201 @@
+package quick
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+func TestQuickParser_Parse(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		checkFunc func(t *testing.T, file *domain.TestFile)
+	}{
+		{
+			name: "nested describe and context",
+			source: `import Quick
+import Nimble
+
+final class DolphinSpec: QuickSpec {
+    override class func spec() {
+        describe("a dolphin") {
+            beforeEach {
+                it("not an example") { }
+            }
+
+            it("is friendly") {
+                expect(1).to(equal(1))
+            }
+
+            context("when clicked") {
+                it("emits a click") { }
+                it("is loud") { }
+            }
+        }
+    }
+
+    func helper() {
+        it("not in spec") { }
+    }
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 || file.Suites[0].Name != "DolphinSpec" {
+					t.Fatalf("expected DolphinSpec suite, got %+v", file.Suites)
+				}
+				spec := file.Suites[0]
+				if len(spec.Suites) != 1 || spec.Suites[0].Name != "a dolphin" {
+					t.Fatalf("expected describe suite, got %+v", spec.Suites)
+				}
+				dolphin := spec.Suites[0]
+				if len(dolphin.Tests) != 1 || dolphin.Tests[0].Name != "is friendly" {
+					t.Errorf("expected is friendly, got %+v", dolphin.Tests)
+				}
+				if dolphin.Tests[0].Location.StartLine != 11 {
+					t.Errorf("expected is friendly at line 11, got %d", dolphin.Tests[0].Location.StartLine)
+				}
+				if len(dolphin.Suites) != 1 || len(dolphin.Suites[0].Tests) != 2 {
+					t.Fatalf("expected context with 2 examples, got %+v", dolphin.Suites)
+				}
+				if file.CountTests() != 3 {
+					t.Errorf("expected 3 tests, got %d", file.CountTests())
+				}
+			},
+		},
+		{
+			name: "focused, disabled and pending",
+			source: `import Quick
+
+class StatusSpec: QuickSpec {
+    override class func spec() {
+        xit("is disabled") { }
+        fit("is focused", file: #file) { }
+        pending("is pending") { }
+        xdescribe("disabled group") {
+            it("inside") { }
+        }
+        fcontext("focused group") {
+            it("inside") { }
+        }
+        itBehavesLike("a mammal")
+    }
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				spec := file.Suites[0]
+				want := []struct {
+					name     string
+					status   domain.TestStatus
+					modifier string
+				}{
+					{"is disabled", domain.TestStatusSkipped, "xit"},
+					{"is focused", domain.TestStatusFocused, "fit"},
+					{"is pending", domain.TestStatusTodo, "pending"},
+					{"a mammal", domain.TestStatusActive, ""},
+				}
+				if len(spec.Tests) != len(want) {
+					t.Fatalf("expected %d tests, got %+v", len(want), spec.Tests)
+				}
+				for i, w := range want {
+					got := spec.Tests[i]
+					if got.Name != w.name || got.Status != w.status || got.Modifier != w.modifier {
+						t.Errorf("expected %q %s/%q, got %q %s/%q", w.name, w.status, w.modifier, got.Name, got.Status, got.Modifier)
+					}
+				}
+				if len(spec.Suites) != 2 {
+					t.Fatalf("expected 2 suites, got %d", len(spec.Suites))
+				}
+				if spec.Suites[0].Status != domain.TestStatusSkipped || spec.Suites[0].Modifier != "xdescribe" {
+					t.Errorf("expected xdescribe to be skipped, got %s/%q", spec.Suites[0].Status, spec.Suites[0].Modifier)
+				}
+				if spec.Suites[1].Status != domain.TestStatusFocused || spec.Suites[1].Modifier != "fcontext" {
+					t.Errorf("expected fcontext to be focused, got %s/%q", spec.Suites[1].Status, spec.Suites[1].Modifier)
+				}
+			},
+		},
+		{
+			name: "async spec",
+			source: `import Quick
+import Nimble
+
+final class LoaderSpec: AsyncSpec {
+    override class func spec() {
+        describe("loader") {
+            it("loads") {
+                await expect { try await load() }.toNot(throwError())
+            }
+        }
+    }
+}
+
+final class Helper: NSObject {}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 || file.Suites[0].Name != "LoaderSpec" {
+					t.Fatalf("expected only LoaderSpec, got %+v", file.Suites)
+				}
+				if file.CountTests() != 1 {
+					t.Errorf("expected 1 test, got %d", file.CountTests())
+				}
+			},
+		},
+	}
+
+	parser := &QuickParser{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := parser.Parse(ctx, []byte(tt.source), "DolphinSpec.swift")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if file.Framework != frameworkName {
+				t.Errorf("expected framework %q, got %q", frameworkName, file.Framework)
+			}
+
+			if file.Language != domain.LanguageSwift {
+				t.Errorf("expected language Swift, got %q", file.Language)
+			}
+
+			if tt.checkFunc != nil {
+				tt.checkFunc(t, file)
+			}
+		})
+	}
+}
+
+func TestQuickContentMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantMatch bool
+	}{
+		{"Quick import", "import Quick\nimport Nimble", true},
+		{"QuickSpec subclass", "class FooSpec: QuickSpec {}", true},
+		{"AsyncSpec subclass", "final class FooSpec: AsyncSpec {}", true},
+		{"XCTest case", "import XCTest\n\nclass FooTests: XCTestCase {\n  func testA() {}\n}", false},
+		{"Nimble with XCTest", "import XCTest\nimport Nimble\n\nexpect(1).to(equal(1))", false},
+	}
+
+	matcher := &QuickContentMatcher{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := framework.Signal{
+				Type:    framework.SignalFileContent,
+				Value:   tt.content,
+				Context: []byte(tt.content),
+			}
+			result := matcher.Match(ctx, signal)
+			if (result.Confidence > 0) != tt.wantMatch {
+				t.Errorf("Match() confidence = %v, want match = %v", result.Confidence, tt.wantMatch)
+			}
+		})
+	}
+}
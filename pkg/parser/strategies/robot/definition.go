@@ -0,0 +1,127 @@
+// Package robot implements Robot Framework support for .robot suite files.
+package robot
+
+import (
+	"context"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/framework"
+	"github.com/specvital/core/pkg/parser/framework/matchers"
+)
+
+const frameworkName = framework.FrameworkRobot
+
+const (
+	robotExtension = ".robot"
+	// initFile holds the settings of its directory suite.
+	initFile = "__init__.robot"
+)
+
+// Settings stored on the ConfigScope of an __init__.robot file.
+const (
+	// settingName is the directory suite name set with the Name setting.
+	settingName = "name"
+	// settingTags are the Test Tags (Force Tags) applied to every test below the directory.
+	settingTags = "tags"
+	// settingSuiteSkip is the Skip keyword the Suite Setup runs, skipping every test below
+	// the directory.
+	settingSuiteSkip = "suiteSkip"
+	// settingTestSkip is the Skip keyword the Test Setup runs, skipping the tests below the
+	// directory that no nearer Test Setup or [Setup] overrides.
+	settingTestSkip = "testSkip"
+	// settingLastLine is the last line of the __init__.robot, which locates the directory suite.
+	settingLastLine = "lastLine"
+)
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageRobot},
+		Matchers: []framework.Matcher{
+			matchers.NewConfigMatcher(initFile),
+			&RobotFileMatcher{},
+			&RobotContentMatcher{},
+		},
+		ConfigParser: &RobotConfigParser{},
+		Parser:       &RobotParser{},
+		Priority:     framework.PriorityGeneric,
+	}
+}
+
+// RobotConfigParser reads the suite settings of an __init__.robot file. Its directory
+// is the scope; the Name setting, Test Tags and a Skip run by Suite Setup or Test
+// Setup apply to the suites below it.
+type RobotConfigParser struct{}
+
+func (p *RobotConfigParser) Parse(ctx context.Context, configPath string, content []byte) (*framework.ConfigScope, error) {
+	scope := framework.NewConfigScope(configPath, "")
+	scope.Framework = frameworkName
+
+	doc := parseDocument(content, configPath)
+	settings := doc.settings
+	scope.Settings[settingLastLine] = doc.lastLine
+	if settings.name != "" {
+		scope.Settings[settingName] = settings.name
+	}
+	if len(settings.testTags) > 0 {
+		scope.Settings[settingTags] = settings.testTags
+	}
+	if settings.suiteSkip != "" {
+		scope.Settings[settingSuiteSkip] = settings.suiteSkip
+	}
+	if settings.testSkip != "" {
+		scope.Settings[settingTestSkip] = settings.testSkip
+	}
+	return scope, nil
+}
+
+// RobotFileMatcher matches *.robot files, which only Robot Framework runs.
+type RobotFileMatcher struct{}
+
+func (m *RobotFileMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileName {
+		return framework.NoMatch()
+	}
+
+	if strings.EqualFold(filepath.Ext(signal.Value), robotExtension) {
+		return framework.DefiniteMatch("Robot Framework file extension: *.robot")
+	}
+
+	return framework.NoMatch()
+}
+
+// RobotContentMatcher matches Robot Framework section headers.
+type RobotContentMatcher struct{}
+
+var robotPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`(?im)^\*+\s*(?:test cases?|tasks?)\b`), "*** Test Cases *** section"},
+}
+
+func (m *RobotContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range robotPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found Robot Framework pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
@@ -0,0 +1,360 @@
+package robot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+func TestRobotFileMatcher_Match(t *testing.T) {
+	matcher := &RobotFileMatcher{}
+	ctx := context.Background()
+
+	tests := []struct {
+		filename           string
+		expectedConfidence int
+	}{
+		{"atest/login.robot", 100},
+		{"atest/Login.ROBOT", 100},
+		{"atest/keywords.resource", 0},
+		{"atest/login.txt", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			result := matcher.Match(ctx, framework.Signal{Type: framework.SignalFileName, Value: tt.filename})
+			if result.Confidence != tt.expectedConfidence {
+				t.Errorf("expected Confidence=%d, got %d", tt.expectedConfidence, result.Confidence)
+			}
+		})
+	}
+}
+
+func TestRobotConfigParser_Parse(t *testing.T) {
+	source := `*** Settings ***
+Name          Acceptance
+Test Tags     smoke
+...           robot:skip
+Suite Setup   Open Browser
+`
+	scope, err := (&RobotConfigParser{}).Parse(context.Background(), "/project/atest/__init__.robot", []byte(source))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if scope.BaseDir != "/project/atest" {
+		t.Errorf("expected BaseDir=/project/atest, got %s", scope.BaseDir)
+	}
+	if name, _ := scope.Settings[settingName].(string); name != "Acceptance" {
+		t.Errorf("expected name=Acceptance, got %q", name)
+	}
+	tags, _ := scope.Settings[settingTags].([]string)
+	if len(tags) != 2 || tags[0] != "smoke" || tags[1] != "robot:skip" {
+		t.Errorf("expected tags=[smoke robot:skip], got %v", tags)
+	}
+	if _, ok := scope.Settings[settingSuiteSkip]; ok {
+		t.Errorf("expected no suite skip for Open Browser, got %v", scope.Settings[settingSuiteSkip])
+	}
+	if lastLine, _ := scope.Settings[settingLastLine].(int); lastLine != 5 {
+		t.Errorf("expected lastLine=5, got %d", lastLine)
+	}
+}
+
+func TestRobotParser_Parse(t *testing.T) {
+	p := &RobotParser{}
+	ctx := context.Background()
+
+	t.Run("test cases with tags, skip and templates", func(t *testing.T) {
+		source := `*** Settings ***
+Documentation     Login tests.
+Test Tags         ui
+Default Tags      regression
+
+*** Variables ***
+${URL}    http://localhost
+
+*** Test Cases ***
+Valid Login
+    [Tags]    smoke
+    Open Login Page
+    Submit Credentials    demo    mode
+
+Not Ready
+    [Tags]    robot:skip
+    Open Login Page
+
+Excluded
+    [Tags]
+    ...    robot:exclude    -ui
+    No Operation
+
+Skipped In Body
+    Skip    Waiting for backend
+
+Conditional Skip
+    Skip If    ${CI}    flaky on CI
+    IF    ${True}
+        Skip
+    END
+    FOR    ${i}    IN RANGE    3
+        Log    ${i}
+    END
+
+Invalid Passwords
+    [Template]    Login Should Fail
+    demo    wrong
+    demo    ${EMPTY}
+    Skip    this is data
+
+# Commented Out
+*** Keywords ***
+Open Login Page
+    Go To    ${URL}
+`
+		file, err := p.Parse(ctx, []byte(source), "atest/01__web_login.robot")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if file.Language != domain.LanguageRobot || file.Framework != frameworkName {
+			t.Errorf("expected robot/%s, got %s/%s", frameworkName, file.Language, file.Framework)
+		}
+		if len(file.Suites) != 1 || file.Suites[0].Name != "Atest" {
+			t.Fatalf("expected directory suite Atest, got %+v", file.Suites)
+		}
+		suite := file.Suites[0].Suites[0]
+		if suite.Name != "Web Login" {
+			t.Errorf("expected file suite 'Web Login', got %q", suite.Name)
+		}
+
+		expected := []struct {
+			name     string
+			status   domain.TestStatus
+			modifier string
+			tags     []string
+		}{
+			{"Valid Login", domain.TestStatusActive, "", []string{"ui", "smoke"}},
+			{"Not Ready", domain.TestStatusSkipped, "robot:skip", []string{"ui", "robot:skip"}},
+			{"Excluded", domain.TestStatusSkipped, "robot:exclude", []string{"robot:exclude"}},
+			{"Skipped In Body", domain.TestStatusSkipped, "Skip", []string{"ui", "regression"}},
+			{"Conditional Skip", domain.TestStatusActive, "", []string{"ui", "regression"}},
+			{"Invalid Passwords", domain.TestStatusActive, "", []string{"ui", "regression"}},
+		}
+		if len(suite.Tests) != len(expected) {
+			t.Fatalf("expected %d tests, got %d", len(expected), len(suite.Tests))
+		}
+		for i, want := range expected {
+			got := suite.Tests[i]
+			if got.Name != want.name || got.Status != want.status || got.Modifier != want.modifier {
+				t.Errorf("test %d: expected %s/%s/%s, got %s/%s/%s", i,
+					want.name, want.status, want.modifier, got.Name, got.Status, got.Modifier)
+			}
+			if len(got.Tags) != len(want.tags) {
+				t.Errorf("test %d: expected tags %v, got %v", i, want.tags, got.Tags)
+				continue
+			}
+			for j := range want.tags {
+				if got.Tags[j] != want.tags[j] {
+					t.Errorf("test %d: expected tags %v, got %v", i, want.tags, got.Tags)
+					break
+				}
+			}
+		}
+		if suite.Tests[0].Location.StartLine != 10 || suite.Tests[0].Location.EndLine != 13 {
+			t.Errorf("expected Valid Login at lines 10-13, got %+v", suite.Tests[0].Location)
+		}
+	})
+
+	t.Run("test template setting and pipe format", func(t *testing.T) {
+		source := `| *** Settings ***   |
+| Test Template      | Login Should Fail |
+
+| *** Test Cases *** |                   |
+| Empty Username     | ${EMPTY}          | secret |
+| Empty Password     | demo              | ${EMPTY} |
+|                    | [Tags]            | robot:skip |
+`
+		file, err := p.Parse(ctx, []byte(source), "login.robot")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(file.Suites) != 1 || file.Suites[0].Name != "Login" {
+			t.Fatalf("expected top-level suite Login, got %+v", file.Suites)
+		}
+		tests := file.Suites[0].Tests
+		if len(tests) != 2 {
+			t.Fatalf("expected 2 tests, got %d", len(tests))
+		}
+		if tests[0].Name != "Empty Username" || tests[0].Status != domain.TestStatusActive {
+			t.Errorf("unexpected first test %+v", tests[0])
+		}
+		if tests[1].Status != domain.TestStatusSkipped {
+			t.Errorf("expected Empty Password skipped, got %s", tests[1].Status)
+		}
+	})
+
+	t.Run("skip in setups", func(t *testing.T) {
+		source := `*** Settings ***
+Test Setup    Skip    not ready
+
+*** Test Cases ***
+Checkout
+    Log    checkout
+
+Own Setup
+    [Setup]    Open Browser
+    Log    cart
+
+No Setup
+    [Setup]    NONE
+    Log    cart
+
+Setup Skip
+    [Setup]    BuiltIn.Skip
+    Log    cart
+`
+		file, err := p.Parse(ctx, []byte(source), "shop.robot")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expected := []struct {
+			status   domain.TestStatus
+			modifier string
+		}{
+			{domain.TestStatusSkipped, "Skip"},
+			{domain.TestStatusActive, ""},
+			{domain.TestStatusActive, ""},
+			{domain.TestStatusSkipped, "BuiltIn.Skip"},
+		}
+		tests := file.Suites[0].Tests
+		if len(tests) != len(expected) {
+			t.Fatalf("expected %d tests, got %d", len(expected), len(tests))
+		}
+		for i, want := range expected {
+			if tests[i].Status != want.status || tests[i].Modifier != want.modifier {
+				t.Errorf("%s: expected %s/%s, got %s/%s", tests[i].Name, want.status, want.modifier, tests[i].Status, tests[i].Modifier)
+			}
+		}
+	})
+
+	t.Run("skip in suite setup", func(t *testing.T) {
+		source := `*** Settings ***
+Suite Setup    Skip    backend down
+
+*** Test Cases ***
+Checkout
+    [Setup]    Open Browser
+    Log    checkout
+`
+		file, err := p.Parse(ctx, []byte(source), "shop.robot")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if test := file.Suites[0].Tests[0]; test.Status != domain.TestStatusSkipped || test.Modifier != "Skip" {
+			t.Errorf("expected Checkout skipped by Suite Setup, got %s/%s", test.Status, test.Modifier)
+		}
+	})
+
+	t.Run("tasks section", func(t *testing.T) {
+		source := "*** Tasks ***\nProcess Invoices\n    Log    done\n"
+		file, err := p.Parse(ctx, []byte(source), "rpa/invoices.robot")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if file.CountTests() != 1 {
+			t.Errorf("expected 1 task, got %d", file.CountTests())
+		}
+	})
+
+	t.Run("resource file", func(t *testing.T) {
+		source := "*** Keywords ***\nOpen Login Page\n    Go To    ${URL}\n"
+		file, err := p.Parse(ctx, []byte(source), "atest/resources/common.robot")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if file != nil {
+			t.Errorf("expected nil file for resource file, got %+v", file)
+		}
+	})
+}
+
+func TestRobotParser_ParseWithScope(t *testing.T) {
+	scope := framework.NewConfigScope("/project/atest/web/__init__.robot", "")
+	scope.Framework = frameworkName
+	scope.Settings[settingName] = "Web UI"
+	scope.Settings[settingTags] = []string{"robot:exclude"}
+
+	source := "*** Test Cases ***\nLogin\n    No Operation\n"
+	file, err := (&RobotParser{}).ParseWithScope(context.Background(), []byte(source), "atest/web/login.robot", scope)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	atest := file.Suites[0]
+	if atest.Name != "Atest" || len(atest.Suites) != 1 {
+		t.Fatalf("expected Atest directory suite, got %+v", atest)
+	}
+	web := atest.Suites[0]
+	if web.Name != "Web UI" {
+		t.Errorf("expected directory suite renamed to 'Web UI', got %q", web.Name)
+	}
+	test := web.Suites[0].Tests[0]
+	if test.Status != domain.TestStatusSkipped || test.Modifier != "robot:exclude" {
+		t.Errorf("expected inherited robot:exclude, got %s/%s", test.Status, test.Modifier)
+	}
+}
+
+func TestRobotParser_ParseWithScope_DirectorySuites(t *testing.T) {
+	scope := framework.NewConfigScope("/project/atest/web/__init__.robot", "")
+	scope.Framework = frameworkName
+	scope.Settings[settingLastLine] = 4
+	scope.Settings[settingTestSkip] = "Skip"
+
+	source := "*** Test Cases ***\nLogin\n    No Operation\n\nLogout\n    [Setup]    Open Browser\n"
+	file, err := (&RobotParser{}).ParseWithScope(context.Background(), []byte(source), "atest/web/login.robot", scope)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	atest := file.Suites[0]
+	expected := domain.Location{File: "atest/web/login.robot", StartLine: 1, EndLine: 6}
+	if atest.Location != expected {
+		t.Errorf("expected Atest to span the file suite %+v, got %+v", expected, atest.Location)
+	}
+	web := atest.Suites[0]
+	expected = domain.Location{File: "atest/web/__init__.robot", StartLine: 1, EndLine: 4}
+	if web.Location != expected {
+		t.Errorf("expected Web located in its __init__.robot %+v, got %+v", expected, web.Location)
+	}
+
+	tests := web.Suites[0].Tests
+	if tests[0].Status != domain.TestStatusSkipped || tests[0].Modifier != "Skip" {
+		t.Errorf("expected Login skipped by the inherited Test Setup, got %s/%s", tests[0].Status, tests[0].Modifier)
+	}
+	if tests[1].Status != domain.TestStatusActive {
+		t.Errorf("expected Logout to replace the Test Setup, got %s", tests[1].Status)
+	}
+}
+
+func TestSuiteName(t *testing.T) {
+	tests := []struct {
+		base, want string
+	}{
+		{"login_tests.robot", "Login Tests"},
+		{"01__smoke.robot", "Smoke"},
+		{"MyTests.robot", "MyTests"},
+		{"api_v2", "Api V2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.base, func(t *testing.T) {
+			if got := suiteName(tt.base); got != tt.want {
+				t.Errorf("suiteName(%q) = %q, want %q", tt.base, got, tt.want)
+			}
+		})
+	}
+}
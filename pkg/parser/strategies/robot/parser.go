@@ -0,0 +1,487 @@
+package robot
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+// Section kinds. Keywords, Variables and Comments sections hold no tests.
+const (
+	sectionSettings = "settings"
+	sectionTests    = "tests"
+	sectionOther    = "other"
+)
+
+var sectionHeader = regexp.MustCompile(`^\*+\s*([^*]*?)\s*\**\s*$`)
+
+var sectionKinds = map[string]string{
+	"settings":   sectionSettings,
+	"setting":    sectionSettings,
+	"test cases": sectionTests,
+	"test case":  sectionTests,
+	"tasks":      sectionTests,
+	"task":       sectionTests,
+}
+
+// cellSeparator separates cells in the space separated format: two or more spaces or a tab.
+var cellSeparator = regexp.MustCompile(`\s{2,}|\t`)
+
+const (
+	continuationMarker = "..."
+	templateNone       = "NONE"
+	tagRemovalPrefix   = "-"
+)
+
+// Reserved tags excluding a test from execution. Robot reports robot:skip tests as
+// skipped and leaves robot:exclude tests out of the run.
+var tagStatuses = map[string]domain.TestStatus{
+	"robot:skip":    domain.TestStatusSkipped,
+	"robot:exclude": domain.TestStatusSkipped,
+}
+
+// Control structures whose body is only conditionally executed.
+var blockStarts = map[string]bool{"FOR": true, "WHILE": true, "TRY": true, "IF": true, "GROUP": true}
+
+const blockEnd = "END"
+
+// RobotParser extracts test cases and tasks from Robot Framework suite files.
+// The file is a suite nested in one suite per directory of its path, as Robot builds
+// directory suites. A test is skipped when tagged robot:skip or robot:exclude (directly,
+// through Test Tags or an __init__.robot) or when the Skip keyword runs in its body, its
+// setup, or the Suite Setup of its file or __init__.robot.
+// Templated tests count once regardless of their data rows.
+type RobotParser struct{}
+
+func (p *RobotParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	return p.parse(source, filename, nil)
+}
+
+// ParseWithScope applies the Name, Test Tags and setups of the nearest __init__.robot.
+func (p *RobotParser) ParseWithScope(ctx context.Context, source []byte, filename string, scope *framework.ConfigScope) (*domain.TestFile, error) {
+	return p.parse(source, filename, scope)
+}
+
+func (p *RobotParser) parse(source []byte, filename string, scope *framework.ConfigScope) (*domain.TestFile, error) {
+	doc := parseDocument(source, filename)
+	if doc.err != nil {
+		return nil, fmt.Errorf("robot parser: failed to read %s: %w", filename, doc.err)
+	}
+	// Robot ignores files without a test or task section (resource files).
+	if !doc.hasTests {
+		return nil, nil
+	}
+
+	var inherited suiteDefaults
+	if scope != nil {
+		inherited.tags, _ = scope.Settings[settingTags].([]string)
+		inherited.suiteSkip, _ = scope.Settings[settingSuiteSkip].(string)
+		inherited.testSkip, _ = scope.Settings[settingTestSkip].(string)
+	}
+	inherited.tags = append(inherited.tags, doc.settings.testTags...)
+	if doc.settings.suiteSkip != "" {
+		inherited.suiteSkip = doc.settings.suiteSkip
+	}
+	if doc.settings.hasTestSetup {
+		inherited.testSkip = doc.settings.testSkip
+	}
+
+	suite := domain.TestSuite{
+		Name:     doc.settings.name,
+		Status:   domain.TestStatusActive,
+		Location: domain.Location{File: filename, StartLine: 1, EndLine: doc.lastLine},
+	}
+	if suite.Name == "" {
+		suite.Name = suiteName(filepath.Base(filename))
+	}
+	for _, tc := range doc.tests {
+		suite.Tests = append(suite.Tests, doc.test(tc, inherited))
+	}
+
+	return &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageRobot,
+		Framework: frameworkName,
+		Suites:    []domain.TestSuite{directorySuites(filename, suite, scope)},
+	}, nil
+}
+
+// directorySuites nests the file suite in one suite per directory of its path. The
+// directory of the nearest __init__.robot is located in that file and takes the name
+// set there; the other directories have no file of their own and span the file suite.
+func directorySuites(filename string, suite domain.TestSuite, scope *framework.ConfigScope) domain.TestSuite {
+	dir := filepath.ToSlash(filepath.Dir(filename))
+	if dir == "." || dir == "/" {
+		return suite
+	}
+	dirs := strings.Split(strings.Trim(dir, "/"), "/")
+
+	initDir := -1
+	if scope != nil {
+		baseDir := filepath.ToSlash(scope.BaseDir)
+		for i := len(dirs); i > 0; i-- {
+			prefix := strings.Join(dirs[:i], "/")
+			if baseDir == prefix || strings.HasSuffix(baseDir, "/"+prefix) {
+				initDir = i - 1
+				break
+			}
+		}
+	}
+
+	location := suite.Location
+	for i := len(dirs) - 1; i >= 0; i-- {
+		parent := domain.TestSuite{
+			Name:     suiteName(dirs[i]),
+			Status:   domain.TestStatusActive,
+			Location: location,
+			Suites:   []domain.TestSuite{suite},
+		}
+		if i == initDir {
+			lastLine, _ := scope.Settings[settingLastLine].(int)
+			parent.Location = domain.Location{
+				File:      strings.Join(dirs[:i+1], "/") + "/" + initFile,
+				StartLine: 1,
+				EndLine:   max(lastLine, 1),
+			}
+			if name, _ := scope.Settings[settingName].(string); name != "" {
+				parent.Name = name
+			}
+		}
+		suite = parent
+	}
+	return suite
+}
+
+// suiteName derives a suite name from a file or directory name as Robot does: the
+// extension and an ordering prefix such as "01__" are dropped, underscores become
+// spaces and all-lowercase names are title cased.
+func suiteName(base string) string {
+	name := strings.TrimSuffix(base, filepath.Ext(base))
+	if _, rest, ok := strings.Cut(name, "__"); ok && rest != "" {
+		name = rest
+	}
+	name = strings.TrimSpace(strings.ReplaceAll(name, "_", " "))
+	if name != strings.ToLower(name) {
+		return name
+	}
+
+	runes := []rune(name)
+	for i, r := range runes {
+		if i == 0 || !unicode.IsLetter(runes[i-1]) {
+			runes[i] = unicode.ToUpper(r)
+		}
+	}
+	return string(runes)
+}
+
+// suiteSettings are the *** Settings *** that affect test inventory.
+type suiteSettings struct {
+	name        string
+	testTags    []string
+	defaultTags []string
+	template    bool
+	// suiteSkip and testSkip are the Skip keywords run by Suite Setup and Test Setup.
+	suiteSkip    string
+	testSkip     string
+	hasTestSetup bool
+}
+
+// suiteDefaults are the settings a test takes from its file and the enclosing
+// __init__.robot: the tags applied to it and the Skip keywords run by the setups.
+type suiteDefaults struct {
+	tags      []string
+	suiteSkip string
+	testSkip  string
+}
+
+// testCase is a test or task as read from the file.
+type testCase struct {
+	name      string
+	startLine int
+	endLine   int
+	tags      []string
+	hasTags   bool
+	// template is nil when the test uses the suite's Test Template setting.
+	template *bool
+	skip     string
+	// hasSetup is set when [Setup] replaces the Test Setup.
+	hasSetup bool
+}
+
+// document is the parse state of a single .robot file.
+type document struct {
+	filename string
+	section  string
+	settings suiteSettings
+	hasTests bool
+	tests    []*testCase
+	current  *testCase
+	// continuation is the setting a "..." row extends.
+	continuation string
+	// depth is the control structure nesting of the current test body.
+	depth    int
+	lastLine int
+	err      error
+}
+
+func parseDocument(source []byte, filename string) *document {
+	doc := &document{filename: filename}
+	scanner := bufio.NewScanner(bytes.NewReader(source))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(source)+1)
+
+	for line := 1; scanner.Scan(); line++ {
+		doc.readLine(scanner.Text(), line)
+	}
+	doc.err = scanner.Err()
+	return doc
+}
+
+func (d *document) readLine(raw string, line int) {
+	text := strings.TrimSpace(raw)
+	if text == "" {
+		return
+	}
+	d.lastLine = line
+
+	cells := splitRow(raw)
+	if len(cells) == 0 {
+		return
+	}
+	if strings.HasPrefix(cells[0], "*") {
+		d.startSection(cells[0])
+		return
+	}
+
+	switch d.section {
+	case sectionSettings:
+		if cells[0] == "" {
+			cells = cells[1:]
+		}
+		if len(cells) > 0 {
+			d.readSetting(cells[0], cells[1:])
+		}
+	case sectionTests:
+		if cells[0] != "" {
+			d.current = &testCase{name: cells[0], startLine: line}
+			d.tests = append(d.tests, d.current)
+			d.continuation = ""
+			d.depth = 0
+		}
+		if d.current == nil {
+			return
+		}
+		d.current.endLine = line
+		d.readStep(cells[1:])
+	}
+}
+
+func (d *document) startSection(text string) {
+	d.current = nil
+	d.continuation = ""
+
+	m := sectionHeader.FindStringSubmatch(text)
+	if m == nil {
+		d.section = sectionOther
+		return
+	}
+	kind, ok := sectionKinds[strings.ToLower(strings.Join(strings.Fields(m[1]), " "))]
+	if !ok {
+		d.section = sectionOther
+		return
+	}
+	d.section = kind
+	if kind == sectionTests {
+		d.hasTests = true
+	}
+}
+
+func (d *document) readSetting(name string, values []string) {
+	key := strings.ToLower(strings.Join(strings.Fields(name), " "))
+	if key == continuationMarker {
+		key = d.continuation
+	} else {
+		d.continuation = key
+	}
+
+	switch key {
+	case "test tags", "force tags", "task tags":
+		d.settings.testTags = append(d.settings.testTags, values...)
+	case "default tags":
+		d.settings.defaultTags = append(d.settings.defaultTags, values...)
+	case "test template", "task template":
+		d.settings.template = isTemplate(values)
+	case "name":
+		d.settings.name = strings.Join(values, " ")
+	case "suite setup":
+		d.settings.suiteSkip = skipKeyword(values)
+	case "test setup", "task setup":
+		d.settings.hasTestSetup = true
+		d.settings.testSkip = skipKeyword(values)
+	}
+}
+
+func (d *document) readStep(cells []string) {
+	if len(cells) == 0 {
+		return
+	}
+	tc := d.current
+	first := cells[0]
+
+	if first == continuationMarker {
+		if d.continuation == "[tags]" {
+			tc.tags = append(tc.tags, cells[1:]...)
+		}
+		return
+	}
+
+	if strings.HasPrefix(first, "[") && strings.HasSuffix(first, "]") {
+		d.continuation = strings.ToLower(first)
+		switch d.continuation {
+		case "[tags]":
+			tc.hasTags = true
+			tc.tags = append(tc.tags, cells[1:]...)
+		case "[template]":
+			template := isTemplate(cells[1:])
+			tc.template = &template
+		case "[setup]":
+			tc.hasSetup = true
+			if skip := skipKeyword(cells[1:]); skip != "" {
+				tc.skip = skip
+			}
+		}
+		return
+	}
+	d.continuation = ""
+
+	// Templated tests hold data rows rather than keyword calls.
+	if d.templated(tc) {
+		return
+	}
+
+	switch {
+	case first == blockEnd:
+		if d.depth > 0 {
+			d.depth--
+		}
+	case blockStarts[first]:
+		// An inline IF carries its keyword on the same row and has no END.
+		if first != "IF" || len(cells) <= 2 {
+			d.depth++
+		}
+	case d.depth == 0 && isSkipKeyword(first):
+		tc.skip = first
+	}
+}
+
+func (d *document) templated(tc *testCase) bool {
+	if tc.template != nil {
+		return *tc.template
+	}
+	return d.settings.template
+}
+
+// test builds the domain test: tags are the inherited Test Tags followed by [Tags]
+// (or Default Tags), where -tag removes an inherited tag.
+func (d *document) test(tc *testCase, inherited suiteDefaults) domain.Test {
+	own := d.settings.defaultTags
+	if tc.hasTags {
+		own = tc.tags
+	}
+
+	var tags []string
+	removed := make(map[string]bool)
+	for _, tag := range own {
+		if rest, ok := strings.CutPrefix(tag, tagRemovalPrefix); ok {
+			removed[strings.ToLower(rest)] = true
+		}
+	}
+	for _, tag := range append(append([]string{}, inherited.tags...), own...) {
+		if !strings.HasPrefix(tag, tagRemovalPrefix) && !removed[strings.ToLower(tag)] {
+			tags = append(tags, tag)
+		}
+	}
+
+	test := domain.Test{
+		Name:   tc.name,
+		Status: domain.TestStatusActive,
+		Location: domain.Location{
+			File:      d.filename,
+			StartLine: tc.startLine,
+			EndLine:   max(tc.endLine, tc.startLine),
+		},
+		Tags: tags,
+	}
+	for _, tag := range tags {
+		if status, ok := tagStatuses[strings.ToLower(tag)]; ok {
+			test.Status, test.Modifier = status, tag
+			return test
+		}
+	}
+	skip := tc.skip
+	if skip == "" && !tc.hasSetup {
+		skip = inherited.testSkip
+	}
+	if inherited.suiteSkip != "" {
+		skip = inherited.suiteSkip
+	}
+	if skip != "" {
+		test.Status, test.Modifier = domain.TestStatusSkipped, skip
+	}
+	return test
+}
+
+// splitRow splits a line into cells. An indented row starts with an empty cell, like the
+// empty first column of the pipe separated format. Comments are dropped.
+func splitRow(line string) []string {
+	var cells []string
+	if strings.HasPrefix(line, "|") {
+		body := strings.TrimSuffix(strings.TrimRight(line, " \t"), "|")
+		for _, cell := range strings.Split(strings.TrimPrefix(body, "|"), " | ") {
+			cells = append(cells, strings.TrimSpace(cell))
+		}
+	} else {
+		if line[0] == ' ' || line[0] == '\t' {
+			cells = append(cells, "")
+		}
+		cells = append(cells, cellSeparator.Split(strings.TrimSpace(line), -1)...)
+	}
+
+	for i, cell := range cells {
+		if strings.HasPrefix(cell, "#") {
+			cells = cells[:i]
+			break
+		}
+	}
+	for len(cells) > 0 && cells[len(cells)-1] == "" {
+		cells = cells[:len(cells)-1]
+	}
+	return cells
+}
+
+// isTemplate reports whether a template setting names a keyword; NONE disables it.
+func isTemplate(values []string) bool {
+	return len(values) > 0 && values[0] != "" && !strings.EqualFold(values[0], templateNone)
+}
+
+// skipKeyword returns the keyword of a setup setting when it is Skip, or "".
+func skipKeyword(values []string) string {
+	if len(values) > 0 && isSkipKeyword(values[0]) {
+		return values[0]
+	}
+	return ""
+}
+
+// isSkipKeyword matches the BuiltIn Skip keyword, which Robot matches ignoring case,
+// spaces and underscores. Skip If is conditional and does not match.
+func isSkipKeyword(keyword string) bool {
+	normalized := strings.ToLower(strings.NewReplacer(" ", "", "_", "").Replace(keyword))
+	return normalized == "skip" || normalized == "builtin.skip"
+}
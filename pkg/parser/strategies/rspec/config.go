@@ -0,0 +1,262 @@
+package rspec
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+// Settings stored on the RSpec ConfigScope ([]string of "name" or "name:value" tags).
+const (
+	// settingIncludeTags holds the inclusion filters (--tag focus, filter_run_including):
+	// only matching examples run.
+	settingIncludeTags = "includeTags"
+	// settingExcludeTags holds the exclusion filters (--tag ~slow, filter_run_excluding).
+	settingExcludeTags = "excludeTags"
+	// settingWhenMatchingTags holds the conditional filters (filter_run_when_matching):
+	// matching examples run alone when there are any.
+	settingWhenMatchingTags = "whenMatchingTags"
+)
+
+const (
+	dotRSpecFile = ".rspec"
+
+	defaultPath    = "spec"
+	defaultPattern = "**/*_spec.rb"
+)
+
+var (
+	// filterRunPattern matches config.filter_run_excluding :slow, filter_run_when_matching(:focus), ...
+	filterRunPattern = regexp.MustCompile(`\.filter_run(_including|_excluding|_when_matching)?\b\s*\(?(.*)`)
+	// filterArgPattern matches :slow, slow: true and :type => :feature filter arguments.
+	filterArgPattern = regexp.MustCompile(`(?::(\w+)\s*=>\s*([^,)]+)|(\w+):\s+([^,)]+)|:(\w+))`)
+	// runAllPattern makes an inclusion filter conditional, as filter_run_when_matching does.
+	runAllPattern = regexp.MustCompile(`\.run_all_when_everything_filtered\s*=\s*true`)
+	// modifierPattern strips a trailing "if ..." or "unless ..." statement modifier.
+	modifierPattern = regexp.MustCompile(`\s+(?:if|unless)\s+.*$`)
+)
+
+// RSpecConfigParser reads .rspec options and the filters of spec_helper.rb and rails_helper.rb.
+//
+// .rspec --default-path, --pattern and --exclude-pattern decide which files under the
+// default path are specs; --tag filters examples. The helpers' filter_run_excluding,
+// filter_run_including and filter_run_when_matching filter examples too. All of these
+// configure one run, so the scopes inherit each other's filters.
+type RSpecConfigParser struct{}
+
+func (p *RSpecConfigParser) Parse(ctx context.Context, configPath string, content []byte) (*framework.ConfigScope, error) {
+	scope := framework.NewConfigScope(configPath, "")
+	scope.Framework = frameworkName
+	scope.Inherits = true
+
+	if filepath.Base(configPath) == dotRSpecFile {
+		applyDotRSpec(scope, content)
+	} else {
+		applyHelperFilters(scope, content)
+	}
+	return scope, nil
+}
+
+func applyDotRSpec(scope *framework.ConfigScope, content []byte) {
+	var (
+		specPath        = defaultPath
+		patterns        []string
+		excludePatterns []string
+		include         []string
+		exclude         []string
+	)
+
+	args := optionArgs(content)
+	for i := 0; i < len(args); i++ {
+		name, value, hasValue := strings.Cut(args[i], "=")
+		if !hasValue && i+1 < len(args) {
+			switch name {
+			case "--default-path", "--pattern", "-P", "--exclude-pattern", "--tag", "-t":
+				i++
+				value = args[i]
+			}
+		}
+
+		switch name {
+		case "--default-path":
+			specPath = strings.TrimSuffix(strings.TrimPrefix(value, "./"), "/")
+		case "--pattern", "-P":
+			patterns = append(patterns, splitPatterns(value)...)
+		case "--exclude-pattern":
+			excludePatterns = append(excludePatterns, splitPatterns(value)...)
+		case "--tag", "-t":
+			if tag, ok := strings.CutPrefix(value, "~"); ok {
+				exclude = append(exclude, normalizeTag(tag))
+			} else {
+				include = append(include, normalizeTag(value))
+			}
+		}
+	}
+
+	scope.Roots = []string{filepath.Join(scope.BaseDir, filepath.FromSlash(specPath))}
+
+	if len(patterns) > 0 || len(excludePatterns) > 0 {
+		if len(patterns) == 0 {
+			patterns = []string{defaultPattern}
+		}
+		for _, pattern := range patterns {
+			scope.CollectPatterns = append(scope.CollectPatterns, resolvePattern(specPath, pattern))
+		}
+		// Exclude is matched relative to the roots, i.e. the default path.
+		for _, pattern := range excludePatterns {
+			scope.Exclude = append(scope.Exclude, strings.TrimPrefix(resolvePattern(specPath, pattern), specPath+"/"))
+		}
+		scope.ExclusivePatterns = []string{path.Join(specPath, "**", "*.rb")}
+	}
+
+	if len(include) > 0 {
+		scope.Settings[settingIncludeTags] = include
+	}
+	if len(exclude) > 0 {
+		scope.Settings[settingExcludeTags] = exclude
+	}
+}
+
+func applyHelperFilters(scope *framework.ConfigScope, content []byte) {
+	var include, exclude, whenMatching []string
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		match := filterRunPattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		tags := parseFilterArgs(modifierPattern.ReplaceAllString(match[2], ""))
+		switch match[1] {
+		case "_excluding":
+			exclude = append(exclude, tags...)
+		case "_when_matching":
+			whenMatching = append(whenMatching, tags...)
+		default:
+			include = append(include, tags...)
+		}
+	}
+
+	if runAllPattern.Match(content) {
+		whenMatching = append(whenMatching, include...)
+		include = nil
+	}
+
+	if len(include) > 0 {
+		scope.Settings[settingIncludeTags] = include
+	}
+	if len(exclude) > 0 {
+		scope.Settings[settingExcludeTags] = exclude
+	}
+	if len(whenMatching) > 0 {
+		scope.Settings[settingWhenMatchingTags] = whenMatching
+	}
+}
+
+// parseFilterArgs converts filter arguments to tags; lambda values are skipped
+// because they cannot be evaluated statically.
+func parseFilterArgs(args string) []string {
+	var tags []string
+	for _, m := range filterArgPattern.FindAllStringSubmatch(args, -1) {
+		name, value := m[1]+m[3]+m[5], strings.TrimSpace(m[2]+m[4])
+		if strings.HasPrefix(value, "->") || strings.HasPrefix(value, "lambda") || strings.HasPrefix(value, "proc") {
+			continue
+		}
+		tag := name
+		if value != "" {
+			tag += ":" + strings.Trim(strings.TrimPrefix(value, ":"), `"'`)
+		}
+		tags = append(tags, normalizeTag(tag))
+	}
+	return tags
+}
+
+// normalizeTag drops a ":true" value, which matches like the bare tag name.
+func normalizeTag(tag string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(tag, ":"), ":true")
+}
+
+// optionArgs splits .rspec content into arguments, honoring quotes and comments.
+func optionArgs(content []byte) []string {
+	var args []string
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		var (
+			current strings.Builder
+			quote   rune
+			inArg   bool
+		)
+		for _, r := range line {
+			switch {
+			case quote != 0 && r == quote:
+				quote = 0
+			case quote != 0:
+				current.WriteRune(r)
+			case r == '"' || r == '\'':
+				quote, inArg = r, true
+			case r == ' ' || r == '\t':
+				if inArg {
+					args = append(args, current.String())
+					current.Reset()
+					inArg = false
+				}
+			default:
+				current.WriteRune(r)
+				inArg = true
+			}
+		}
+		if inArg {
+			args = append(args, current.String())
+		}
+	}
+	return args
+}
+
+// splitPatterns splits a comma-separated pattern list outside of {} alternatives.
+func splitPatterns(value string) []string {
+	var (
+		patterns []string
+		depth    int
+		start    int
+	)
+	for i, c := range value {
+		switch c {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				patterns = append(patterns, strings.TrimSpace(value[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(patterns, strings.TrimSpace(value[start:]))
+}
+
+// resolvePattern makes a pattern relative to the project the way RSpec globs it:
+// patterns that do not start with the default path are searched within it.
+func resolvePattern(specPath, pattern string) string {
+	pattern = strings.TrimPrefix(pattern, "./")
+	if pattern == specPath || strings.HasPrefix(pattern, specPath+"/") {
+		return pattern
+	}
+	return specPath + "/" + pattern
+}
@@ -39,7 +39,7 @@ func NewDefinition() *framework.Definition {
 			&RSpecFileMatcher{},
 			&RSpecContentMatcher{},
 		},
-		ConfigParser: nil,
+		ConfigParser: &RSpecConfigParser{},
 		Parser:       &RSpecParser{},
 		Priority:     framework.PrioritySpecialized,
 	}
@@ -148,6 +148,16 @@ func (m *RSpecContentMatcher) Match(ctx context.Context, signal framework.Signal
 type RSpecParser struct{}
 
 func (p *RSpecParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	return parse(ctx, source, filename, nil)
+}
+
+// ParseWithScope applies the tag filters of the config: examples the filters leave out
+// are excluded, and examples matching filter_run_when_matching are focused.
+func (p *RSpecParser) ParseWithScope(ctx context.Context, source []byte, filename string, scope *framework.ConfigScope) (*domain.TestFile, error) {
+	return parse(ctx, source, filename, filtersFromScope(scope))
+}
+
+func parse(ctx context.Context, source []byte, filename string, filters *exampleFilters) (*domain.TestFile, error) {
 	tree, err := parser.ParseWithPool(ctx, domain.LanguageRuby, source)
 	if err != nil {
 		return nil, fmt.Errorf("rspec parser: failed to parse %s: %w", filename, err)
@@ -161,7 +171,7 @@ func (p *RSpecParser) Parse(ctx context.Context, source []byte, filename string)
 		Framework: frameworkName,
 	}
 
-	parseNode(root, source, filename, file, nil)
+	parseNode(root, source, filename, file, nil, exampleContext{filters: filters})
 	return file, nil
 }
 
@@ -179,23 +189,23 @@ const (
 	modifierPending = "pending"
 )
 
-func parseNode(node *sitter.Node, source []byte, filename string, file *domain.TestFile, currentSuite *domain.TestSuite) {
+func parseNode(node *sitter.Node, source []byte, filename string, file *domain.TestFile, currentSuite *domain.TestSuite, ec exampleContext) {
 	for i := 0; i < int(node.ChildCount()); i++ {
 		child := node.Child(i)
-		processNode(child, source, filename, file, currentSuite)
+		processNode(child, source, filename, file, currentSuite, ec)
 	}
 }
 
-func processNode(node *sitter.Node, source []byte, filename string, file *domain.TestFile, currentSuite *domain.TestSuite) {
+func processNode(node *sitter.Node, source []byte, filename string, file *domain.TestFile, currentSuite *domain.TestSuite, ec exampleContext) {
 	switch node.Type() {
 	case rubyast.NodeCall, rubyast.NodeMethodCall:
-		processCallExpression(node, source, filename, file, currentSuite)
+		processCallExpression(node, source, filename, file, currentSuite, ec)
 	default:
-		parseNode(node, source, filename, file, currentSuite)
+		parseNode(node, source, filename, file, currentSuite, ec)
 	}
 }
 
-func processCallExpression(node *sitter.Node, source []byte, filename string, file *domain.TestFile, currentSuite *domain.TestSuite) {
+func processCallExpression(node *sitter.Node, source []byte, filename string, file *domain.TestFile, currentSuite *domain.TestSuite, ec exampleContext) {
 	funcName, status, modifier := parseFunctionName(node, source)
 	if funcName == "" {
 		return
@@ -203,15 +213,15 @@ func processCallExpression(node *sitter.Node, source []byte, filename string, fi
 
 	switch funcName {
 	case funcDescribe, funcContext:
-		processSuite(node, source, filename, file, currentSuite, status, modifier)
+		processSuite(node, source, filename, file, currentSuite, ec, status, modifier)
 	case funcIt, funcSpecify, funcExample:
-		processTest(node, source, filename, file, currentSuite, status, modifier)
+		processTest(node, source, filename, file, currentSuite, ec, status, modifier)
 	case modifierSkip, modifierPending:
-		processPendingBlock(node, source, filename, file, currentSuite, modifier)
+		processPendingBlock(node, source, filename, file, currentSuite, ec, modifier)
 	default:
 		block := findBlock(node)
 		if block != nil {
-			parseNode(block, source, filename, file, currentSuite)
+			parseNode(block, source, filename, file, currentSuite, ec)
 		}
 	}
 }
@@ -289,15 +299,16 @@ func getBaseMethod(name string) string {
 	return ""
 }
 
-func processSuite(node *sitter.Node, source []byte, filename string, file *domain.TestFile, parentSuite *domain.TestSuite, status domain.TestStatus, modifier string) {
+func processSuite(node *sitter.Node, source []byte, filename string, file *domain.TestFile, parentSuite *domain.TestSuite, ec exampleContext, status domain.TestStatus, modifier string) {
 	name := extractName(node, source)
 	if name == "" {
 		return
 	}
 
+	ec = ec.with(node, source)
 	suite := domain.TestSuite{
 		Name:     name,
-		Status:   status,
+		Status:   ec.suiteStatus(status),
 		Modifier: modifier,
 		Location: parser.GetLocation(node, filename),
 	}
@@ -305,22 +316,23 @@ func processSuite(node *sitter.Node, source []byte, filename string, file *domai
 	// Parse the block content
 	block := findBlock(node)
 	if block != nil {
-		parseNode(block, source, filename, file, &suite)
+		parseNode(block, source, filename, file, &suite, ec)
 	}
 
 	addSuiteToTarget(suite, parentSuite, file)
 }
 
-func processTest(node *sitter.Node, source []byte, filename string, file *domain.TestFile, parentSuite *domain.TestSuite, status domain.TestStatus, modifier string) {
+func processTest(node *sitter.Node, source []byte, filename string, file *domain.TestFile, parentSuite *domain.TestSuite, ec exampleContext, status domain.TestStatus, modifier string) {
 	name := extractName(node, source)
 	if name == "" {
 		// Handle pending tests without description: it { ... } or specify { ... }
 		name = "(anonymous)"
 	}
 
+	ec = ec.with(node, source)
 	test := domain.Test{
 		Name:     name,
-		Status:   status,
+		Status:   ec.exampleStatus(status),
 		Modifier: modifier,
 		Location: parser.GetLocation(node, filename),
 	}
@@ -328,7 +340,7 @@ func processTest(node *sitter.Node, source []byte, filename string, file *domain
 	addTestToTarget(test, parentSuite, file)
 }
 
-func processPendingBlock(node *sitter.Node, source []byte, filename string, file *domain.TestFile, currentSuite *domain.TestSuite, modifier string) {
+func processPendingBlock(node *sitter.Node, source []byte, filename string, file *domain.TestFile, currentSuite *domain.TestSuite, ec exampleContext, modifier string) {
 	// Handle skip/pending with string: skip "reason" or pending "reason"
 	name := extractName(node, source)
 	if name == "" {
@@ -345,7 +357,7 @@ func processPendingBlock(node *sitter.Node, source []byte, filename string, file
 			Modifier: modifier,
 			Location: parser.GetLocation(node, filename),
 		}
-		parseNode(block, source, filename, file, &suite)
+		parseNode(block, source, filename, file, &suite, ec)
 		addSuiteToTarget(suite, currentSuite, file)
 	} else {
 		// Just a pending marker, create a skipped test
@@ -424,3 +436,142 @@ func addTestToTarget(test domain.Test, parentSuite *domain.TestSuite, file *doma
 func addSuiteToTarget(suite domain.TestSuite, parentSuite *domain.TestSuite, file *domain.TestFile) {
 	rubyast.AddSuiteToTarget(suite, parentSuite, file)
 }
+
+// exampleFilters are the tag filters of the config covering the file.
+type exampleFilters struct {
+	include      []string
+	exclude      []string
+	whenMatching []string
+}
+
+func filtersFromScope(scope *framework.ConfigScope) *exampleFilters {
+	if scope == nil {
+		return nil
+	}
+	tags := func(key string) []string {
+		values, _ := scope.Settings[key].([]string)
+		return values
+	}
+	filters := &exampleFilters{
+		include:      tags(settingIncludeTags),
+		exclude:      tags(settingExcludeTags),
+		whenMatching: tags(settingWhenMatchingTags),
+	}
+	if len(filters.include) == 0 && len(filters.exclude) == 0 && len(filters.whenMatching) == 0 {
+		return nil
+	}
+	return filters
+}
+
+// exampleContext carries the filters and the metadata example groups pass on to
+// their examples. Metadata maps tags to values: "true" for :slow, "feature" for
+// type: :feature.
+type exampleContext struct {
+	filters  *exampleFilters
+	metadata map[string]string
+}
+
+// with adds the metadata of an example group or example call. fdescribe, fit and
+// friends are tagged focus: true, as in RSpec.
+func (ec exampleContext) with(node *sitter.Node, source []byte) exampleContext {
+	if ec.filters == nil {
+		return ec
+	}
+
+	metadata := make(map[string]string, len(ec.metadata))
+	for key, value := range ec.metadata {
+		metadata[key] = value
+	}
+
+	if name := calledName(node, source); strings.HasPrefix(name, "f") && getBaseMethod(name) != "" {
+		metadata["focus"] = "true"
+	}
+
+	if args := node.ChildByFieldName("arguments"); args != nil {
+		described := false
+		for i := 0; i < int(args.NamedChildCount()); i++ {
+			arg := args.NamedChild(i)
+			switch arg.Type() {
+			case "pair":
+				key := metadataText(arg.ChildByFieldName("key"), source)
+				if key != "" {
+					metadata[key] = metadataText(arg.ChildByFieldName("value"), source)
+				}
+			case rubyast.NodeSimpleSymbol, rubyast.NodeSymbol:
+				if described {
+					metadata[extractSymbolContent(arg, source)] = "true"
+				}
+			}
+			// The first argument is the description or described class.
+			described = true
+		}
+	}
+
+	return exampleContext{filters: ec.filters, metadata: metadata}
+}
+
+// suiteStatus applies the exclusion and conditional filters to an example group.
+// Inclusion filters are left to the examples: a group runs its matching examples.
+func (ec exampleContext) suiteStatus(status domain.TestStatus) domain.TestStatus {
+	if ec.filters == nil || status != domain.TestStatusActive {
+		return status
+	}
+	if ec.matchesAny(ec.filters.exclude) {
+		return domain.TestStatusExcluded
+	}
+	if ec.matchesAny(ec.filters.whenMatching) {
+		return domain.TestStatusFocused
+	}
+	return status
+}
+
+func (ec exampleContext) exampleStatus(status domain.TestStatus) domain.TestStatus {
+	if ec.filters == nil || status != domain.TestStatusActive {
+		return status
+	}
+	if len(ec.filters.include) > 0 && !ec.matchesAny(ec.filters.include) {
+		return domain.TestStatusExcluded
+	}
+	return ec.suiteStatus(status)
+}
+
+// matchesAny reports whether the metadata matches one of the "name" or "name:value"
+// tags. A bare name matches any value but false and nil.
+func (ec exampleContext) matchesAny(tags []string) bool {
+	for _, tag := range tags {
+		name, want, hasValue := strings.Cut(tag, ":")
+		value, ok := ec.metadata[name]
+		if !ok {
+			continue
+		}
+		if hasValue && value == want || !hasValue && value != "false" && value != "nil" {
+			return true
+		}
+	}
+	return false
+}
+
+func calledName(node *sitter.Node, source []byte) string {
+	if method := node.ChildByFieldName("method"); method != nil {
+		return parser.GetNodeText(method, source)
+	}
+	if name := parser.FindChildByType(node, rubyast.NodeIdentifier); name != nil {
+		return parser.GetNodeText(name, source)
+	}
+	return ""
+}
+
+// metadataText returns a metadata key or value: symbols without the colon, string
+// contents, or the source text of other literals.
+func metadataText(node *sitter.Node, source []byte) string {
+	if node == nil {
+		return ""
+	}
+	switch node.Type() {
+	case rubyast.NodeString:
+		return extractStringContent(node, source)
+	case rubyast.NodeSimpleSymbol, rubyast.NodeSymbol:
+		return extractSymbolContent(node, source)
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(parser.GetNodeText(node, source), ":"), ":")
+}
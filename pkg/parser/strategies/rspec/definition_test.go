@@ -2,6 +2,8 @@ package rspec
 
 import (
 	"context"
+	"path/filepath"
+	"reflect"
 	"testing"
 
 	"github.com/specvital/core/pkg/domain"
@@ -240,6 +242,205 @@ end
 	}
 }
 
+func TestRSpecConfigParser_Parse(t *testing.T) {
+	parser := &RSpecConfigParser{}
+	ctx := context.Background()
+
+	t.Run(".rspec options", func(t *testing.T) {
+		content := `--require spec_helper
+--format documentation
+--default-path checks
+--pattern "**/*_check.rb,checks/api/**/*_spec.rb"
+--exclude-pattern=checks/legacy/**/*
+--tag ~slow --tag type:feature
+-t focus:true
+`
+		scope, err := parser.Parse(ctx, filepath.FromSlash("/project/.rspec"), []byte(content))
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		if scope.Framework != frameworkName || !scope.Inherits {
+			t.Errorf("expected an inheriting %q scope, got %+v", frameworkName, scope)
+		}
+		if want := []string{filepath.FromSlash("/project/checks")}; !reflect.DeepEqual(scope.Roots, want) {
+			t.Errorf("Roots = %v, want %v", scope.Roots, want)
+		}
+		if want := []string{"checks/**/*_check.rb", "checks/api/**/*_spec.rb"}; !reflect.DeepEqual(scope.CollectPatterns, want) {
+			t.Errorf("CollectPatterns = %v, want %v", scope.CollectPatterns, want)
+		}
+		if want := []string{"legacy/**/*"}; !reflect.DeepEqual(scope.Exclude, want) {
+			t.Errorf("Exclude = %v, want %v", scope.Exclude, want)
+		}
+		if want := []string{"checks/**/*.rb"}; !reflect.DeepEqual(scope.ExclusivePatterns, want) {
+			t.Errorf("ExclusivePatterns = %v, want %v", scope.ExclusivePatterns, want)
+		}
+		if want := []string{"type:feature", "focus"}; !reflect.DeepEqual(scope.Settings[settingIncludeTags], want) {
+			t.Errorf("includeTags = %v, want %v", scope.Settings[settingIncludeTags], want)
+		}
+		if want := []string{"slow"}; !reflect.DeepEqual(scope.Settings[settingExcludeTags], want) {
+			t.Errorf("excludeTags = %v, want %v", scope.Settings[settingExcludeTags], want)
+		}
+
+		file := filepath.FromSlash("/project/checks/models/user_check.rb")
+		if !scope.Decides(file) || !scope.Collects(file) {
+			t.Errorf("expected %s to be collected", file)
+		}
+		for _, path := range []string{"/project/checks/models/user_spec.rb", "/project/checks/legacy/old_check.rb"} {
+			if scope.Collects(filepath.FromSlash(path)) {
+				t.Errorf("expected %s not to be collected", path)
+			}
+		}
+	})
+
+	t.Run(".rspec without patterns keeps naming conventions", func(t *testing.T) {
+		scope, err := parser.Parse(ctx, filepath.FromSlash("/project/.rspec"), []byte("--require spec_helper\n--color\n"))
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		if want := []string{filepath.FromSlash("/project/spec")}; !reflect.DeepEqual(scope.Roots, want) {
+			t.Errorf("Roots = %v, want %v", scope.Roots, want)
+		}
+		if len(scope.ExclusivePatterns) != 0 || len(scope.CollectPatterns) != 0 {
+			t.Errorf("expected no collection patterns, got %+v", scope)
+		}
+	})
+
+	t.Run("spec_helper.rb filters", func(t *testing.T) {
+		content := `RSpec.configure do |config|
+  config.filter_run_when_matching :focus
+  config.filter_run_excluding :slow, type: :feature
+  config.filter_run_excluding(broken: true) unless ENV["ALL"]
+  # config.filter_run_excluding :commented
+  config.filter_run_excluding ruby: ->(version) { !RUBY_VERSION.start_with?(version) }
+end
+`
+		scope, err := parser.Parse(ctx, filepath.FromSlash("/project/spec/spec_helper.rb"), []byte(content))
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		if scope.BaseDir != filepath.FromSlash("/project/spec") || !scope.Inherits {
+			t.Errorf("unexpected scope %+v", scope)
+		}
+		if want := []string{"slow", "type:feature", "broken"}; !reflect.DeepEqual(scope.Settings[settingExcludeTags], want) {
+			t.Errorf("excludeTags = %v, want %v", scope.Settings[settingExcludeTags], want)
+		}
+		if want := []string{"focus"}; !reflect.DeepEqual(scope.Settings[settingWhenMatchingTags], want) {
+			t.Errorf("whenMatchingTags = %v, want %v", scope.Settings[settingWhenMatchingTags], want)
+		}
+	})
+
+	t.Run("run_all_when_everything_filtered makes filter_run conditional", func(t *testing.T) {
+		content := `RSpec.configure do |c|
+  c.filter_run focus: true
+  c.run_all_when_everything_filtered = true
+end
+`
+		scope, err := parser.Parse(ctx, filepath.FromSlash("/project/spec/rails_helper.rb"), []byte(content))
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		if _, ok := scope.Settings[settingIncludeTags]; ok {
+			t.Errorf("expected no inclusion filter, got %v", scope.Settings[settingIncludeTags])
+		}
+		if want := []string{"focus"}; !reflect.DeepEqual(scope.Settings[settingWhenMatchingTags], want) {
+			t.Errorf("whenMatchingTags = %v, want %v", scope.Settings[settingWhenMatchingTags], want)
+		}
+	})
+}
+
+func TestRSpecParser_ParseWithScope(t *testing.T) {
+	source := `
+RSpec.describe User do
+  it "saves" do
+  end
+
+  it "imports the archive", :slow do
+  end
+
+  fit "validates" do
+  end
+
+  xit "is pending", :slow do
+  end
+
+  describe "browser", type: :feature do
+    it "logs in" do
+    end
+  end
+
+  context "api", slow: false do
+    it "responds" do
+    end
+  end
+end
+`
+	parse := func(t *testing.T, settings map[string]interface{}) map[string]domain.TestStatus {
+		t.Helper()
+		scope := framework.NewConfigScope(filepath.FromSlash("/project/spec/spec_helper.rb"), "")
+		scope.Settings = settings
+		file, err := (&RSpecParser{}).ParseWithScope(context.Background(), []byte(source), "spec/user_spec.rb", scope)
+		if err != nil {
+			t.Fatalf("ParseWithScope() error = %v", err)
+		}
+		statuses := make(map[string]domain.TestStatus)
+		var walk func(suites []domain.TestSuite)
+		walk = func(suites []domain.TestSuite) {
+			for _, suite := range suites {
+				statuses["suite "+suite.Name] = suite.Status
+				for _, test := range suite.Tests {
+					statuses[test.Name] = test.Status
+				}
+				walk(suite.Suites)
+			}
+		}
+		walk(file.Suites)
+		return statuses
+	}
+
+	t.Run("exclusion and conditional filters", func(t *testing.T) {
+		statuses := parse(t, map[string]interface{}{
+			settingExcludeTags:      []string{"slow", "type:feature"},
+			settingWhenMatchingTags: []string{"focus"},
+		})
+		want := map[string]domain.TestStatus{
+			"suite User":          domain.TestStatusActive,
+			"saves":               domain.TestStatusActive,
+			"imports the archive": domain.TestStatusExcluded,
+			"validates":           domain.TestStatusFocused,
+			"is pending":          domain.TestStatusSkipped,
+			"suite browser":       domain.TestStatusExcluded,
+			"logs in":             domain.TestStatusExcluded,
+			"suite api":           domain.TestStatusActive,
+			"responds":            domain.TestStatusActive,
+		}
+		if !reflect.DeepEqual(statuses, want) {
+			t.Errorf("statuses = %v, want %v", statuses, want)
+		}
+	})
+
+	t.Run("inclusion filter", func(t *testing.T) {
+		statuses := parse(t, map[string]interface{}{
+			settingIncludeTags: []string{"type:feature"},
+		})
+		if statuses["suite User"] != domain.TestStatusActive {
+			t.Errorf("expected groups to stay active, got %q", statuses["suite User"])
+		}
+		if statuses["logs in"] != domain.TestStatusActive {
+			t.Errorf("expected matching example to stay active, got %q", statuses["logs in"])
+		}
+		if statuses["saves"] != domain.TestStatusExcluded {
+			t.Errorf("expected example outside the filter to be excluded, got %q", statuses["saves"])
+		}
+	})
+
+	t.Run("no filters", func(t *testing.T) {
+		statuses := parse(t, map[string]interface{}{})
+		if statuses["imports the archive"] != domain.TestStatusActive || statuses["validates"] != domain.TestStatusActive {
+			t.Errorf("expected statuses from the source only, got %v", statuses)
+		}
+	})
+}
+
 func TestRSpecFileMatcher_Match(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -0,0 +1,382 @@
+// Package scalatest implements ScalaTest framework support for Scala test files.
+package scalatest
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+	"github.com/specvital/core/pkg/parser/framework"
+	"github.com/specvital/core/pkg/parser/framework/matchers"
+	"github.com/specvital/core/pkg/parser/strategies/shared/scalaast"
+)
+
+const frameworkName = framework.FrameworkScalaTest
+
+// specStyles are the ScalaTest style traits (and their async/fixture variants) a suite can extend.
+var specStyles = map[string]bool{
+	"AnyFunSuite": true, "AsyncFunSuite": true, "FixtureAnyFunSuite": true, "FunSuite": true,
+	"AnyFlatSpec": true, "AsyncFlatSpec": true, "FixtureAnyFlatSpec": true, "FlatSpec": true,
+	"AnyFunSpec": true, "AsyncFunSpec": true, "FixtureAnyFunSpec": true, "FunSpec": true,
+	"AnyWordSpec": true, "AsyncWordSpec": true, "FixtureAnyWordSpec": true, "WordSpec": true,
+	"AnyFreeSpec": true, "AsyncFreeSpec": true, "FixtureAnyFreeSpec": true, "FreeSpec": true,
+	"AnyFeatureSpec": true, "AsyncFeatureSpec": true, "FixtureAnyFeatureSpec": true, "FeatureSpec": true,
+	"AnyPropSpec": true, "AsyncPropSpec": true, "FixtureAnyPropSpec": true, "PropSpec": true,
+}
+
+// Registration calls: test("name") { }, describe("name") { }, ...
+var (
+	testCalls  = map[string]bool{"test": true, "it": true, "they": true, "property": true, "scenario": true, "Scenario": true}
+	suiteCalls = map[string]bool{"describe": true, "feature": true, "Feature": true}
+)
+
+// Infix registrations: "name" in { }, "subject" should "behave" in { }, "context" when { }, ...
+const (
+	opIn     = "in"
+	opIgnore = "ignore"
+	opIs     = "is"
+	opTagged = "taggedAs"
+	opBranch = "-"
+
+	identIgnore  = "ignore"
+	identPending = "pending"
+	identPUF     = "pendingUntilFixed"
+	identBehave  = "behavior"
+	opOf         = "of"
+)
+
+// containerOps open a nested scope in WordSpec (when/should/must/can/which/that) and FreeSpec (-).
+var containerOps = map[string]bool{
+	"when": true, "should": true, "must": true, "can": true, "which": true, "that": true, opBranch: true,
+}
+
+// flatSpecVerbs join a subject and a behavior description in FlatSpec.
+var flatSpecVerbs = map[string]bool{"should": true, "must": true, "can": true}
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageScala},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher("org.scalatest", "org.scalatest."),
+			&ScalaTestContentMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &ScalaTestParser{},
+		Priority:     framework.PriorityGeneric,
+	}
+}
+
+// ScalaTestContentMatcher matches ScalaTest-specific patterns in file content.
+type ScalaTestContentMatcher struct{}
+
+var scalatestPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`import\s+org\.scalatest\b`), "ScalaTest import"},
+	{regexp.MustCompile(`extends\s+(?:Any|Async)(?:FunSuite|FlatSpec|FunSpec|WordSpec|FreeSpec|FeatureSpec|PropSpec)\b`), "extends ScalaTest style trait"},
+	{regexp.MustCompile(`extends\s+(?:FlatSpec|WordSpec|FreeSpec|FeatureSpec|PropSpec)\b`), "extends legacy ScalaTest style trait"},
+}
+
+func (m *ScalaTestContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range scalatestPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found ScalaTest pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+// ScalaTestParser extracts test definitions from Scala ScalaTest files.
+// Every class or object extending a ScalaTest style trait becomes a suite. Registrations are
+// recognised by shape rather than by style, so suites mixing styles are handled too:
+//   - FunSuite/FunSpec/FeatureSpec/PropSpec: test/it/they/property/scenario, describe/feature, ignore
+//   - FlatSpec: "subject" should "behave" in { }, it/they should ..., behavior of "subject"
+//   - WordSpec/FreeSpec: "context" when/should/must/can/- { }, "name" in/ignore/is { }
+type ScalaTestParser struct{}
+
+func (p *ScalaTestParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageScala, source)
+	if err != nil {
+		return nil, fmt.Errorf("scalatest parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	file := &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageScala,
+		Framework: frameworkName,
+	}
+
+	for _, spec := range scalaast.Specs(tree.RootNode(), source) {
+		if !spec.Extends(specStyles) || spec.Body == nil {
+			continue
+		}
+
+		suite := domain.TestSuite{
+			Name:     spec.Name,
+			Status:   domain.TestStatusActive,
+			Location: parser.GetLocation(spec.Node, filename),
+		}
+		if scalaast.HasAnnotation(spec.Node, source, "Ignore") {
+			suite.Status = domain.TestStatusSkipped
+			suite.Modifier = "@Ignore"
+		}
+
+		w := &walker{source: source, filename: filename}
+		w.walk(spec.Body, &suite)
+
+		if len(suite.Tests) > 0 || len(suite.Suites) > 0 {
+			file.Suites = append(file.Suites, suite)
+		}
+	}
+
+	return file, nil
+}
+
+// walker tracks the FlatSpec subject, which carries over between sibling statements.
+type walker struct {
+	source   []byte
+	filename string
+	subject  string
+}
+
+func (w *walker) walk(node *sitter.Node, suite *domain.TestSuite) {
+	for _, child := range scalaast.NamedChildren(node) {
+		w.visit(child, suite)
+	}
+}
+
+func (w *walker) visit(node *sitter.Node, suite *domain.TestSuite) {
+	switch node.Type() {
+	case scalaast.NodeCallExpression:
+		if w.visitCall(node, suite) {
+			return
+		}
+	case scalaast.NodeInfixExpression:
+		if w.visitInfix(node, suite) {
+			return
+		}
+	case scalaast.NodeClassDefinition, scalaast.NodeObjectDefinition:
+		// Nested specs are reported on their own
+		return
+	}
+	w.walk(node, suite)
+}
+
+func (w *walker) visitCall(node *sitter.Node, suite *domain.TestSuite) bool {
+	call, ok := scalaast.CurriedCall(node, w.source)
+	if !ok || call.Receiver != nil || len(call.Args) == 0 {
+		return false
+	}
+	name, ok := scalaast.StringValue(call.Args[0], w.source)
+	if !ok {
+		return false
+	}
+
+	switch {
+	case suiteCalls[call.Name]:
+		nested := domain.TestSuite{
+			Name:     name,
+			Status:   domain.TestStatusActive,
+			Location: parser.GetLocation(node, w.filename),
+		}
+		if body := scalaast.BlockOf(call.Body); body != nil {
+			w.walk(body, &nested)
+		}
+		appendSuite(suite, nested)
+		return true
+
+	case testCalls[call.Name] || call.Name == identIgnore:
+		test := domain.Test{
+			Name:     name,
+			Status:   domain.TestStatusActive,
+			Location: parser.GetLocation(node, w.filename),
+			Tags:     w.tagNames(call.Args[1:]),
+		}
+		if call.Name == identIgnore {
+			test.Status = domain.TestStatusSkipped
+			test.Modifier = identIgnore
+		} else if w.isPendingBody(call.Body) {
+			test.Status = domain.TestStatusTodo
+			test.Modifier = identPending
+		}
+		suite.Tests = append(suite.Tests, test)
+		return true
+	}
+
+	return false
+}
+
+func (w *walker) visitInfix(node *sitter.Node, suite *domain.TestSuite) bool {
+	infix, ok := scalaast.InfixParts(node, w.source)
+	if !ok {
+		return false
+	}
+
+	// behavior of "subject"
+	if infix.Operator == opOf && scalaast.IsIdentifier(infix.Left, w.source, identBehave) {
+		if subject, ok := scalaast.StringValue(infix.Right, w.source); ok {
+			w.subject = subject
+		}
+		return true
+	}
+
+	if containerOps[infix.Operator] {
+		body := scalaast.BlockOf(infix.Right)
+		name, ok := scalaast.StringValue(infix.Left, w.source)
+		if body == nil || !ok {
+			return false
+		}
+		nested := domain.TestSuite{
+			Name:     name,
+			Status:   domain.TestStatusActive,
+			Location: parser.GetLocation(node, w.filename),
+		}
+		w.walk(body, &nested)
+		appendSuite(suite, nested)
+		return true
+	}
+
+	if infix.Operator != opIn && infix.Operator != opIgnore && infix.Operator != opIs {
+		return false
+	}
+
+	left, tags := w.stripTags(infix.Left)
+	test := domain.Test{
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(node, w.filename),
+		Tags:     tags,
+	}
+	switch infix.Operator {
+	case opIgnore:
+		test.Status = domain.TestStatusSkipped
+		test.Modifier = identIgnore
+	case opIs:
+		test.Status = domain.TestStatusTodo
+		test.Modifier = identPending
+	default:
+		if call := infix.Right; call.Type() == scalaast.NodeCallExpression &&
+			scalaast.IsIdentifier(call.ChildByFieldName("function"), w.source, identPUF) {
+			test.Status = domain.TestStatusXfail
+			test.Modifier = identPUF
+		}
+	}
+
+	// WordSpec/FreeSpec: "name" in { }
+	if name, ok := scalaast.StringValue(left, w.source); ok {
+		test.Name = name
+		suite.Tests = append(suite.Tests, test)
+		return true
+	}
+
+	// FlatSpec: subject verb "behavior" in { }
+	phrase, ok := scalaast.InfixParts(left, w.source)
+	if !ok || !flatSpecVerbs[phrase.Operator] {
+		return false
+	}
+	behavior, ok := scalaast.StringValue(phrase.Right, w.source)
+	if !ok {
+		return false
+	}
+	test.Name = phrase.Operator + " " + behavior
+
+	switch {
+	case scalaast.IsIdentifier(phrase.Left, w.source, identIgnore):
+		test.Status = domain.TestStatusSkipped
+		test.Modifier = identIgnore
+	case scalaast.IsIdentifier(phrase.Left, w.source, "it"), scalaast.IsIdentifier(phrase.Left, w.source, "they"):
+	default:
+		subject, ok := scalaast.StringValue(phrase.Left, w.source)
+		if !ok {
+			return false
+		}
+		w.subject = subject
+	}
+
+	if w.subject == "" {
+		suite.Tests = append(suite.Tests, test)
+		return true
+	}
+	subjectSuite := findOrAddSuite(suite, w.subject, test.Location)
+	subjectSuite.Tests = append(subjectSuite.Tests, test)
+	return true
+}
+
+// stripTags unwraps `... taggedAs(Slow, Db)` layers and returns the tag names.
+func (w *walker) stripTags(node *sitter.Node) (*sitter.Node, []string) {
+	var tags []string
+	for {
+		infix, ok := scalaast.InfixParts(node, w.source)
+		if !ok || infix.Operator != opTagged {
+			return node, tags
+		}
+		args := []*sitter.Node{infix.Right}
+		if infix.Right.Type() == scalaast.NodeParenthesizedExpression || infix.Right.Type() == scalaast.NodeTupleExpression {
+			args = scalaast.NamedChildren(infix.Right)
+		}
+		tags = append(w.tagNames(args), tags...)
+		node = infix.Left
+	}
+}
+
+// tagNames returns the names of Tag objects passed to a registration.
+func (w *walker) tagNames(args []*sitter.Node) []string {
+	var tags []string
+	for _, arg := range args {
+		switch arg.Type() {
+		case scalaast.NodeIdentifier, scalaast.NodeFieldExpression:
+			tags = append(tags, parser.GetNodeText(arg, w.source))
+		}
+	}
+	return tags
+}
+
+// isPendingBody reports a FunSpec-style `it("name") (pending)` registration.
+func (w *walker) isPendingBody(body *sitter.Node) bool {
+	if body == nil || body.Type() != scalaast.NodeArguments {
+		return false
+	}
+	args := scalaast.NamedChildren(body)
+	return len(args) == 1 && scalaast.IsIdentifier(args[0], w.source, identPending)
+}
+
+func appendSuite(parent *domain.TestSuite, nested domain.TestSuite) {
+	if len(nested.Tests) > 0 || len(nested.Suites) > 0 {
+		parent.Suites = append(parent.Suites, nested)
+	}
+}
+
+// findOrAddSuite returns the FlatSpec subject suite with the given name, creating it if needed.
+func findOrAddSuite(parent *domain.TestSuite, name string, location domain.Location) *domain.TestSuite {
+	for i := range parent.Suites {
+		if parent.Suites[i].Name == name {
+			return &parent.Suites[i]
+		}
+	}
+	parent.Suites = append(parent.Suites, domain.TestSuite{
+		Name:     name,
+		Status:   domain.TestStatusActive,
+		Location: location,
+	})
+	return &parent.Suites[len(parent.Suites)-1]
+}
@@ -0,0 +1,287 @@
+package scalatest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+func TestScalaTestParser_Parse(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		checkFunc func(t *testing.T, file *domain.TestFile)
+	}{
+		{
+			name: "AnyFunSuite with ignore and tags",
+			source: `
+package com.example
+
+import org.scalatest.funsuite.AnyFunSuite
+
+class SetSuite extends AnyFunSuite {
+  test("an empty Set should have size 0") {
+    assert(Set.empty.size == 0)
+  }
+
+  ignore("invoking head on an empty Set") {
+    Set.empty.head
+  }
+
+  test("slow lookup", Slow, DbTest) {}
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				suite := file.Suites[0]
+				if suite.Name != "SetSuite" {
+					t.Errorf("expected suite 'SetSuite', got %q", suite.Name)
+				}
+				if len(suite.Tests) != 3 {
+					t.Fatalf("expected 3 tests, got %d", len(suite.Tests))
+				}
+				if suite.Tests[0].Name != "an empty Set should have size 0" || suite.Tests[0].Location.StartLine != 7 {
+					t.Errorf("unexpected first test %q at line %d", suite.Tests[0].Name, suite.Tests[0].Location.StartLine)
+				}
+				if suite.Tests[1].Status != domain.TestStatusSkipped || suite.Tests[1].Modifier != "ignore" {
+					t.Errorf("expected ignored test, got %q/%q", suite.Tests[1].Status, suite.Tests[1].Modifier)
+				}
+				if tags := suite.Tests[2].Tags; len(tags) != 2 || tags[0] != "Slow" || tags[1] != "DbTest" {
+					t.Errorf("expected tags [Slow DbTest], got %v", tags)
+				}
+			},
+		},
+		{
+			name: "AnyFlatSpec subjects",
+			source: `
+import org.scalatest.flatspec.AnyFlatSpec
+
+class StackSpec extends AnyFlatSpec {
+  "A Stack" should "pop values in last-in-first-out order" in {}
+  it should "throw NoSuchElementException if an empty stack is popped" in {}
+  they should "be pending" is (pending)
+
+  behavior of "An empty Stack"
+  it must "be empty" taggedAs(Slow, Db) in {}
+  ignore should "not grow" in {}
+  it should "eventually work" in pendingUntilFixed {}
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				subjects := file.Suites[0].Suites
+				if len(subjects) != 2 {
+					t.Fatalf("expected 2 subjects, got %d", len(subjects))
+				}
+				stack := subjects[0]
+				if stack.Name != "A Stack" || len(stack.Tests) != 3 {
+					t.Fatalf("expected 'A Stack' with 3 tests, got %q with %d", stack.Name, len(stack.Tests))
+				}
+				if stack.Tests[0].Name != "should pop values in last-in-first-out order" {
+					t.Errorf("unexpected test name %q", stack.Tests[0].Name)
+				}
+				if stack.Tests[2].Status != domain.TestStatusTodo {
+					t.Errorf("expected pending test as todo, got %q", stack.Tests[2].Status)
+				}
+				empty := subjects[1]
+				if empty.Name != "An empty Stack" || len(empty.Tests) != 3 {
+					t.Fatalf("expected 'An empty Stack' with 3 tests, got %q with %d", empty.Name, len(empty.Tests))
+				}
+				if empty.Tests[0].Name != "must be empty" || len(empty.Tests[0].Tags) != 2 {
+					t.Errorf("expected tagged 'must be empty', got %q with tags %v", empty.Tests[0].Name, empty.Tests[0].Tags)
+				}
+				if empty.Tests[1].Status != domain.TestStatusSkipped {
+					t.Errorf("expected ignored test, got %q", empty.Tests[1].Status)
+				}
+				if empty.Tests[2].Status != domain.TestStatusXfail || empty.Tests[2].Modifier != "pendingUntilFixed" {
+					t.Errorf("expected xfail test, got %q/%q", empty.Tests[2].Status, empty.Tests[2].Modifier)
+				}
+			},
+		},
+		{
+			name: "AnyWordSpec nesting",
+			source: `
+class SetSpec extends AnyWordSpec {
+  "A Set" when {
+    "empty" should {
+      "have size 0" in {
+        assert(Set.empty.size == 0)
+      }
+      "produce NoSuchElementException when head is invoked" ignore {}
+    }
+  }
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 || len(file.Suites[0].Suites) != 1 {
+					t.Fatalf("expected class suite with 1 nested suite, got %+v", file.Suites)
+				}
+				set := file.Suites[0].Suites[0]
+				if set.Name != "A Set" || len(set.Suites) != 1 {
+					t.Fatalf("expected 'A Set' with 1 nested suite, got %q", set.Name)
+				}
+				empty := set.Suites[0]
+				if empty.Name != "empty" || len(empty.Tests) != 2 {
+					t.Fatalf("expected 'empty' with 2 tests, got %q with %d", empty.Name, len(empty.Tests))
+				}
+				if empty.Tests[1].Status != domain.TestStatusSkipped {
+					t.Errorf("expected ignored test, got %q", empty.Tests[1].Status)
+				}
+			},
+		},
+		{
+			name: "AnyFreeSpec nesting",
+			source: `
+class SetSpec extends AnyFreeSpec {
+  "A Set" - {
+    "when empty" - {
+      "should have size 0" in {}
+    }
+  }
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if file.CountTests() != 1 {
+					t.Fatalf("expected 1 test, got %d", file.CountTests())
+				}
+				set := file.Suites[0].Suites[0]
+				if set.Name != "A Set" || set.Suites[0].Name != "when empty" {
+					t.Errorf("unexpected nesting %q > %q", set.Name, set.Suites[0].Name)
+				}
+			},
+		},
+		{
+			name: "AnyFunSpec with ignored class",
+			source: `
+@Ignore
+class SetSpec extends AnyFunSpec {
+  describe("A Set") {
+    describe("when empty") {
+      it("should have size 0") {}
+      it("should be pending") (pending)
+      ignore("should be ignored") {}
+    }
+  }
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				if file.Suites[0].Status != domain.TestStatusSkipped {
+					t.Errorf("expected @Ignore suite to be skipped, got %q", file.Suites[0].Status)
+				}
+				empty := file.Suites[0].Suites[0].Suites[0]
+				if len(empty.Tests) != 3 {
+					t.Fatalf("expected 3 tests, got %d", len(empty.Tests))
+				}
+				if empty.Tests[1].Status != domain.TestStatusTodo {
+					t.Errorf("expected pending test as todo, got %q", empty.Tests[1].Status)
+				}
+			},
+		},
+		{
+			name: "AnyFeatureSpec and Scala 3 syntax",
+			source: `
+class TVSetSpec extends AnyFeatureSpec:
+  Feature("TV power button"):
+    Scenario("User presses power button when TV is off"):
+      assert(tv.isOn)
+    Scenario("User presses power button when TV is on"):
+      assert(!tv.isOn)
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 || len(file.Suites[0].Suites) != 1 {
+					t.Fatalf("expected class suite with 1 feature, got %+v", file.Suites)
+				}
+				feature := file.Suites[0].Suites[0]
+				if feature.Name != "TV power button" || len(feature.Tests) != 2 {
+					t.Errorf("expected feature with 2 scenarios, got %q with %d", feature.Name, len(feature.Tests))
+				}
+			},
+		},
+		{
+			name: "tests registered in a loop and non-spec classes",
+			source: `
+class Helper {
+  def test(name: String) = name
+}
+
+object ParserSuite extends AnyFunSuite {
+  Seq("a", "b").foreach { input =>
+    test(s"parses $input") {}
+  }
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				if len(file.Suites[0].Tests) != 1 || file.Suites[0].Tests[0].Name != "parses $input" {
+					t.Errorf("expected 1 dynamic test, got %+v", file.Suites[0].Tests)
+				}
+			},
+		},
+	}
+
+	parser := &ScalaTestParser{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := parser.Parse(ctx, []byte(tt.source), "SetSpec.scala")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if file.Framework != frameworkName {
+				t.Errorf("expected framework %q, got %q", frameworkName, file.Framework)
+			}
+
+			if file.Language != domain.LanguageScala {
+				t.Errorf("expected language Scala, got %q", file.Language)
+			}
+
+			if tt.checkFunc != nil {
+				tt.checkFunc(t, file)
+			}
+		})
+	}
+}
+
+func TestScalaTestContentMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantMatch bool
+	}{
+		{"scalatest import", "import org.scalatest.funsuite.AnyFunSuite", true},
+		{"AnyFlatSpec", "class A extends AnyFlatSpec with Matchers", true},
+		{"legacy WordSpec", "class A extends WordSpec", true},
+		{"munit FunSuite", "class A extends munit.FunSuite", false},
+		{"specs2 Specification", "class A extends Specification", false},
+	}
+
+	matcher := &ScalaTestContentMatcher{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := framework.Signal{
+				Type:    framework.SignalFileContent,
+				Value:   tt.content,
+				Context: []byte(tt.content),
+			}
+			result := matcher.Match(ctx, signal)
+			if (result.Confidence > 0) != tt.wantMatch {
+				t.Errorf("Match() confidence = %v, want match = %v", result.Confidence, tt.wantMatch)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Package batsfile rewrites Bats test files into plain shell, the way Bats itself
+// preprocesses them before running, so that they can be parsed as Bash.
+package batsfile
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	keywordTest = "@test"
+	// FunctionPrefix starts the names of the functions @test blocks are rewritten to.
+	FunctionPrefix = "bats_test_"
+	tagScopeFile   = "file_tags"
+	tagSeparator   = ","
+)
+
+// Tag comments: "# bats test_tags=a,b" applies to the next test, "# bats file_tags=a" to
+// the tests after it.
+var tagComment = regexp.MustCompile(`^#\s*bats\s+(test_tags|file_tags)\s*=\s*(.*)$`)
+
+// TestBlock is an @test block of a Bats file.
+type TestBlock struct {
+	Name string
+	// Function is the name of the function the block is rewritten to.
+	Function string
+	// Line and Column locate the @test keyword (1-based line, 0-based column).
+	Line   int
+	Column int
+	// EndLine and EndColumn locate the end of the closing brace, or of the last
+	// statement of a block without one.
+	EndLine   int
+	EndColumn int
+	Tags      []string
+}
+
+// Preprocess rewrites each @test "name" { header into a function header, keeping the
+// lines in place, and returns the rewritten source with the test blocks. The
+// tree-sitter Bash grammar cannot parse @test blocks: it reads the header as a command
+// and the statements after it, so tests merge and their bodies are misread.
+//
+// Like Bats, the headers and tag comments are found line by line. A block ends at the
+// first line holding only "}" indented no deeper than its header.
+func Preprocess(source []byte) ([]byte, []TestBlock) {
+	if !strings.Contains(string(source), keywordTest) {
+		return source, nil
+	}
+
+	lines := strings.Split(string(source), "\n")
+	var blocks []TestBlock
+	var fileTags, pendingTags []string
+	open := -1
+
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		indent := len(line) - len(strings.TrimLeft(line, " \t"))
+
+		if open >= 0 {
+			block := &blocks[open]
+			if trimmed == "}" && indent <= block.Column {
+				block.EndLine, block.EndColumn = i+1, len(strings.TrimRight(line, " \t\r"))
+				open = -1
+				continue
+			}
+			if trimmed != "" && !strings.HasPrefix(trimmed, keywordTest) {
+				block.EndLine, block.EndColumn = i+1, len(strings.TrimRight(line, " \t\r"))
+			}
+		}
+
+		if match := tagComment.FindStringSubmatch(trimmed); match != nil {
+			if match[1] == tagScopeFile {
+				fileTags = append(fileTags, splitTags(match[2])...)
+			} else {
+				pendingTags = append(pendingTags, splitTags(match[2])...)
+			}
+			continue
+		}
+
+		name, body, ok := testHeader(trimmed)
+		if !ok {
+			continue
+		}
+
+		block := TestBlock{
+			Name:      name,
+			Function:  FunctionPrefix + strconv.Itoa(len(blocks)+1),
+			Line:      i + 1,
+			Column:    indent,
+			EndLine:   i + 1,
+			EndColumn: len(strings.TrimRight(line, " \t\r")),
+		}
+		block.Tags = append(append(block.Tags, fileTags...), pendingTags...)
+		pendingTags = nil
+
+		lines[i] = line[:indent] + block.Function + "() {" + body
+		blocks = append(blocks, block)
+		open = len(blocks) - 1
+		if strings.HasSuffix(strings.TrimSpace(body), "}") {
+			// Single-line form: @test "name" { run cmd; }
+			open = -1
+		}
+	}
+
+	return []byte(strings.Join(lines, "\n")), blocks
+}
+
+// testHeader splits `@test "name" { body` into the unquoted name and the text after the
+// opening brace.
+func testHeader(line string) (name, body string, ok bool) {
+	rest, found := strings.CutPrefix(line, keywordTest)
+	if !found || rest == "" || (rest[0] != ' ' && rest[0] != '\t') {
+		return "", "", false
+	}
+	rest = strings.TrimLeft(rest, " \t")
+
+	// A quoted name may hold braces, so the brace is searched after it.
+	searchFrom := 0
+	if quote := rest[0]; quote == '"' || quote == '\'' {
+		if end := strings.IndexByte(rest[1:], quote); end >= 0 {
+			searchFrom = end + 2
+		}
+	}
+	for i := searchFrom; i < len(rest); i++ {
+		if rest[i] != '{' || i == 0 || (rest[i-1] != ' ' && rest[i-1] != '\t') {
+			continue
+		}
+		if i+1 < len(rest) && rest[i+1] != ' ' && rest[i+1] != '\t' {
+			continue
+		}
+		name = unquote(strings.TrimSpace(rest[:i]))
+		return name, rest[i+1:], name != ""
+	}
+	return "", "", false
+}
+
+func unquote(name string) string {
+	if len(name) >= 2 && (name[0] == '"' || name[0] == '\'') && name[len(name)-1] == name[0] {
+		return name[1 : len(name)-1]
+	}
+	return name
+}
+
+func splitTags(list string) []string {
+	var tags []string
+	for _, tag := range strings.Split(list, tagSeparator) {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
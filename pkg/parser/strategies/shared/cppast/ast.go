@@ -0,0 +1,592 @@
+// Package cppast provides shared C/C++ AST utilities for macro-based test framework parsers.
+// The helpers work on trees produced by both the C and C++ tree-sitter grammars.
+//
+// Test frameworks such as Catch2, doctest and Boost.Test declare tests with macros
+// (TEST_CASE("name") { ... }). Without preprocessing, tree-sitter parses these in one of three shapes:
+//
+//	expression_statement(call_expression) followed by a sibling compound_statement
+//	function_definition(function_declarator(identifier, parameter_list), compound_statement)
+//	function_definition(type_identifier, parenthesized_declarator, compound_statement)
+//
+// Macros normalizes these shapes into Macro values in source order.
+package cppast
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+)
+
+// C/C++ AST node types.
+const (
+	NodeArgumentList            = "argument_list"
+	NodeCallExpression          = "call_expression"
+	NodeCompoundStatement       = "compound_statement"
+	NodeConcatenatedString      = "concatenated_string"
+	NodeDeclaration             = "declaration"
+	NodeError                   = "ERROR"
+	NodeExpressionStatement     = "expression_statement"
+	NodeFunctionDeclarator      = "function_declarator"
+	NodeFunctionDefinition      = "function_definition"
+	NodeIdentifier              = "identifier"
+	NodeParameterList           = "parameter_list"
+	NodeParenthesizedDeclarator = "parenthesized_declarator"
+	NodeRawStringLiteral        = "raw_string_literal"
+	NodeStringContent           = "string_content"
+	NodeStringLiteral           = "string_literal"
+	NodeTypeIdentifier          = "type_identifier"
+)
+
+// containerTypes are statement containers whose children are flattened into the macro sequence.
+var containerTypes = map[string]bool{
+	"declaration_list":      true,
+	"do_statement":          true,
+	"else_clause":           true,
+	"for_range_loop":        true,
+	"for_statement":         true,
+	"if_statement":          true,
+	"linkage_specification": true,
+	"namespace_definition":  true,
+	"preproc_elif":          true,
+	"preproc_else":          true,
+	"preproc_if":            true,
+	"preproc_ifdef":         true,
+	"translation_unit":      true,
+	"while_statement":       true,
+	NodeCompoundStatement:   true,
+}
+
+// Macro is a normalized macro invocation such as TEST_CASE("name", "[tag]") { ... }.
+type Macro struct {
+	// Name is the macro identifier (e.g., "TEST_CASE").
+	Name string
+	// Node is the node where the invocation starts.
+	Node *sitter.Node
+	// Args are the argument nodes: expressions for call shape, parameter declarations otherwise.
+	Args []*sitter.Node
+	// Body is the compound_statement following the invocation, or nil if there is none.
+	Body *sitter.Node
+}
+
+// Arg returns the i-th argument node, or nil if out of range.
+func (m Macro) Arg(i int) *sitter.Node {
+	if i < 0 || i >= len(m.Args) {
+		return nil
+	}
+	return m.Args[i]
+}
+
+// ArgText returns the source text of the i-th argument with surrounding whitespace removed.
+func (m Macro) ArgText(i int, source []byte) string {
+	arg := m.Arg(i)
+	if arg == nil {
+		return ""
+	}
+	return strings.TrimSpace(parser.GetNodeText(arg, source))
+}
+
+// Location returns the location spanning the invocation and its body.
+func (m Macro) Location(filename string) domain.Location {
+	loc := parser.GetLocation(m.Node, filename)
+	if m.Body != nil {
+		end := parser.GetLocation(m.Body, filename)
+		loc.EndLine = end.EndLine
+		loc.EndCol = end.EndCol
+	}
+	return loc
+}
+
+// Macros returns the macro invocations within container in source order.
+// Nested containers (namespaces, preprocessor blocks, loops, plain blocks) are flattened,
+// while the bodies of recognized macros are not descended into.
+func Macros(container *sitter.Node, source []byte) []Macro {
+	var macros []Macro
+	collectMacros(container, source, &macros, 0)
+	return macros
+}
+
+func collectMacros(container *sitter.Node, source []byte, macros *[]Macro, depth int) {
+	if container == nil || depth > parser.MaxTreeDepth {
+		return
+	}
+
+	count := int(container.NamedChildCount())
+	for i := 0; i < count; i++ {
+		child := container.NamedChild(i)
+
+		switch child.Type() {
+		case NodeExpressionStatement:
+			m, ok := macroFromCall(child, source)
+			if !ok {
+				continue
+			}
+			if i+1 < count {
+				if next := container.NamedChild(i + 1); next.Type() == NodeCompoundStatement {
+					m.Body = next
+					i++
+				}
+			}
+			*macros = append(*macros, m)
+
+		case NodeFunctionDefinition:
+			if m, ok := macroFromFunctionDefinition(child, source); ok {
+				*macros = append(*macros, m)
+			}
+
+		case NodeDeclaration:
+			if m, ok := macroFromDeclaration(child, source); ok {
+				*macros = append(*macros, m)
+			}
+
+		case NodeError:
+			collectErrorMacros(child, source, macros)
+
+		default:
+			if containerTypes[child.Type()] {
+				collectMacros(child, source, macros, depth+1)
+			}
+		}
+	}
+}
+
+func macroFromCall(stmt *sitter.Node, source []byte) (Macro, bool) {
+	if stmt.NamedChildCount() == 0 {
+		return Macro{}, false
+	}
+	m, ok := macroFromCallExpression(stmt.NamedChild(0), source)
+	if !ok {
+		return Macro{}, false
+	}
+	m.Node = stmt
+	return m, true
+}
+
+func macroFromCallExpression(call *sitter.Node, source []byte) (Macro, bool) {
+	if call.Type() != NodeCallExpression {
+		return Macro{}, false
+	}
+
+	fn := call.ChildByFieldName("function")
+	if fn == nil || fn.Type() != NodeIdentifier {
+		return Macro{}, false
+	}
+
+	m := Macro{
+		Name: parser.GetNodeText(fn, source),
+		Node: call,
+	}
+	if args := call.ChildByFieldName("arguments"); args != nil {
+		for i := 0; i < int(args.NamedChildCount()); i++ {
+			m.Args = append(m.Args, args.NamedChild(i))
+		}
+	}
+	return m, true
+}
+
+// collectErrorMacros recovers invocations from ERROR nodes, which tree-sitter produces when
+// macro arguments are not valid expressions (e.g., the type list of TEMPLATE_TEST_CASE).
+// Recovered invocations of the form NAME(args...) have no body.
+func collectErrorMacros(node *sitter.Node, source []byte, macros *[]Macro) {
+	count := int(node.ChildCount())
+	for i := 0; i < count; i++ {
+		child := node.Child(i)
+
+		switch child.Type() {
+		case NodeIdentifier:
+			if i+1 >= count || node.Child(i+1).Type() != "(" {
+				continue
+			}
+			m := Macro{
+				Name: parser.GetNodeText(child, source),
+				Node: child,
+			}
+			for i += 2; i < count && node.Child(i).Type() != ")"; i++ {
+				arg := node.Child(i)
+				if arg.Type() == NodeCallExpression || arg.Type() == NodeExpressionStatement {
+					// The closing parenthesis was swallowed; the next invocation has begun
+					i--
+					break
+				}
+				if arg.IsNamed() {
+					m.Args = append(m.Args, arg)
+				}
+			}
+			*macros = append(*macros, m)
+
+		case NodeCallExpression:
+			if m, ok := macroFromCallExpression(child, source); ok {
+				*macros = append(*macros, m)
+			}
+
+		case NodeExpressionStatement:
+			if m, ok := macroFromCall(child, source); ok {
+				*macros = append(*macros, m)
+			}
+		}
+	}
+}
+
+// macroFromDeclaration recovers invocations such as BOOST_TEST_DECORATOR(* utf::disabled()),
+// which tree-sitter parses as a declaration of a parenthesized declarator.
+func macroFromDeclaration(node *sitter.Node, source []byte) (Macro, bool) {
+	typ := node.ChildByFieldName("type")
+	declarator := node.ChildByFieldName("declarator")
+	if typ == nil || declarator == nil ||
+		typ.Type() != NodeTypeIdentifier || declarator.Type() != NodeParenthesizedDeclarator {
+		return Macro{}, false
+	}
+
+	m := Macro{
+		Name: parser.GetNodeText(typ, source),
+		Node: node,
+	}
+	for i := 0; i < int(declarator.NamedChildCount()); i++ {
+		m.Args = append(m.Args, declarator.NamedChild(i))
+	}
+	return m, true
+}
+
+func macroFromFunctionDefinition(node *sitter.Node, source []byte) (Macro, bool) {
+	declarator := node.ChildByFieldName("declarator")
+	if declarator == nil {
+		return Macro{}, false
+	}
+
+	// Single-argument macros such as START_TEST(name) { ... } parse as a definition
+	// whose "type" is the macro name and whose declarator is the parenthesized argument.
+	if declarator.Type() == NodeParenthesizedDeclarator {
+		typ := node.ChildByFieldName("type")
+		if typ == nil || typ.Type() != NodeTypeIdentifier {
+			return Macro{}, false
+		}
+		m := Macro{
+			Name: parser.GetNodeText(typ, source),
+			Node: node,
+			Body: node.ChildByFieldName("body"),
+		}
+		for i := 0; i < int(declarator.NamedChildCount()); i++ {
+			m.Args = append(m.Args, declarator.NamedChild(i))
+		}
+		return m, true
+	}
+
+	if declarator.Type() != NodeFunctionDeclarator {
+		return Macro{}, false
+	}
+
+	ident := declarator.ChildByFieldName("declarator")
+	if ident == nil || ident.Type() != NodeIdentifier {
+		return Macro{}, false
+	}
+
+	// Real functions have a return type; macro invocations parsed as definitions do not.
+	if node.ChildByFieldName("type") != nil {
+		return Macro{}, false
+	}
+
+	m := Macro{
+		Name: parser.GetNodeText(ident, source),
+		Node: node,
+		Body: node.ChildByFieldName("body"),
+	}
+	if params := declarator.ChildByFieldName("parameters"); params != nil {
+		for i := 0; i < int(params.NamedChildCount()); i++ {
+			m.Args = append(m.Args, params.NamedChild(i))
+		}
+	}
+	return m, true
+}
+
+// Function is a function definition with a declared return type.
+type Function struct {
+	// Name is the function identifier.
+	Name string
+	// Node is the function_definition node.
+	Node *sitter.Node
+	// Params are the parameter_declaration nodes.
+	Params []*sitter.Node
+	// Body is the compound_statement of the function.
+	Body *sitter.Node
+}
+
+// TakesNoArguments reports whether the function is declared as f(void) or f().
+func (f Function) TakesNoArguments(source []byte) bool {
+	switch len(f.Params) {
+	case 0:
+		return true
+	case 1:
+		return strings.TrimSpace(parser.GetNodeText(f.Params[0], source)) == "void"
+	}
+	return false
+}
+
+// Functions returns the file-scope function definitions within root in source order,
+// including those nested in namespaces, linkage specifications and preprocessor blocks.
+func Functions(root *sitter.Node, source []byte) []Function {
+	var functions []Function
+	collectFunctions(root, source, &functions, 0)
+	return functions
+}
+
+func collectFunctions(container *sitter.Node, source []byte, functions *[]Function, depth int) {
+	if container == nil || depth > parser.MaxTreeDepth {
+		return
+	}
+
+	for i := 0; i < int(container.NamedChildCount()); i++ {
+		child := container.NamedChild(i)
+
+		if child.Type() != NodeFunctionDefinition {
+			if containerTypes[child.Type()] && child.Type() != NodeCompoundStatement {
+				collectFunctions(child, source, functions, depth+1)
+			}
+			continue
+		}
+
+		if child.ChildByFieldName("type") == nil {
+			continue
+		}
+
+		// Unwrap pointer return types: Suite *make_suite(void)
+		declarator := child.ChildByFieldName("declarator")
+		for declarator != nil && declarator.Type() == "pointer_declarator" {
+			declarator = declarator.ChildByFieldName("declarator")
+		}
+		if declarator == nil || declarator.Type() != NodeFunctionDeclarator {
+			continue
+		}
+
+		ident := declarator.ChildByFieldName("declarator")
+		if ident == nil || ident.Type() != NodeIdentifier {
+			continue
+		}
+
+		fn := Function{
+			Name: parser.GetNodeText(ident, source),
+			Node: child,
+			Body: child.ChildByFieldName("body"),
+		}
+		if params := declarator.ChildByFieldName("parameters"); params != nil {
+			for j := 0; j < int(params.NamedChildCount()); j++ {
+				fn.Params = append(fn.Params, params.NamedChild(j))
+			}
+		}
+		*functions = append(*functions, fn)
+	}
+}
+
+// EnclosingFunctionName returns the name of the function definition containing node, or "".
+func EnclosingFunctionName(node *sitter.Node, source []byte) string {
+	for current := node.Parent(); current != nil; current = current.Parent() {
+		if current.Type() != NodeFunctionDefinition {
+			continue
+		}
+		declarator := current.ChildByFieldName("declarator")
+		for declarator != nil && declarator.Type() != NodeFunctionDeclarator {
+			declarator = declarator.ChildByFieldName("declarator")
+		}
+		if declarator == nil {
+			return ""
+		}
+		if ident := declarator.ChildByFieldName("declarator"); ident != nil {
+			return parser.GetNodeText(ident, source)
+		}
+		return ""
+	}
+	return ""
+}
+
+// CallArgs returns the argument nodes of a call_expression.
+func CallArgs(call *sitter.Node) []*sitter.Node {
+	args := call.ChildByFieldName("arguments")
+	if args == nil {
+		return nil
+	}
+	var nodes []*sitter.Node
+	for i := 0; i < int(args.NamedChildCount()); i++ {
+		nodes = append(nodes, args.NamedChild(i))
+	}
+	return nodes
+}
+
+// CallName returns the function identifier of a call_expression, or "" if it is not a plain identifier.
+func CallName(call *sitter.Node, source []byte) string {
+	fn := call.ChildByFieldName("function")
+	if fn == nil || fn.Type() != NodeIdentifier {
+		return ""
+	}
+	return parser.GetNodeText(fn, source)
+}
+
+// AssignedName returns the variable a call result is assigned to, as in
+// `tc = tcase_create("Core")` or `CU_pSuite s = CU_add_suite(...)`. Returns "" otherwise.
+func AssignedName(call *sitter.Node, source []byte) string {
+	parent := call.Parent()
+	if parent == nil {
+		return ""
+	}
+
+	var target *sitter.Node
+	switch parent.Type() {
+	case "assignment_expression":
+		target = parent.ChildByFieldName("left")
+	case "init_declarator":
+		target = parent.ChildByFieldName("declarator")
+		for target != nil && target.Type() == "pointer_declarator" {
+			target = target.ChildByFieldName("declarator")
+		}
+	}
+	if target == nil || target.Type() != NodeIdentifier {
+		return ""
+	}
+	return parser.GetNodeText(target, source)
+}
+
+// StringValue returns the value of a string literal node, joining adjacent literals
+// ("a" "b") and unwrapping raw strings. Returns false for non-string nodes.
+func StringValue(node *sitter.Node, source []byte) (string, bool) {
+	if node == nil {
+		return "", false
+	}
+
+	switch node.Type() {
+	case NodeStringLiteral:
+		var sb strings.Builder
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			child := node.NamedChild(i)
+			if child.Type() == NodeStringContent || child.Type() == "escape_sequence" {
+				sb.WriteString(parser.GetNodeText(child, source))
+			}
+		}
+		return sb.String(), true
+
+	case NodeRawStringLiteral:
+		text := parser.GetNodeText(node, source)
+		open := strings.Index(text, "(")
+		closeIdx := strings.LastIndex(text, ")")
+		if open < 0 || closeIdx <= open {
+			return "", false
+		}
+		return text[open+1 : closeIdx], true
+
+	case NodeConcatenatedString:
+		var sb strings.Builder
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			part, ok := StringValue(node.NamedChild(i), source)
+			if !ok {
+				return "", false
+			}
+			sb.WriteString(part)
+		}
+		return sb.String(), true
+	}
+
+	return "", false
+}
+
+// FirstString returns the left-most string literal value in an expression,
+// such as the name in doctest's `"name" * doctest::skip()`.
+func FirstString(node *sitter.Node, source []byte) (string, bool) {
+	if node == nil {
+		return "", false
+	}
+	if value, ok := StringValue(node, source); ok {
+		return value, true
+	}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if value, ok := FirstString(node.NamedChild(i), source); ok {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// CalledFunctions returns the unqualified names of functions called within node
+// (e.g., "skip" for doctest::skip(), "disabled" for *boost::unit_test::disabled()).
+func CalledFunctions(node *sitter.Node, source []byte) []string {
+	if node == nil {
+		return nil
+	}
+
+	var names []string
+	parser.WalkTree(node, func(n *sitter.Node) bool {
+		if n.Type() != NodeCallExpression {
+			return true
+		}
+		fn := n.ChildByFieldName("function")
+		if fn == nil {
+			return true
+		}
+		name := parser.GetNodeText(fn, source)
+		if idx := strings.LastIndex(name, "::"); idx >= 0 {
+			name = name[idx+2:]
+		}
+		if idx := strings.Index(name, "<"); idx >= 0 {
+			name = name[:idx]
+		}
+		names = append(names, strings.TrimSpace(name))
+		return true
+	})
+	return names
+}
+
+// CaseAttributes holds the status and labels a test case passes down to its sections.
+type CaseAttributes struct {
+	Status   domain.TestStatus
+	Modifier string
+	Tags     []string
+}
+
+// SectionNamer returns the display name of a section macro (SECTION, SUBCASE, GIVEN, ...),
+// or false if the macro does not open a section.
+type SectionNamer func(m Macro, source []byte) (string, bool)
+
+// BuildCase converts a test case macro into a Test, or into a TestSuite when its body
+// contains sections. Sections with nested sections become suites and leaf sections become
+// tests, mirroring how Catch2 and doctest execute each leaf path as a separate run.
+// Exactly one of the returned values is non-nil.
+func BuildCase(name string, m Macro, attrs CaseAttributes, namer SectionNamer, source []byte, filename string) (*domain.Test, *domain.TestSuite) {
+	return buildCase(name, m, attrs, namer, source, filename, 0)
+}
+
+func buildCase(name string, m Macro, attrs CaseAttributes, namer SectionNamer, source []byte, filename string, depth int) (*domain.Test, *domain.TestSuite) {
+	var sections []Macro
+	var sectionNames []string
+	if m.Body != nil && depth < parser.MaxTreeDepth {
+		for _, child := range Macros(m.Body, source) {
+			if sectionName, ok := namer(child, source); ok && child.Body != nil {
+				sections = append(sections, child)
+				sectionNames = append(sectionNames, sectionName)
+			}
+		}
+	}
+
+	if len(sections) == 0 {
+		return &domain.Test{
+			Name:     name,
+			Status:   attrs.Status,
+			Modifier: attrs.Modifier,
+			Tags:     attrs.Tags,
+			Location: m.Location(filename),
+		}, nil
+	}
+
+	suite := &domain.TestSuite{
+		Name:     name,
+		Status:   attrs.Status,
+		Modifier: attrs.Modifier,
+		Location: m.Location(filename),
+	}
+	for i, section := range sections {
+		test, sub := buildCase(sectionNames[i], section, attrs, namer, source, filename, depth+1)
+		if sub != nil {
+			suite.Suites = append(suite.Suites, *sub)
+		} else {
+			suite.Tests = append(suite.Tests, *test)
+		}
+	}
+	return nil, suite
+}
@@ -224,6 +224,39 @@ func ParseAssignmentExpression(argNode *sitter.Node, source []byte) (string, str
 	return "", ""
 }
 
+// GetTraits returns the categories and traits of the attribute lists as tags, the way
+// dotnet test --filter matches them: [Category("Slow")], [TestCategory("Slow")] and
+// [Trait("Category", "Slow")] give "Slow", and [Trait("Priority", "1")] gives "Priority=1".
+func GetTraits(attributeLists []*sitter.Node, source []byte) []string {
+	var traits []string
+	for _, attr := range GetAttributes(attributeLists) {
+		name := strings.TrimSuffix(GetAttributeName(attr, source), "Attribute")
+		if name != "Category" && name != "TestCategory" && name != "Trait" {
+			continue
+		}
+
+		var args []string
+		if argList := FindAttributeArgumentList(attr); argList != nil {
+			for i := 0; i < int(argList.ChildCount()); i++ {
+				arg := argList.Child(i)
+				if arg.Type() == NodeAttributeArgument && arg.ChildCount() > 0 {
+					args = append(args, ExtractStringContent(arg.Child(0), source))
+				}
+			}
+		}
+
+		switch {
+		case name != "Trait" && len(args) > 0 && args[0] != "":
+			traits = append(traits, args[0])
+		case name == "Trait" && len(args) == 2 && args[0] == "Category":
+			traits = append(traits, args[1])
+		case name == "Trait" && len(args) == 2:
+			traits = append(traits, args[0]+"="+args[1])
+		}
+	}
+	return traits
+}
+
 // IsCSharpTestFileName checks if a filename follows C# test file naming conventions.
 // Matches: *Test.cs, *Tests.cs, Test*.cs, *Spec.cs, *Specs.cs
 func IsCSharpTestFileName(filename string) bool {
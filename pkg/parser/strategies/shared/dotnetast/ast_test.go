@@ -2,6 +2,7 @@ package dotnetast
 
 import (
 	"context"
+	"reflect"
 	"testing"
 
 	sitter "github.com/smacker/go-tree-sitter"
@@ -117,6 +118,30 @@ func TestHasAttribute(t *testing.T) {
 	}
 }
 
+func TestGetTraits(t *testing.T) {
+	source := `public class C {
+    [Fact, Trait("Category", "Slow"), Trait("Priority", "1")]
+    [Category("Db"), TestCategoryAttribute(@"Nightly")]
+    public void Test() { }
+}`
+	root := parseCS(t, source)
+
+	var attrLists []*sitter.Node
+	walkTree(root, func(n *sitter.Node) bool {
+		if n.Type() == NodeMethodDeclaration {
+			attrLists = GetAttributeLists(n)
+			return false
+		}
+		return true
+	})
+
+	got := GetTraits(attrLists, []byte(source))
+	want := []string{"Slow", "Priority=1", "Db", "Nightly"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetTraits() = %v, want %v", got, want)
+	}
+}
+
 func TestGetDeclarationList(t *testing.T) {
 	source := `public class C { public void M() { } }`
 	root := parseCS(t, source)
@@ -0,0 +1,47 @@
+// Package dotnetproj reads the .NET project files, solutions and run settings that
+// decide which C# files are tests and which framework runs them.
+package dotnetproj
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+const (
+	CSharpProjectExt = ".csproj"
+	FSharpProjectExt = ".fsproj"
+	SolutionExt      = ".sln"
+	RunSettingsExt   = ".runsettings"
+)
+
+// ConfigPatterns are the file name patterns of the files ConfigParser reads.
+var ConfigPatterns = []string{
+	"*" + CSharpProjectExt,
+	"*" + FSharpProjectExt,
+	"*" + SolutionExt,
+	"*" + RunSettingsExt,
+}
+
+// ConfigParser reads .csproj, .fsproj, .sln and .runsettings files.
+//
+// A project file decides the C# files below it: every file of a test project (one
+// referencing Microsoft.NET.Test.Sdk or a test framework, or setting IsTestProject)
+// is collected, under the framework its package references name, while the files of
+// other projects are not tests. A solution names its projects, and the TestCaseFilter
+// of a run settings file filters the tests by category and trait.
+type ConfigParser struct{}
+
+func (p *ConfigParser) Parse(ctx context.Context, configPath string, content []byte) (*framework.ConfigScope, error) {
+	switch strings.ToLower(filepath.Ext(configPath)) {
+	case CSharpProjectExt, FSharpProjectExt:
+		return parseProject(configPath, content)
+	case SolutionExt:
+		return parseSolution(configPath, content), nil
+	case RunSettingsExt:
+		return parseRunSettings(configPath, content)
+	}
+	return nil, nil
+}
@@ -0,0 +1,240 @@
+package dotnetproj
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+func parse(t *testing.T, configPath, content string) *framework.ConfigScope {
+	t.Helper()
+
+	scope, err := (&ConfigParser{}).Parse(context.Background(), filepath.FromSlash(configPath), []byte(content))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if scope == nil {
+		t.Fatal("Parse() returned nil scope")
+	}
+	return scope
+}
+
+func TestConfigParser_ParseProject(t *testing.T) {
+	tests := []struct {
+		name          string
+		configPath    string
+		content       string
+		wantFramework string
+		wantCollect   bool
+	}{
+		{
+			name:       "xunit test project",
+			configPath: "/repo/tests/Shop.Verification/Shop.Verification.csproj",
+			content: "\ufeff" + `<Project Sdk="Microsoft.NET.Sdk">
+  <ItemGroup>
+    <PackageReference Include="Microsoft.NET.Test.Sdk" Version="17.8.0" />
+    <PackageReference Include="xunit" Version="2.6.2" />
+    <PackageReference Include="xunit.runner.visualstudio" Version="2.5.4" />
+  </ItemGroup>
+</Project>`,
+			wantFramework: "xunit",
+			wantCollect:   true,
+		},
+		{
+			name:       "legacy nunit project",
+			configPath: "/repo/tests/Shop.Verification/Shop.Verification.csproj",
+			content: `<?xml version="1.0" encoding="utf-8"?>
+<Project ToolsVersion="15.0" xmlns="http://schemas.microsoft.com/developer/msbuild/2003">
+  <ItemGroup>
+    <Reference Include="nunit.framework, Version=3.14.0.0, Culture=neutral" />
+  </ItemGroup>
+</Project>`,
+			wantFramework: "nunit",
+			wantCollect:   true,
+		},
+		{
+			name:          "mstest sdk",
+			configPath:    "/repo/tests/Shop.Verification/Shop.Verification.csproj",
+			content:       `<Project Sdk="MSTest.Sdk/3.6.0"></Project>`,
+			wantFramework: "mstest",
+			wantCollect:   true,
+		},
+		{
+			name:       "is test project without framework",
+			configPath: "/repo/tests/Shop.Verification/Shop.Verification.csproj",
+			content: `<Project Sdk="Microsoft.NET.Sdk">
+  <PropertyGroup><IsTestProject>true</IsTestProject></PropertyGroup>
+</Project>`,
+			wantCollect: true,
+		},
+		{
+			name:       "several frameworks",
+			configPath: "/repo/tests/Shop.Verification/Shop.Verification.csproj",
+			content: `<Project Sdk="Microsoft.NET.Sdk">
+  <ItemGroup>
+    <PackageReference Include="NUnit" Version="3.14.0" />
+    <PackageReference Include="MSTest.TestFramework" Version="3.1.1" />
+  </ItemGroup>
+</Project>`,
+			wantCollect: true,
+		},
+		{
+			name:       "test utilities opting out",
+			configPath: "/repo/tests/Shop.Verification/Shop.Verification.csproj",
+			content: `<Project Sdk="Microsoft.NET.Sdk">
+  <PropertyGroup><IsTestProject>false</IsTestProject></PropertyGroup>
+  <ItemGroup><PackageReference Include="xunit" Version="2.6.2" /></ItemGroup>
+</Project>`,
+		},
+		{
+			name:       "production project",
+			configPath: "/repo/tests/Shop.Verification/Shop.Verification.csproj",
+			content: `<Project Sdk="Microsoft.NET.Sdk.Web">
+  <ItemGroup><PackageReference Include="Serilog" Version="3.1.1" /></ItemGroup>
+</Project>`,
+		},
+		{
+			name:       "fsharp test project",
+			configPath: "/repo/tests/Shop.Verification/Shop.Verification.fsproj",
+			content: `<Project Sdk="Microsoft.NET.Sdk">
+  <ItemGroup><PackageReference Include="xunit" Version="2.6.2" /></ItemGroup>
+</Project>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope := parse(t, tt.configPath, tt.content)
+			if scope.Framework != tt.wantFramework {
+				t.Errorf("Framework = %q, want %q", scope.Framework, tt.wantFramework)
+			}
+
+			file := filepath.FromSlash("/repo/tests/Shop.Verification/Orders/OrderFlow.cs")
+			if !scope.Decides(file) {
+				t.Errorf("expected Decides(%s)", file)
+			}
+			if got := scope.Collects(file); got != tt.wantCollect {
+				t.Errorf("Collects(%s) = %v, want %v", file, got, tt.wantCollect)
+			}
+
+			generated := filepath.FromSlash("/repo/tests/Shop.Verification/obj/Debug/GlobalUsings.g.cs")
+			if scope.Collects(generated) {
+				t.Errorf("expected build output %s not to be collected", generated)
+			}
+
+			project := scope.FindMatchingProject(file)
+			if tt.wantCollect && (project == nil || project.Name != "Shop.Verification") {
+				t.Errorf("FindMatchingProject(%s) = %v, want Shop.Verification", file, project)
+			}
+			if !tt.wantCollect && project != nil {
+				t.Errorf("FindMatchingProject(%s) = %v, want nil", file, project)
+			}
+		})
+	}
+
+	t.Run("compile remove", func(t *testing.T) {
+		scope := parse(t, "/repo/Shop.Tests/Shop.Tests.csproj", `<Project Sdk="Microsoft.NET.Sdk">
+  <ItemGroup>
+    <PackageReference Include="xunit" Version="2.6.2" />
+    <Compile Remove="Fixtures\**;Legacy/*.cs" />
+  </ItemGroup>
+</Project>`)
+		for path, want := range map[string]bool{
+			"/repo/Shop.Tests/OrderTests.cs":         true,
+			"/repo/Shop.Tests/Fixtures/Orders.cs":    false,
+			"/repo/Shop.Tests/Legacy/OldTests.cs":    false,
+			"/repo/Shop.Tests/Legacy/V1/OldTests.cs": true,
+		} {
+			if got := scope.Collects(filepath.FromSlash(path)); got != want {
+				t.Errorf("Collects(%s) = %v, want %v", path, got, want)
+			}
+		}
+	})
+
+	t.Run("invalid xml", func(t *testing.T) {
+		_, err := (&ConfigParser{}).Parse(context.Background(), "/repo/Shop.Tests/Shop.Tests.csproj", []byte("<Project><ItemGroup>"))
+		if err == nil {
+			t.Error("expected error for invalid XML")
+		}
+	})
+}
+
+func TestConfigParser_ParseSolution(t *testing.T) {
+	content := `
+Microsoft Visual Studio Solution File, Format Version 12.00
+Project("{9A19103F-16F7-4668-BE54-9A1E7A4F7556}") = "Shop", "src\Shop\Shop.csproj", "{11111111-1111-1111-1111-111111111111}"
+EndProject
+Project("{2150E333-8FDC-42A3-9474-1A3956D46DE8}") = "tests", "tests", "{22222222-2222-2222-2222-222222222222}"
+EndProject
+Project("{FAE04EC0-301F-11D3-BF4B-00C04F79EFBC}") = "Shop.Tests", "tests\Shop.Tests\Shop.Tests.csproj", "{33333333-3333-3333-3333-333333333333}"
+EndProject
+`
+	scope := parse(t, "/repo/Shop.sln", content)
+	if !scope.Passive {
+		t.Error("expected solution scope to be passive")
+	}
+
+	want := []framework.ProjectScope{
+		{Name: "Shop", BaseDir: filepath.FromSlash("/repo/src/Shop")},
+		{Name: "Shop.Tests", BaseDir: filepath.FromSlash("/repo/tests/Shop.Tests")},
+	}
+	if !reflect.DeepEqual(scope.Projects, want) {
+		t.Errorf("Projects = %+v, want %+v", scope.Projects, want)
+	}
+}
+
+func TestConfigParser_ParseRunSettings(t *testing.T) {
+	content := `<?xml version="1.0" encoding="utf-8"?>
+<RunSettings>
+  <RunConfiguration>
+    <TestCaseFilter>TestCategory=Unit|Category=Fast&amp;Category!=Slow</TestCaseFilter>
+  </RunConfiguration>
+</RunSettings>`
+
+	scope := parse(t, "/repo/test.runsettings", content)
+	if !scope.Passive {
+		t.Error("expected run settings scope to be passive")
+	}
+	if want := []string{"Unit", "Fast"}; !reflect.DeepEqual(scope.IncludeTags, want) {
+		t.Errorf("IncludeTags = %v, want %v", scope.IncludeTags, want)
+	}
+	if want := []string{"Slow"}; !reflect.DeepEqual(scope.ExcludeTags, want) {
+		t.Errorf("ExcludeTags = %v, want %v", scope.ExcludeTags, want)
+	}
+	if !scope.Contains(filepath.FromSlash("/repo/tests/Shop.Tests/OrderTests.cs")) {
+		t.Error("expected run settings to contain the C# files below it")
+	}
+}
+
+func TestParseTestCaseFilter(t *testing.T) {
+	tests := []struct {
+		filter      string
+		wantInclude []string
+		wantExclude []string
+	}{
+		{"", nil, nil},
+		{"Category=Unit", []string{"Unit"}, nil},
+		{"TestCategory!=Slow&TestCategory!=Flaky", nil, []string{"Slow", "Flaky"}},
+		{"Priority=1", nil, nil},
+		{"Owner=Payments", []string{"Owner=Payments"}, nil},
+		{"Category=Unit|FullyQualifiedName~Orders", nil, nil},
+		{"FullyQualifiedName!=Shop.Tests.OrderTests&Category=Unit", []string{"Unit"}, nil},
+		{"Category=Unit|Category!=Slow", nil, nil},
+		{"(Category=Unit|Category=Fast)&Category!=Slow", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			include, exclude := parseTestCaseFilter(tt.filter)
+			if !reflect.DeepEqual(include, tt.wantInclude) {
+				t.Errorf("include = %v, want %v", include, tt.wantInclude)
+			}
+			if !reflect.DeepEqual(exclude, tt.wantExclude) {
+				t.Errorf("exclude = %v, want %v", exclude, tt.wantExclude)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package dotnetproj
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+const (
+	csharpSources  = "**/*.cs"
+	testSdkPackage = "microsoft.net.test.sdk"
+	mstestSdk      = "mstest.sdk"
+)
+
+var (
+	// frameworkPackages maps the lowercased package and assembly references of test
+	// projects to the framework they run.
+	frameworkPackages = map[string]string{
+		"xunit":                "xunit",
+		"xunit.core":           "xunit",
+		"xunit.v3":             "xunit",
+		"xunit.v3.core":        "xunit",
+		"nunit":                "nunit",
+		"nunit.framework":      "nunit",
+		"mstest":               "mstest",
+		"mstest.testframework": "mstest",
+	}
+
+	// buildOutputDirs hold generated sources (AssemblyInfo, global usings) of the project.
+	buildOutputDirs = []string{"bin/**", "obj/**"}
+)
+
+type msbuildProject struct {
+	Sdk        string              `xml:"Sdk,attr"`
+	Properties []msbuildProperties `xml:"PropertyGroup"`
+	Items      []msbuildItems      `xml:"ItemGroup"`
+}
+
+type msbuildProperties struct {
+	IsTestProject string `xml:"IsTestProject"`
+}
+
+type msbuildItems struct {
+	PackageReferences []msbuildItem `xml:"PackageReference"`
+	References        []msbuildItem `xml:"Reference"`
+	Compile           []msbuildItem `xml:"Compile"`
+}
+
+type msbuildItem struct {
+	Include string `xml:"Include,attr"`
+	Remove  string `xml:"Remove,attr"`
+}
+
+// parseProject decides the C# files of a project. A C# test project collects them all
+// under the framework it references, and names itself after the project file so its
+// tests are grouped by project. F# projects compile no C# files, and the C# files of
+// other projects are not tests.
+func parseProject(configPath string, content []byte) (*framework.ConfigScope, error) {
+	var project msbuildProject
+	if err := decodeXML(content, &project); err != nil {
+		return nil, fmt.Errorf("dotnet project: failed to parse %s: %w", configPath, err)
+	}
+
+	scope := framework.NewConfigScope(configPath, "")
+	scope.ExclusivePatterns = []string{csharpSources}
+	scope.Exclude = append(slices.Clone(buildOutputDirs), project.compileRemoves()...)
+
+	frameworks, isTest := project.testSetup()
+	if !isTest || !strings.EqualFold(filepath.Ext(configPath), CSharpProjectExt) {
+		return scope, nil
+	}
+
+	// A project referencing several frameworks leaves detection to each file.
+	if len(frameworks) == 1 {
+		scope.Framework = frameworks[0]
+	}
+	scope.CollectPatterns = []string{csharpSources}
+	scope.Projects = []framework.ProjectScope{{
+		Name:    strings.TrimSuffix(filepath.Base(configPath), filepath.Ext(configPath)),
+		BaseDir: scope.BaseDir,
+	}}
+	return scope, nil
+}
+
+// testSetup returns the frameworks the project references and whether it is a test
+// project: one using MSTest.Sdk or referencing Microsoft.NET.Test.Sdk or a framework,
+// unless IsTestProject says otherwise.
+func (p *msbuildProject) testSetup() (frameworks []string, isTest bool) {
+	if strings.HasPrefix(strings.ToLower(p.Sdk), mstestSdk) {
+		frameworks = append(frameworks, "mstest")
+		isTest = true
+	}
+
+	for _, items := range p.Items {
+		for _, ref := range append(slices.Clone(items.PackageReferences), items.References...) {
+			id := ref.packageID()
+			if id == testSdkPackage {
+				isTest = true
+			}
+			if name, ok := frameworkPackages[id]; ok {
+				isTest = true
+				if !slices.Contains(frameworks, name) {
+					frameworks = append(frameworks, name)
+				}
+			}
+		}
+	}
+
+	for _, props := range p.Properties {
+		switch strings.ToLower(strings.TrimSpace(props.IsTestProject)) {
+		case "true":
+			isTest = true
+		case "false":
+			isTest = false
+		}
+	}
+	return frameworks, isTest
+}
+
+// compileRemoves returns the globs of the C# files the project leaves out of compilation.
+func (p *msbuildProject) compileRemoves() []string {
+	var patterns []string
+	for _, items := range p.Items {
+		for _, item := range items.Compile {
+			for _, pattern := range strings.Split(item.Remove, ";") {
+				pattern = strings.TrimPrefix(strings.TrimSpace(strings.ReplaceAll(pattern, "\\", "/")), "./")
+				if pattern != "" && !strings.Contains(pattern, "$") {
+					patterns = append(patterns, pattern)
+				}
+			}
+		}
+	}
+	return patterns
+}
+
+// packageID returns the lowercased package id of a PackageReference, or the assembly
+// name of a Reference ("nunit.framework, Version=3.14.0.0").
+func (i msbuildItem) packageID() string {
+	id, _, _ := strings.Cut(i.Include, ",")
+	return strings.ToLower(strings.TrimSpace(id))
+}
+
+func decodeXML(content []byte, v any) error {
+	decoder := xml.NewDecoder(bytes.NewReader(bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))))
+	// Element values are ASCII names and paths, so the raw bytes are read whatever the declared encoding.
+	decoder.CharsetReader = func(charset string, input io.Reader) (io.Reader, error) { return input, nil }
+	return decoder.Decode(v)
+}
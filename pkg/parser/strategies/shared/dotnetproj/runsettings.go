@@ -0,0 +1,95 @@
+package dotnetproj
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+// nonTraitProperties are the test case filter properties that select tests by name or
+// priority rather than by category or trait.
+var nonTraitProperties = map[string]bool{
+	"fullyqualifiedname": true,
+	"name":               true,
+	"classname":          true,
+	"displayname":        true,
+	"priority":           true,
+}
+
+type runSettings struct {
+	TestCaseFilter string `xml:"RunConfiguration>TestCaseFilter"`
+}
+
+// parseRunSettings reads the TestCaseFilter of a run settings file into tag filters
+// for the C# files below it. The scope is passive, as the project files decide which
+// files are tests.
+func parseRunSettings(configPath string, content []byte) (*framework.ConfigScope, error) {
+	var settings runSettings
+	if err := decodeXML(content, &settings); err != nil {
+		return nil, fmt.Errorf("runsettings: failed to parse %s: %w", configPath, err)
+	}
+
+	scope := framework.NewConfigScope(configPath, "")
+	scope.Passive = true
+	scope.Include = []string{csharpSources}
+	scope.IncludeTags, scope.ExcludeTags = parseTestCaseFilter(settings.TestCaseFilter)
+	return scope, nil
+}
+
+// parseTestCaseFilter converts a test case filter ("TestCategory=Unit|Category=Fast&
+// Priority!=2") to the tags it includes and excludes. Categories become their value
+// and other traits "Name=Value", as test tags are read. A condition on the test name
+// makes its disjunction unknown, so it is skipped, and grouped filters are skipped
+// as a whole.
+func parseTestCaseFilter(filter string) (include, exclude []string) {
+	filter = strings.TrimSpace(filter)
+	if filter == "" || strings.ContainsAny(filter, "()") {
+		return nil, nil
+	}
+
+	for _, clause := range strings.Split(filter, "&") {
+		terms := strings.Split(clause, "|")
+		var includes []string
+		known := true
+		for _, term := range terms {
+			tag, negated, ok := filterTag(term)
+			switch {
+			case !ok:
+				known = false
+			case negated && len(terms) == 1:
+				exclude = append(exclude, tag)
+			case negated:
+				known = false
+			default:
+				includes = append(includes, tag)
+			}
+		}
+		if known {
+			include = append(include, includes...)
+		}
+	}
+	return include, exclude
+}
+
+// filterTag returns the tag a filter condition compares against ("Category=Slow" is
+// the tag Slow) and whether the condition is negated. Contains (~) conditions and
+// conditions on non-trait properties are not tags.
+func filterTag(condition string) (tag string, negated, ok bool) {
+	property, value, found := strings.Cut(condition, "=")
+	if !found || strings.ContainsRune(property, '~') || strings.ContainsRune(value, '~') {
+		return "", false, false
+	}
+	property, negated = strings.CutSuffix(strings.TrimSpace(property), "!")
+	property = strings.TrimSpace(property)
+	value = strings.TrimSpace(value)
+	if property == "" || value == "" || nonTraitProperties[strings.ToLower(property)] {
+		return "", false, false
+	}
+
+	switch strings.ToLower(property) {
+	case "category", "testcategory":
+		return value, negated, true
+	}
+	return property + "=" + value, negated, true
+}
@@ -0,0 +1,36 @@
+package dotnetproj
+
+import (
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+// solutionProjectPattern matches the project entries of a solution:
+// Project("{FAE04EC0-...}") = "Shop.Tests", "tests\Shop.Tests\Shop.Tests.csproj", "{...}"
+var solutionProjectPattern = regexp.MustCompile(`(?m)^\s*Project\("\{[^}]*\}"\)\s*=\s*"([^"]+)"\s*,\s*"([^"]+)"`)
+
+// parseSolution lists the C# and F# projects of a solution, named as the solution
+// names them. The scope is passive: the project files decide their own files.
+func parseSolution(configPath string, content []byte) *framework.ConfigScope {
+	scope := framework.NewConfigScope(configPath, "")
+	scope.Passive = true
+
+	for _, m := range solutionProjectPattern.FindAllStringSubmatch(string(content), -1) {
+		projectPath := path.Clean(strings.ReplaceAll(m[2], "\\", "/"))
+		switch strings.ToLower(path.Ext(projectPath)) {
+		case CSharpProjectExt, FSharpProjectExt:
+		default:
+			// Solution folders and projects of other languages.
+			continue
+		}
+		scope.Projects = append(scope.Projects, framework.ProjectScope{
+			Name:    m[1],
+			BaseDir: filepath.Join(scope.BaseDir, filepath.FromSlash(path.Dir(projectPath))),
+		})
+	}
+	return scope
+}
@@ -0,0 +1,92 @@
+// Package gomod reads the go.mod and go.work files that give Go packages their import
+// paths and group modules into workspaces.
+package gomod
+
+import (
+	"bufio"
+	"bytes"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	ModFile  = "go.mod"
+	WorkFile = "go.work"
+)
+
+// ModulePath returns the path of the module directive of a go.mod, or "" if it has none.
+func ModulePath(content []byte) string {
+	for _, line := range lines(content) {
+		if rest, ok := cutDirective(line, "module"); ok {
+			return unquote(rest)
+		}
+	}
+	return ""
+}
+
+// UseDirs returns the module directories of the use directives of a go.work, written
+// singly (use ./shop) or in a block (use ( ./shop ./tools )), relative to the go.work.
+// Directories outside the workspace root are left out.
+func UseDirs(content []byte) []string {
+	var dirs []string
+	inBlock := false
+
+	for _, line := range lines(content) {
+		switch {
+		case inBlock && line == ")":
+			inBlock = false
+			continue
+		case inBlock:
+		default:
+			rest, ok := cutDirective(line, "use")
+			if !ok {
+				continue
+			}
+			if rest == "(" {
+				inBlock = true
+				continue
+			}
+			line = rest
+		}
+
+		dir := path.Clean(filepath.ToSlash(unquote(line)))
+		if dir != "" && !path.IsAbs(dir) && dir != ".." && !strings.HasPrefix(dir, "../") {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
+
+// lines returns the non-empty lines of content without // comments.
+func lines(content []byte) []string {
+	var result []string
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "//"); i >= 0 {
+			line = line[:i]
+		}
+		if line = strings.TrimSpace(line); line != "" {
+			result = append(result, line)
+		}
+	}
+	return result
+}
+
+// cutDirective returns the arguments of line if it is the given directive.
+func cutDirective(line, directive string) (string, bool) {
+	rest, ok := strings.CutPrefix(line, directive)
+	if !ok || rest == "" || !strings.ContainsAny(rest[:1], " \t(\"`") {
+		return "", false
+	}
+	return strings.TrimSpace(rest), true
+}
+
+func unquote(s string) string {
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		return unquoted
+	}
+	return s
+}
@@ -0,0 +1,700 @@
+package jsconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+	"github.com/specvital/core/pkg/parser/strategies/shared/jstest"
+)
+
+// maxDepth bounds expression nesting so deeply nested or self-referencing
+// configs cannot exhaust the stack.
+const maxDepth = 64
+
+const fileURLPrefix = "file://"
+
+// Evaluate parses a config module and returns the value it exports through
+// `export default`, `module.exports` or `export { x as default }`. Wrappers such as
+// defineConfig(...) are unwrapped and exported functions are called, so the
+// result is the config object itself. JSON files are decoded as is.
+//
+// __dirname, __filename and import.meta resolve against configPath, which
+// should be absolute for path.join/path.resolve results to be absolute.
+func Evaluate(ctx context.Context, configPath string, content []byte) (Value, error) {
+	if strings.EqualFold(filepath.Ext(configPath), ".json") {
+		var data interface{}
+		if err := json.Unmarshal(content, &data); err != nil {
+			return Unknown, fmt.Errorf("jsconfig: decode %s: %w", configPath, err)
+		}
+		return fromJSON(data), nil
+	}
+
+	tree, err := parser.ParseWithPool(ctx, moduleLanguage(configPath), content)
+	if err != nil {
+		return Unknown, fmt.Errorf("jsconfig: parse %s: %w", configPath, err)
+	}
+	defer tree.Close()
+
+	e := &evaluator{
+		source:   content,
+		filename: configPath,
+		dir:      filepath.Dir(configPath),
+		env:      make(map[string]Value),
+	}
+	return e.module(tree.RootNode()), nil
+}
+
+func moduleLanguage(configPath string) domain.Language {
+	switch strings.ToLower(filepath.Ext(configPath)) {
+	case ".js", ".mjs", ".cjs", ".jsx":
+		return domain.LanguageJavaScript
+	}
+	return domain.LanguageTypeScript
+}
+
+type evaluator struct {
+	source   []byte
+	filename string
+	dir      string
+	env      map[string]Value
+	depth    int
+}
+
+func (e *evaluator) text(node *sitter.Node) string {
+	return parser.GetNodeText(node, e.source)
+}
+
+// module walks the top-level statements in order, binding declarations and
+// recording the exported value.
+func (e *evaluator) module(root *sitter.Node) Value {
+	exported := Unknown
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		stmt := root.NamedChild(i)
+		switch stmt.Type() {
+		case "import_statement":
+			e.bindImport(stmt)
+		case "lexical_declaration", "variable_declaration":
+			e.bindDeclaration(stmt)
+		case "function_declaration":
+			e.bindFunction(stmt)
+		case "export_statement":
+			if value, ok := e.export(stmt); ok {
+				exported = value
+			}
+		case "expression_statement":
+			exported = e.assignExports(stmt, exported)
+		}
+	}
+	return e.resolve(exported)
+}
+
+// resolve calls exported config factories, e.g. `module.exports = async () => ({...})`.
+func (e *evaluator) resolve(value Value) Value {
+	if value.kind == kindFunction {
+		return e.call(value.fn)
+	}
+	return value
+}
+
+func (e *evaluator) export(stmt *sitter.Node) (Value, bool) {
+	isDefault := false
+	for i := 0; i < int(stmt.ChildCount()); i++ {
+		if stmt.Child(i).Type() == "default" {
+			isDefault = true
+		}
+	}
+
+	if decl := stmt.ChildByFieldName("declaration"); decl != nil {
+		switch decl.Type() {
+		case "lexical_declaration", "variable_declaration":
+			e.bindDeclaration(decl)
+		case "function_declaration", "function":
+			value := e.bindFunction(decl)
+			if isDefault {
+				return value, true
+			}
+		}
+		return Unknown, false
+	}
+
+	if value := stmt.ChildByFieldName("value"); value != nil && isDefault {
+		return e.eval(value), true
+	}
+
+	for i := 0; i < int(stmt.NamedChildCount()); i++ {
+		clause := stmt.NamedChild(i)
+		if clause.Type() != "export_clause" {
+			continue
+		}
+		for j := 0; j < int(clause.NamedChildCount()); j++ {
+			spec := clause.NamedChild(j)
+			alias := spec.ChildByFieldName("alias")
+			name := spec.ChildByFieldName("name")
+			if alias != nil && name != nil && e.text(alias) == "default" {
+				return e.env[e.text(name)], true
+			}
+		}
+	}
+	return Unknown, false
+}
+
+// assignExports handles `module.exports = ...` and `module.exports.key = ...`.
+func (e *evaluator) assignExports(stmt *sitter.Node, exported Value) Value {
+	assign := stmt.NamedChild(0)
+	if assign == nil || assign.Type() != "assignment_expression" {
+		return exported
+	}
+	left := assign.ChildByFieldName("left")
+	right := assign.ChildByFieldName("right")
+	if left == nil || right == nil {
+		return exported
+	}
+
+	switch target := e.text(left); {
+	case target == "module.exports" || target == "exports.default":
+		return e.eval(right)
+	case left.Type() == "member_expression":
+		owner := e.text(left.ChildByFieldName("object"))
+		if owner != "module.exports" && owner != "exports" {
+			return exported
+		}
+		if !exported.IsObject() {
+			exported = newObject()
+		}
+		exported.set(e.text(left.ChildByFieldName("property")), e.eval(right))
+	}
+	return exported
+}
+
+func (e *evaluator) bindDeclaration(decl *sitter.Node) {
+	for i := 0; i < int(decl.NamedChildCount()); i++ {
+		declarator := decl.NamedChild(i)
+		if declarator.Type() != "variable_declarator" {
+			continue
+		}
+		name := declarator.ChildByFieldName("name")
+		valueNode := declarator.ChildByFieldName("value")
+		if name == nil {
+			continue
+		}
+		value := Unknown
+		if valueNode != nil {
+			value = e.eval(valueNode)
+		}
+
+		switch name.Type() {
+		case "identifier":
+			e.env[e.text(name)] = value
+		case "object_pattern":
+			e.bindPattern(name, value)
+		}
+	}
+}
+
+// bindPattern binds `const { join, resolve: r } = require('path')` and
+// destructured object literals.
+func (e *evaluator) bindPattern(pattern *sitter.Node, value Value) {
+	for i := 0; i < int(pattern.NamedChildCount()); i++ {
+		prop := pattern.NamedChild(i)
+		switch prop.Type() {
+		case "shorthand_property_identifier_pattern":
+			name := e.text(prop)
+			e.env[name] = e.member(value, name)
+		case "pair_pattern":
+			key := prop.ChildByFieldName("key")
+			target := prop.ChildByFieldName("value")
+			if key != nil && target != nil && target.Type() == "identifier" {
+				e.env[e.text(target)] = e.member(value, e.text(key))
+			}
+		}
+	}
+}
+
+func (e *evaluator) bindFunction(decl *sitter.Node) Value {
+	value := e.function(decl)
+	if name := decl.ChildByFieldName("name"); name != nil {
+		e.env[e.text(name)] = value
+	}
+	return value
+}
+
+// bindImport binds imports of the path and url modules; other imports stay
+// unbound and evaluate to Unknown.
+func (e *evaluator) bindImport(stmt *sitter.Node) {
+	source := stmt.ChildByFieldName("source")
+	if source == nil {
+		return
+	}
+	module, ok := nodeModule(jstest.UnquoteString(e.text(source)))
+	if !ok {
+		return
+	}
+
+	for i := 0; i < int(stmt.NamedChildCount()); i++ {
+		clause := stmt.NamedChild(i)
+		if clause.Type() != "import_clause" {
+			continue
+		}
+		for j := 0; j < int(clause.NamedChildCount()); j++ {
+			item := clause.NamedChild(j)
+			switch item.Type() {
+			case "identifier":
+				e.env[e.text(item)] = Value{kind: kindModule, str: module}
+			case "namespace_import":
+				if id := item.NamedChild(0); id != nil {
+					e.env[e.text(id)] = Value{kind: kindModule, str: module}
+				}
+			case "named_imports":
+				for k := 0; k < int(item.NamedChildCount()); k++ {
+					spec := item.NamedChild(k)
+					name := spec.ChildByFieldName("name")
+					if name == nil {
+						continue
+					}
+					local := name
+					if alias := spec.ChildByFieldName("alias"); alias != nil {
+						local = alias
+					}
+					e.env[e.text(local)] = Value{kind: kindBuiltin, str: module + "." + e.text(name)}
+				}
+			}
+		}
+	}
+}
+
+// nodeModule maps a module specifier to the supported Node module it names.
+func nodeModule(specifier string) (string, bool) {
+	switch strings.TrimPrefix(specifier, "node:") {
+	case "path", "path/posix":
+		return "path", true
+	case "url":
+		return "url", true
+	}
+	return "", false
+}
+
+func (e *evaluator) eval(node *sitter.Node) Value {
+	if node == nil || e.depth >= maxDepth {
+		return Unknown
+	}
+	e.depth++
+	defer func() { e.depth-- }()
+
+	switch node.Type() {
+	case "string":
+		return stringValue(jstest.UnquoteString(e.text(node)))
+	case "template_string":
+		return e.template(node)
+	case "number":
+		text := strings.ReplaceAll(e.text(node), "_", "")
+		if n, err := strconv.ParseFloat(text, 64); err == nil {
+			return Value{kind: kindNumber, num: n}
+		}
+		return Unknown
+	case "true", "false":
+		return Value{kind: kindBool, b: node.Type() == "true"}
+	case "null", "undefined":
+		return Value{kind: kindNull}
+	case "identifier":
+		return e.identifier(e.text(node))
+	case "object":
+		return e.object(node)
+	case "array":
+		return e.array(node)
+	case "member_expression":
+		return e.memberExpression(node)
+	case "subscript_expression":
+		index := e.eval(node.ChildByFieldName("index"))
+		object := e.eval(node.ChildByFieldName("object"))
+		if index.kind == kindNumber && object.kind == kindArray {
+			if i := int(index.num); i >= 0 && i < len(object.items) {
+				return object.items[i]
+			}
+			return Unknown
+		}
+		if key, ok := index.text(); ok {
+			return e.member(object, key)
+		}
+		return Unknown
+	case "call_expression":
+		return e.callExpression(node)
+	case "new_expression":
+		return e.newExpression(node)
+	case "binary_expression":
+		return e.binary(node)
+	case "ternary_expression":
+		if cond, ok := e.eval(node.ChildByFieldName("condition")).truthy(); ok {
+			if cond {
+				return e.eval(node.ChildByFieldName("consequence"))
+			}
+			return e.eval(node.ChildByFieldName("alternative"))
+		}
+		return Unknown
+	case "arrow_function", "function", "function_expression":
+		return e.function(node)
+	case "parenthesized_expression", "as_expression", "satisfies_expression",
+		"non_null_expression", "await_expression":
+		return e.eval(node.NamedChild(0))
+	case "type_assertion":
+		return e.eval(node.NamedChild(int(node.NamedChildCount()) - 1))
+	}
+	return Unknown
+}
+
+func (e *evaluator) identifier(name string) Value {
+	switch name {
+	case "__dirname":
+		return stringValue(e.dir)
+	case "__filename":
+		return stringValue(e.filename)
+	case "undefined":
+		return Value{kind: kindNull}
+	}
+	return e.env[name]
+}
+
+func (e *evaluator) template(node *sitter.Node) Value {
+	var sb strings.Builder
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		part := node.NamedChild(i)
+		switch part.Type() {
+		case "string_fragment":
+			sb.WriteString(e.text(part))
+		case "escape_sequence":
+			sb.WriteString(jstest.UnquoteString(`"` + e.text(part) + `"`))
+		case "template_substitution":
+			s, ok := e.eval(part.NamedChild(0)).text()
+			if !ok {
+				return Unknown
+			}
+			sb.WriteString(s)
+		}
+	}
+	return stringValue(sb.String())
+}
+
+func (e *evaluator) object(node *sitter.Node) Value {
+	result := newObject()
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		prop := node.NamedChild(i)
+		switch prop.Type() {
+		case "pair":
+			if key, ok := e.propertyKey(prop.ChildByFieldName("key")); ok {
+				result.set(key, e.eval(prop.ChildByFieldName("value")))
+			}
+		case "shorthand_property_identifier":
+			name := e.text(prop)
+			result.set(name, e.identifier(name))
+		case "spread_element":
+			spread := e.eval(prop.NamedChild(0))
+			for _, key := range spread.Keys() {
+				result.set(key, spread.Get(key))
+			}
+		case "method_definition":
+			if key, ok := e.propertyKey(prop.ChildByFieldName("name")); ok {
+				result.set(key, e.function(prop))
+			}
+		}
+	}
+	return result
+}
+
+func (e *evaluator) propertyKey(key *sitter.Node) (string, bool) {
+	if key == nil {
+		return "", false
+	}
+	switch key.Type() {
+	case "property_identifier", "number":
+		return e.text(key), true
+	case "string":
+		return jstest.UnquoteString(e.text(key)), true
+	case "computed_property_name":
+		return e.eval(key.NamedChild(0)).text()
+	}
+	return "", false
+}
+
+func (e *evaluator) array(node *sitter.Node) Value {
+	result := Value{kind: kindArray}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		elem := node.NamedChild(i)
+		if elem.Type() == "comment" {
+			continue
+		}
+		if elem.Type() == "spread_element" {
+			result.items = append(result.items, e.eval(elem.NamedChild(0)).Items()...)
+			continue
+		}
+		result.items = append(result.items, e.eval(elem))
+	}
+	return result
+}
+
+func (e *evaluator) memberExpression(node *sitter.Node) Value {
+	objectNode := node.ChildByFieldName("object")
+	property := e.text(node.ChildByFieldName("property"))
+
+	if e.text(objectNode) == "import.meta" {
+		switch property {
+		case "dirname":
+			return stringValue(e.dir)
+		case "filename":
+			return stringValue(e.filename)
+		case "url":
+			return stringValue(fileURLPrefix + filepath.ToSlash(e.filename))
+		}
+		return Unknown
+	}
+	return e.member(e.eval(objectNode), property)
+}
+
+func (e *evaluator) member(object Value, property string) Value {
+	switch object.kind {
+	case kindObject:
+		return object.Get(property)
+	case kindModule:
+		if property == "posix" || property == "default" {
+			return object
+		}
+		return Value{kind: kindBuiltin, str: object.str + "." + property}
+	case kindString, kindArray:
+		if property == "length" {
+			n := len(object.str)
+			if object.kind == kindArray {
+				n = len(object.items)
+			}
+			return Value{kind: kindNumber, num: float64(n)}
+		}
+	}
+	return Unknown
+}
+
+func (e *evaluator) arguments(node *sitter.Node) []Value {
+	args := node.ChildByFieldName("arguments")
+	if args == nil {
+		return nil
+	}
+	var values []Value
+	for i := 0; i < int(args.NamedChildCount()); i++ {
+		if arg := args.NamedChild(i); arg.Type() != "comment" {
+			values = append(values, e.eval(arg))
+		}
+	}
+	return values
+}
+
+func (e *evaluator) callExpression(node *sitter.Node) Value {
+	callee := node.ChildByFieldName("function")
+	if callee == nil {
+		return Unknown
+	}
+	name := e.text(callee)
+
+	if name == "require" {
+		args := e.arguments(node)
+		if len(args) == 1 {
+			if specifier, ok := args[0].AsString(); ok {
+				if module, ok := nodeModule(specifier); ok {
+					return Value{kind: kindModule, str: module}
+				}
+			}
+		}
+		return Unknown
+	}
+
+	target := e.eval(callee)
+	switch target.kind {
+	case kindBuiltin:
+		return e.builtin(target.str, e.arguments(node))
+	case kindFunction:
+		return e.call(target.fn)
+	}
+
+	// Config helpers from the frameworks are identity functions around the
+	// config (or a factory returning it): defineConfig, defineProject, ...
+	if callee.Type() == "identifier" && target.kind == kindUnknown {
+		args := e.arguments(node)
+		switch {
+		case name == "mergeConfig" && len(args) >= 2:
+			result := e.resolve(args[0])
+			for _, arg := range args[1:] {
+				result = merge(result, e.resolve(arg))
+			}
+			return result
+		case strings.HasPrefix(name, "define") && len(args) >= 1:
+			return e.resolve(args[0])
+		}
+	}
+	return Unknown
+}
+
+// newExpression supports `new URL(relative, import.meta.url)`.
+func (e *evaluator) newExpression(node *sitter.Node) Value {
+	constructor := node.ChildByFieldName("constructor")
+	if constructor == nil || e.text(constructor) != "URL" {
+		return Unknown
+	}
+	args := e.arguments(node)
+	if len(args) != 2 {
+		return Unknown
+	}
+	rel, ok1 := args[0].AsString()
+	base, ok2 := args[1].AsString()
+	if !ok1 || !ok2 || !strings.HasPrefix(base, fileURLPrefix) {
+		return Unknown
+	}
+	basePath := strings.TrimPrefix(base, fileURLPrefix)
+	return stringValue(fileURLPrefix + filepath.ToSlash(filepath.Join(filepath.Dir(basePath), rel)))
+}
+
+func (e *evaluator) builtin(name string, args []Value) Value {
+	strs := make([]string, 0, len(args))
+	for _, arg := range args {
+		s, ok := arg.AsString()
+		if !ok {
+			return Unknown
+		}
+		strs = append(strs, s)
+	}
+
+	switch name {
+	case "path.join":
+		if len(strs) == 0 {
+			return stringValue(".")
+		}
+		return stringValue(filepath.Join(strs...))
+	case "path.resolve":
+		// Config files are loaded with the config directory as the working directory.
+		resolved := e.dir
+		for _, s := range strs {
+			if filepath.IsAbs(s) {
+				resolved = s
+			} else {
+				resolved = filepath.Join(resolved, s)
+			}
+		}
+		return stringValue(filepath.Clean(resolved))
+	case "path.dirname":
+		if len(strs) == 1 {
+			return stringValue(filepath.Dir(strs[0]))
+		}
+	case "path.basename":
+		if len(strs) >= 1 {
+			base := filepath.Base(strs[0])
+			if len(strs) == 2 {
+				base = strings.TrimSuffix(base, strs[1])
+			}
+			return stringValue(base)
+		}
+	case "url.fileURLToPath":
+		if len(strs) == 1 && strings.HasPrefix(strs[0], fileURLPrefix) {
+			return stringValue(filepath.Clean(filepath.FromSlash(strings.TrimPrefix(strs[0], fileURLPrefix))))
+		}
+	}
+	return Unknown
+}
+
+func (e *evaluator) binary(node *sitter.Node) Value {
+	operator := node.ChildByFieldName("operator")
+	if operator == nil {
+		return Unknown
+	}
+	left := e.eval(node.ChildByFieldName("left"))
+
+	switch e.text(operator) {
+	case "+":
+		right := e.eval(node.ChildByFieldName("right"))
+		if left.kind == kindNumber && right.kind == kindNumber {
+			return Value{kind: kindNumber, num: left.num + right.num}
+		}
+		if left.kind == kindString || right.kind == kindString {
+			l, ok1 := left.text()
+			r, ok2 := right.text()
+			if ok1 && ok2 {
+				return stringValue(l + r)
+			}
+		}
+	case "||":
+		if truthy, ok := left.truthy(); ok {
+			if truthy {
+				return left
+			}
+			return e.eval(node.ChildByFieldName("right"))
+		}
+	case "&&":
+		if truthy, ok := left.truthy(); ok {
+			if !truthy {
+				return left
+			}
+			return e.eval(node.ChildByFieldName("right"))
+		}
+	case "??":
+		if left.kind == kindNull {
+			return e.eval(node.ChildByFieldName("right"))
+		}
+		if left.Known() {
+			return left
+		}
+	}
+	return Unknown
+}
+
+func (e *evaluator) function(node *sitter.Node) Value {
+	return Value{kind: kindFunction, fn: &function{node: node, env: e.env}}
+}
+
+// call evaluates a function's result without arguments: an expression body, or
+// the first top-level return of a block body after its local declarations.
+func (e *evaluator) call(fn *function) Value {
+	body := fn.node.ChildByFieldName("body")
+	if body == nil || e.depth >= maxDepth {
+		return Unknown
+	}
+
+	local := make(map[string]Value, len(fn.env))
+	for name, value := range fn.env {
+		local[name] = value
+	}
+	// Arguments are not known statically, so parameters shadow outer bindings.
+	for _, param := range []string{"parameters", "parameter"} {
+		if params := fn.node.ChildByFieldName(param); params != nil {
+			e.unbind(params, local)
+		}
+	}
+	inner := &evaluator{source: e.source, filename: e.filename, dir: e.dir, env: local, depth: e.depth + 1}
+
+	if body.Type() != "statement_block" {
+		return inner.eval(body)
+	}
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		stmt := body.NamedChild(i)
+		switch stmt.Type() {
+		case "lexical_declaration", "variable_declaration":
+			inner.bindDeclaration(stmt)
+		case "function_declaration":
+			inner.bindFunction(stmt)
+		case "return_statement":
+			return inner.resolve(inner.eval(stmt.NamedChild(0)))
+		}
+	}
+	return Unknown
+}
+
+func (e *evaluator) unbind(node *sitter.Node, env map[string]Value) {
+	switch node.Type() {
+	case "identifier", "shorthand_property_identifier_pattern":
+		env[e.text(node)] = Unknown
+		return
+	}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		e.unbind(node.NamedChild(i), env)
+	}
+}
@@ -0,0 +1,181 @@
+package jsconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func evaluate(t *testing.T, configPath, content string) Value {
+	t.Helper()
+	value, err := Evaluate(context.Background(), configPath, []byte(content))
+	require.NoError(t, err)
+	return value
+}
+
+func TestEvaluate_Exports(t *testing.T) {
+	tests := []struct {
+		name       string
+		configPath string
+		content    string
+	}{
+		{
+			name:       "export default object",
+			configPath: "/project/vitest.config.ts",
+			content:    `export default { testDir: 'e2e' };`,
+		},
+		{
+			name:       "defineConfig with satisfies",
+			configPath: "/project/vitest.config.ts",
+			content: `import { defineConfig } from 'vitest/config';
+export default defineConfig({ testDir: 'e2e' }) satisfies UserConfig;`,
+		},
+		{
+			name:       "defineConfig factory",
+			configPath: "/project/vite.config.mts",
+			content: `export default defineConfig(({ mode }) => {
+  const dir = 'e2e';
+  return { testDir: dir };
+});`,
+		},
+		{
+			name:       "module.exports",
+			configPath: "/project/jest.config.js",
+			content:    `module.exports = { testDir: 'e2e' };`,
+		},
+		{
+			name:       "module.exports async factory",
+			configPath: "/project/jest.config.js",
+			content:    `module.exports = async () => ({ testDir: 'e2e' });`,
+		},
+		{
+			name:       "module.exports property assignments",
+			configPath: "/project/jest.config.cjs",
+			content:    `module.exports.testDir = 'e2e';`,
+		},
+		{
+			name:       "exported identifier",
+			configPath: "/project/playwright.config.ts",
+			content: `const config: PlaywrightTestConfig = { testDir: 'e2e' };
+export default config;`,
+		},
+		{
+			name:       "export clause as default",
+			configPath: "/project/playwright.config.ts",
+			content: `const config = { testDir: 'e2e' };
+export { config as default };`,
+		},
+		{
+			name:       "export default function",
+			configPath: "/project/jest.config.js",
+			content:    `export default function config() { return { testDir: 'e2e' }; }`,
+		},
+		{
+			name:       "json",
+			configPath: "/project/jest.config.json",
+			content:    `{"testDir": "e2e"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := evaluate(t, tt.configPath, tt.content)
+			testDir, ok := config.Get("testDir").AsString()
+			assert.True(t, ok)
+			assert.Equal(t, "e2e", testDir)
+		})
+	}
+}
+
+func TestEvaluate_Expressions(t *testing.T) {
+	content := `import path, { join } from 'node:path';
+import { fileURLToPath } from 'url';
+const { resolve } = require('path');
+const here = path.dirname(fileURLToPath(import.meta.url));
+const shared = { include: ['src/**/*.test.ts'], globals: true };
+export const testDirRoot = 'e2e/';
+const suffix = 'spec';
+
+export default defineConfig({
+  joined: path.join(__dirname, 'tests'),
+  named: join(testDirRoot, '/admin'),
+  resolved: resolve(__dirname, '../shared', './fixtures'),
+  relative: path.resolve('src'),
+  here,
+  template: ` + "`**/*.${suffix}.ts`" + `,
+  concat: testDirRoot + 'api',
+  fallback: undefined ?? 'default',
+  env: process.env.TEST_DIR || 'tests',
+  test: { ...shared, globals: false },
+  list: [...shared.include, 'lib/**/*.test.ts'],
+  nested: shared['include'][0],
+  setup() { return {}; },
+});`
+	config := evaluate(t, "/project/apps/web/vitest.config.ts", content)
+
+	str := func(keys ...string) string {
+		s, _ := config.Lookup(keys...).AsString()
+		return s
+	}
+	assert.Equal(t, "/project/apps/web/tests", str("joined"))
+	assert.Equal(t, "e2e/admin", str("named"))
+	assert.Equal(t, "/project/apps/shared/fixtures", str("resolved"))
+	assert.Equal(t, "/project/apps/web/src", str("relative"))
+	assert.Equal(t, "/project/apps/web", str("here"))
+	assert.Equal(t, "**/*.spec.ts", str("template"))
+	assert.Equal(t, "e2e/api", str("concat"))
+	assert.Equal(t, "default", str("fallback"))
+	assert.False(t, config.Get("env").Known(), "process.env is not known statically")
+	assert.Equal(t, []string{"src/**/*.test.ts"}, config.Lookup("test", "include").Strings())
+	globals, ok := config.Lookup("test", "globals").AsBool()
+	assert.True(t, ok)
+	assert.False(t, globals)
+	assert.Equal(t, []string{"src/**/*.test.ts", "lib/**/*.test.ts"}, config.Get("list").Strings())
+	assert.Equal(t, "src/**/*.test.ts", str("nested"))
+	assert.Equal(t, []string{"joined", "named", "resolved", "relative", "here", "template", "concat",
+		"fallback", "env", "test", "list", "nested", "setup"}, config.Keys())
+}
+
+func TestEvaluate_MergeConfig(t *testing.T) {
+	content := `import { defineConfig, mergeConfig } from 'vitest/config';
+import viteConfig from './vite.config';
+
+const base = { test: { include: ['a.test.ts'], environment: 'node' } };
+
+export default mergeConfig(base, defineConfig({
+  test: { include: ['b.test.ts'], globals: true },
+}));`
+	config := evaluate(t, "/project/vitest.config.ts", content)
+
+	assert.Equal(t, []string{"a.test.ts", "b.test.ts"}, config.Lookup("test", "include").Strings())
+	env, _ := config.Lookup("test", "environment").AsString()
+	assert.Equal(t, "node", env)
+	globals, _ := config.Lookup("test", "globals").AsBool()
+	assert.True(t, globals)
+}
+
+func TestEvaluate_Unresolvable(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"no export", `const config = { testDir: 'e2e' };`},
+		{"imported config", `import config from './base.config';
+export default config;`},
+		{"call result", `export default createConfig({ testDir: 'e2e' });`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := evaluate(t, "/project/playwright.config.ts", tt.content)
+			assert.False(t, config.Get("testDir").Known())
+		})
+	}
+}
+
+func TestEvaluate_InvalidJSON(t *testing.T) {
+	_, err := Evaluate(context.Background(), "/project/jest.config.json", []byte(`{`))
+	assert.Error(t, err)
+}
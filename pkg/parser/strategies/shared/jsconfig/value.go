@@ -0,0 +1,231 @@
+// Package jsconfig statically evaluates JavaScript and TypeScript config modules
+// (jest.config.ts, vitest.config.mts, playwright.config.js, ...) without running them.
+package jsconfig
+
+import (
+	"sort"
+	"strconv"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+type kind int
+
+const (
+	kindUnknown kind = iota
+	kindNull
+	kindBool
+	kindNumber
+	kindString
+	kindArray
+	kindObject
+	// kindFunction holds an arrow function or function declaration; calling it
+	// evaluates its returned expression.
+	kindFunction
+	// kindModule is a namespace import of a supported Node module (path, url).
+	kindModule
+	// kindBuiltin is a supported Node function such as path.join.
+	kindBuiltin
+)
+
+// Value is a statically evaluated JavaScript value. Expressions that depend on
+// runtime state (process.env, imported helpers, call results) evaluate to an
+// unknown Value, which reads as absent through every accessor.
+type Value struct {
+	kind  kind
+	str   string
+	num   float64
+	b     bool
+	items []Value
+	obj   *object
+	fn    *function
+}
+
+type object struct {
+	keys   []string
+	fields map[string]Value
+}
+
+type function struct {
+	node *sitter.Node
+	env  map[string]Value
+}
+
+// Unknown is the value of expressions the evaluator cannot resolve.
+var Unknown = Value{}
+
+func stringValue(s string) Value { return Value{kind: kindString, str: s} }
+
+func newObject() Value {
+	return Value{kind: kindObject, obj: &object{fields: make(map[string]Value)}}
+}
+
+func (v Value) set(key string, val Value) {
+	if _, ok := v.obj.fields[key]; !ok {
+		v.obj.keys = append(v.obj.keys, key)
+	}
+	v.obj.fields[key] = val
+}
+
+// Known reports whether the value was resolved statically.
+func (v Value) Known() bool {
+	return v.kind != kindUnknown
+}
+
+// IsObject reports whether the value is an object literal.
+func (v Value) IsObject() bool {
+	return v.kind == kindObject
+}
+
+// Get returns the named property of an object, or Unknown.
+func (v Value) Get(key string) Value {
+	if v.kind != kindObject {
+		return Unknown
+	}
+	return v.obj.fields[key]
+}
+
+// Lookup follows a chain of property names, e.g. Lookup("test", "include").
+func (v Value) Lookup(keys ...string) Value {
+	for _, key := range keys {
+		v = v.Get(key)
+	}
+	return v
+}
+
+// Keys returns the property names of an object in declaration order.
+func (v Value) Keys() []string {
+	if v.kind != kindObject {
+		return nil
+	}
+	return v.obj.keys
+}
+
+// Items returns the elements of an array.
+func (v Value) Items() []Value {
+	if v.kind != kindArray {
+		return nil
+	}
+	return v.items
+}
+
+// AsString returns the value of a string.
+func (v Value) AsString() (string, bool) {
+	return v.str, v.kind == kindString
+}
+
+// AsBool returns the value of a boolean.
+func (v Value) AsBool() (bool, bool) {
+	return v.b, v.kind == kindBool
+}
+
+// Strings returns a string as a single-element slice, or the string elements of
+// an array. Elements that are not strings are skipped.
+func (v Value) Strings() []string {
+	switch v.kind {
+	case kindString:
+		return []string{v.str}
+	case kindArray:
+		var result []string
+		for _, item := range v.items {
+			if s, ok := item.AsString(); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	return nil
+}
+
+// text converts primitives the way template literals and string concatenation do.
+func (v Value) text() (string, bool) {
+	switch v.kind {
+	case kindString:
+		return v.str, true
+	case kindNumber:
+		return strconv.FormatFloat(v.num, 'f', -1, 64), true
+	case kindBool:
+		return strconv.FormatBool(v.b), true
+	case kindNull:
+		return "null", true
+	}
+	return "", false
+}
+
+// truthy reports JavaScript truthiness; ok is false when it cannot be decided.
+func (v Value) truthy() (truthy bool, ok bool) {
+	switch v.kind {
+	case kindNull:
+		return false, true
+	case kindBool:
+		return v.b, true
+	case kindNumber:
+		return v.num != 0, true
+	case kindString:
+		return v.str != "", true
+	case kindArray, kindObject, kindFunction, kindModule, kindBuiltin:
+		return true, true
+	}
+	return false, false
+}
+
+// merge deep-merges override into base the way Vite's mergeConfig does:
+// objects merge recursively, arrays concatenate, anything else is replaced.
+func merge(base, override Value) Value {
+	if base.kind != kindObject || override.kind != kindObject {
+		if base.kind == kindArray && override.kind == kindArray {
+			items := append(append([]Value{}, base.items...), override.items...)
+			return Value{kind: kindArray, items: items}
+		}
+		if override.kind == kindUnknown {
+			return base
+		}
+		return override
+	}
+
+	result := newObject()
+	for _, key := range base.obj.keys {
+		result.set(key, base.obj.fields[key])
+	}
+	for _, key := range override.obj.keys {
+		if existing, ok := result.obj.fields[key]; ok {
+			result.set(key, merge(existing, override.obj.fields[key]))
+		} else {
+			result.set(key, override.obj.fields[key])
+		}
+	}
+	return result
+}
+
+// fromJSON converts a decoded JSON document. Object keys are sorted because
+// encoding/json does not keep their order.
+func fromJSON(data interface{}) Value {
+	switch d := data.(type) {
+	case nil:
+		return Value{kind: kindNull}
+	case bool:
+		return Value{kind: kindBool, b: d}
+	case float64:
+		return Value{kind: kindNumber, num: d}
+	case string:
+		return stringValue(d)
+	case []interface{}:
+		items := make([]Value, 0, len(d))
+		for _, item := range d {
+			items = append(items, fromJSON(item))
+		}
+		return Value{kind: kindArray, items: items}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(d))
+		for key := range d {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		result := newObject()
+		for _, key := range keys {
+			result.set(key, fromJSON(d[key]))
+		}
+		return result
+	}
+	return Unknown
+}
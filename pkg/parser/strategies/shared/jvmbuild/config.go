@@ -0,0 +1,77 @@
+// Package jvmbuild reads the test setup of Maven and Gradle builds, which run the
+// tests of every JVM framework (JUnit, TestNG, Kotest, ...).
+package jvmbuild
+
+import (
+	"context"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+const (
+	PomFile         = "pom.xml"
+	GradleFile      = "build.gradle"
+	GradleKotlinDSL = "build.gradle.kts"
+)
+
+// sourceExtensions are the test sources a build scope decides. Groovy sources are left
+// to the naming conventions, as Spock specs rarely match the runner defaults.
+const sourceExtensions = ".{java,kt}"
+
+// ConfigParser reads pom.xml, build.gradle and build.gradle.kts.
+//
+// The Java and Kotlin files under the test source directories are decided by the
+// build alone: by the Surefire and Failsafe includes and excludes for Maven, and as
+// a whole for each Gradle test source set. Failsafe tests and integration test
+// source sets are marked as integration tests. The scopes name no framework, since
+// the build runs them all; detection still picks the framework per file.
+type ConfigParser struct{}
+
+func (p *ConfigParser) Parse(ctx context.Context, configPath string, content []byte) (*framework.ConfigScope, error) {
+	scope := framework.NewConfigScope(configPath, "")
+	if err := Apply(scope, configPath, content); err != nil {
+		return nil, err
+	}
+	return scope, nil
+}
+
+// Apply adds the test setup of the build file at configPath to scope. It lets the
+// parsers of frameworks that claim build files (Cucumber-JVM) keep the build setup.
+func Apply(scope *framework.ConfigScope, configPath string, content []byte) error {
+	switch filepath.Base(configPath) {
+	case PomFile:
+		return applyPom(scope, configPath, content)
+	case GradleFile, GradleKotlinDSL:
+		applyGradle(scope, string(content))
+	}
+	return nil
+}
+
+// decideSources makes the scope decide the Java and Kotlin files under dir.
+func decideSources(scope *framework.ConfigScope, dir string) {
+	scope.ExclusivePatterns = append(scope.ExclusivePatterns, path.Join(dir, "**", "*"+sourceExtensions))
+}
+
+// cleanDir normalizes a source directory of the build ("${project.basedir}/src/it/java",
+// "$projectDir/src/it/java/") to a path relative to the build file directory.
+// Directories still holding variables are unresolvable and dropped.
+func cleanDir(dir string) string {
+	dir = strings.TrimSpace(strings.ReplaceAll(dir, "\\", "/"))
+	for _, prefix := range []string{"${project.basedir}", "${basedir}", "${projectDir}", "$projectDir"} {
+		if rest, ok := strings.CutPrefix(dir, prefix); ok {
+			dir = strings.TrimPrefix(rest, "/")
+			break
+		}
+	}
+	if dir == "" || strings.Contains(dir, "$") || path.IsAbs(dir) {
+		return ""
+	}
+	dir = path.Clean(dir)
+	if dir == "." || strings.HasPrefix(dir, "..") {
+		return ""
+	}
+	return dir
+}
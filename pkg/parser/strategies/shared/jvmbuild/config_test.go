@@ -0,0 +1,282 @@
+package jvmbuild
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+type fileExpectation struct {
+	path            string
+	wantDecided     bool
+	wantCollect     bool
+	wantIntegration bool
+}
+
+func checkFiles(t *testing.T, content, configPath string, files []fileExpectation) {
+	t.Helper()
+
+	scope, err := (&ConfigParser{}).Parse(context.Background(), filepath.FromSlash(configPath), []byte(content))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if scope.Framework != "" {
+		t.Errorf("expected no framework, got %q", scope.Framework)
+	}
+	for _, tt := range files {
+		filePath := filepath.FromSlash(tt.path)
+		if got := scope.Decides(filePath); got != tt.wantDecided {
+			t.Errorf("Decides(%s) = %v, want %v", tt.path, got, tt.wantDecided)
+		}
+		if got := scope.Collects(filePath); got != tt.wantCollect {
+			t.Errorf("Collects(%s) = %v, want %v", tt.path, got, tt.wantCollect)
+		}
+		if got := scope.Integrates(filePath); got != tt.wantIntegration {
+			t.Errorf("Integrates(%s) = %v, want %v", tt.path, got, tt.wantIntegration)
+		}
+	}
+}
+
+type tagFilterExpectation struct {
+	path        string
+	wantInclude []string
+	wantExclude []string
+}
+
+func checkTagFilters(t *testing.T, content, configPath string, files []tagFilterExpectation) {
+	t.Helper()
+
+	scope, err := (&ConfigParser{}).Parse(context.Background(), filepath.FromSlash(configPath), []byte(content))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	ps := framework.NewProjectScope()
+	ps.AddConfig(filepath.FromSlash(configPath), scope)
+	for _, tt := range files {
+		include, exclude := ps.FindTagFilters(filepath.FromSlash(tt.path))
+		if !reflect.DeepEqual(include, tt.wantInclude) {
+			t.Errorf("FindTagFilters(%s) include = %v, want %v", tt.path, include, tt.wantInclude)
+		}
+		if !reflect.DeepEqual(exclude, tt.wantExclude) {
+			t.Errorf("FindTagFilters(%s) exclude = %v, want %v", tt.path, exclude, tt.wantExclude)
+		}
+	}
+}
+
+func TestConfigParser_ParsePom(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		content := `<?xml version="1.0" encoding="UTF-8"?>
+<project xmlns="http://maven.apache.org/POM/4.0.0">
+  <dependencies><dependency><groupId>org.junit.jupiter</groupId></dependency></dependencies>
+</project>
+`
+		checkFiles(t, content, "/project/pom.xml", []fileExpectation{
+			{"/project/src/test/java/com/example/OrderTest.java", true, true, false},
+			{"/project/src/test/java/com/example/TestOrders.java", true, true, false},
+			{"/project/src/test/kotlin/com/example/OrderTests.kt", true, true, false},
+			{"/project/src/test/java/com/example/OrderSpec.java", true, false, false},
+			{"/project/src/test/java/com/example/OrderIT.java", true, false, false},
+			{"/project/src/main/java/com/example/Order.java", false, false, false},
+		})
+	})
+
+	t.Run("kotest on the classpath", func(t *testing.T) {
+		content := `<project>
+  <dependencies>
+    <dependency>
+      <groupId>io.kotest</groupId>
+      <artifactId>kotest-runner-junit5-jvm</artifactId>
+      <scope>test</scope>
+    </dependency>
+  </dependencies>
+</project>
+`
+		checkFiles(t, content, "/project/pom.xml", []fileExpectation{
+			{"/project/src/test/kotlin/com/example/OrderSpec.kt", true, true, false},
+			{"/project/src/test/kotlin/com/example/OrderTest.kt", true, true, false},
+			{"/project/src/test/kotlin/com/example/Fixtures.kt", true, false, false},
+		})
+	})
+
+	t.Run("surefire and failsafe", func(t *testing.T) {
+		content := `<project>
+  <build>
+    <testSourceDirectory>${project.basedir}/src/tests/java</testSourceDirectory>
+    <pluginManagement>
+      <plugins>
+        <plugin>
+          <artifactId>maven-surefire-plugin</artifactId>
+          <configuration>
+            <includes><include>**/*Spec.java</include><include>*Check</include></includes>
+          </configuration>
+        </plugin>
+      </plugins>
+    </pluginManagement>
+    <plugins>
+      <plugin>
+        <artifactId>maven-surefire-plugin</artifactId>
+        <configuration>
+          <excludes>
+            <exclude>**/*IT.java</exclude>
+            <exclude>**/legacy/**</exclude>
+            <exclude>%regex[.*Slow.*]</exclude>
+          </excludes>
+        </configuration>
+      </plugin>
+      <plugin>
+        <artifactId>maven-failsafe-plugin</artifactId>
+        <executions>
+          <execution>
+            <goals><goal>integration-test</goal></goals>
+          </execution>
+        </executions>
+      </plugin>
+    </plugins>
+  </build>
+</project>
+`
+		checkFiles(t, content, "/project/pom.xml", []fileExpectation{
+			{"/project/src/tests/java/com/example/OrderSpec.java", true, true, false},
+			{"/project/src/tests/java/HealthCheck.java", true, true, false},
+			{"/project/src/tests/java/com/example/OrderTest.java", true, false, false},
+			{"/project/src/tests/java/com/example/legacy/OldSpec.java", true, false, false},
+			{"/project/src/tests/java/com/example/OrderIT.java", true, true, true},
+			{"/project/src/tests/java/com/example/ITOrders.kt", true, true, true},
+			{"/project/src/test/java/com/example/OrderTest.java", false, false, false},
+		})
+	})
+
+	t.Run("failsafe in plugin management only", func(t *testing.T) {
+		content := `<project><build><pluginManagement><plugins>
+  <plugin><artifactId>maven-failsafe-plugin</artifactId></plugin>
+</plugins></pluginManagement></build></project>`
+		checkFiles(t, content, "/project/pom.xml", []fileExpectation{
+			{"/project/src/test/java/OrderIT.java", true, false, false},
+		})
+	})
+
+	t.Run("unresolvable test source directory", func(t *testing.T) {
+		content := `<project><build><testSourceDirectory>${tests.dir}</testSourceDirectory></build></project>`
+		checkFiles(t, content, "/project/pom.xml", []fileExpectation{
+			{"/project/src/test/java/OrderTest.java", false, false, false},
+		})
+	})
+
+	t.Run("invalid xml", func(t *testing.T) {
+		_, err := (&ConfigParser{}).Parse(context.Background(), "/project/pom.xml", []byte("<project><build>"))
+		if err == nil {
+			t.Error("expected error for invalid XML")
+		}
+	})
+}
+
+func TestConfigParser_ParseGradle(t *testing.T) {
+	t.Run("groovy source sets", func(t *testing.T) {
+		content := `plugins {
+    id 'java'
+}
+
+sourceSets {
+    main {
+        java.srcDirs = ['src/java']
+    }
+    integrationTest {
+        java {
+            srcDirs = ['src/it/java']
+        }
+        resources.srcDir 'src/it/resources'
+        compileClasspath += sourceSets.main.output
+    }
+    functionalTest {
+        java.srcDir "$projectDir/src/functional"
+    }
+}
+
+test {
+    useJUnitPlatform {
+        includeTags 'fast', 'unit'
+        excludeTags 'slow', 'fast & !flaky'
+    }
+}
+
+task integrationTest(type: Test) {
+    useJUnitPlatform {
+        includeTags 'integration'
+    }
+}
+`
+		checkFiles(t, content, "/project/build.gradle", []fileExpectation{
+			{"/project/src/test/java/com/example/OrderTest.java", true, true, false},
+			{"/project/src/test/java/com/example/Fixtures.java", true, true, false},
+			{"/project/src/it/java/com/example/OrderFlow.java", true, true, true},
+			{"/project/src/it/resources/Data.java", false, false, false},
+			{"/project/src/integrationTest/java/com/example/OrderIT.java", false, false, false},
+			{"/project/src/functional/com/example/Checkout.kt", true, true, false},
+			{"/project/src/functionalTest/java/com/example/Checkout.java", true, true, false},
+			{"/project/src/java/com/example/Order.java", false, false, false},
+		})
+
+		checkTagFilters(t, content, "/project/build.gradle", []tagFilterExpectation{
+			{"/project/src/test/java/com/example/OrderTest.java", []string{"fast", "unit"}, []string{"slow"}},
+			{"/project/src/it/java/com/example/OrderFlow.java", nil, nil},
+		})
+	})
+
+	t.Run("kotlin dsl", func(t *testing.T) {
+		content := `sourceSets {
+    val integrationTest by creating {
+        kotlin.srcDir("src/integration/kotlin")
+    }
+    create("it") {
+        java.setSrcDirs(listOf("src/it"))
+    }
+}
+
+tasks.named<Test>("test") {
+    useJUnitPlatform {
+        excludeTags("slow")
+    }
+}
+`
+		checkFiles(t, content, "/project/build.gradle.kts", []fileExpectation{
+			{"/project/src/test/kotlin/com/example/OrderTest.kt", true, true, false},
+			{"/project/src/integration/kotlin/com/example/OrderFlow.kt", true, true, true},
+			{"/project/src/integrationTest/kotlin/com/example/OrderFlow.kt", true, true, true},
+			{"/project/src/it/com/example/Smoke.java", true, true, true},
+			{"/project/src/main/kotlin/com/example/Order.kt", false, false, false},
+		})
+
+		checkTagFilters(t, content, "/project/build.gradle.kts", []tagFilterExpectation{
+			{"/project/src/test/kotlin/com/example/OrderTest.kt", nil, []string{"slow"}},
+			{"/project/src/integrationTest/kotlin/com/example/OrderFlow.kt", nil, nil},
+		})
+	})
+
+	t.Run("tag filters of every test task", func(t *testing.T) {
+		content := `sourceSets {
+    integrationTest {
+        java.srcDir 'src/integrationTest/java'
+    }
+}
+
+test {
+    useJUnitPlatform {
+        excludeTags 'db'
+    }
+}
+
+tasks.withType(Test) {
+    useJUnitPlatform {
+        excludeTags 'flaky'
+    }
+}
+`
+		checkTagFilters(t, content, "/project/build.gradle", []tagFilterExpectation{
+			{"/project/src/test/java/com/example/OrderTest.java", nil, []string{"flaky", "db"}},
+			{"/project/src/integrationTest/java/com/example/OrderIT.java", nil, []string{"flaky"}},
+		})
+	})
+}
@@ -0,0 +1,198 @@
+package jvmbuild
+
+import (
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/specvital/core/pkg/parser/framework"
+	"github.com/specvital/core/pkg/parser/strategies/shared/configutil"
+)
+
+const defaultSourceSet = "test"
+
+var (
+	sourceSetsPattern = regexp.MustCompile(`(?m)^\s*sourceSets\s*\{`)
+	// sourceSetPattern names the source set of a sourceSets entry: integrationTest,
+	// create("integrationTest"), named<SourceSet>("test"), val integrationTest by creating.
+	sourceSetPattern = regexp.MustCompile(`(?:val\s+(\w+)\s+by\s+(?:creating|getting|registering)|(?:create|register|named|getByName|maybeCreate)(?:<\w+>)?\s*\(\s*["'](\w+)["']\s*\)|(\w+))\s*$`)
+	// srcDirPattern matches srcDir "a", srcDirs = ['a', 'b'], setSrcDirs(listOf("a")), ...
+	srcDirPattern    = regexp.MustCompile(`(?m)\b(srcDirs?|setSrcDirs)\b\s*(=|\+=)?(.*)$`)
+	resourcesPattern = regexp.MustCompile(`(?m)\bresources\s*\{|^.*\bresources\.srcDirs?\b.*$`)
+
+	// testTaskPattern opens the configuration of the test task: test { }, tasks.test { },
+	// tasks.named<Test>("test") { }, or of every test task: tasks.withType(Test) { }, ...
+	testTaskPattern      = regexp.MustCompile(`(?m)^\s*(?:tasks\.)?(?:test|named(?:<Test>)?\s*\(\s*["']test["'][^)]*\)|(withType)\s*(?:<Test>\s*(?:\(\s*\))?|\(\s*Test[\w:.]*\s*\)))\s*(?:\.configureEach\s*)?\{`)
+	junitPlatformPattern = regexp.MustCompile(`useJUnitPlatform\s*(?:\(\s*\))?\s*\{`)
+	tagFilterPattern     = regexp.MustCompile(`(?m)\b(includeTags|excludeTags)\b(.*)$`)
+)
+
+// applyGradle decides the Java and Kotlin files of the test source sets: test and the
+// sourceSets entries named like tests (integrationTest, functionalTest). Gradle runs
+// every test class of a source set, so the source sets collect all their files; those
+// named after integration tests are marked as such. The useJUnitPlatform tag filters of
+// the test task filter the tests of the test source set, those of withType(Test) the
+// tests of every source set.
+func applyGradle(scope *framework.ConfigScope, content string) {
+	sourceSets := map[string][]string{defaultSourceSet: nil}
+	names := []string{defaultSourceSet}
+
+	if loc := sourceSetsPattern.FindStringIndex(content); loc != nil {
+		forEachEntry(blockAt(content, loc[1]-1), func(header, body string) {
+			m := sourceSetPattern.FindStringSubmatch(header)
+			if m == nil {
+				return
+			}
+			name := m[1] + m[2] + m[3]
+			if !isTestSourceSet(name) {
+				return
+			}
+			if _, ok := sourceSets[name]; !ok {
+				names = append(names, name)
+			}
+			sourceSets[name] = sourceDirs(name, body)
+		})
+	}
+
+	var testPatterns []string
+	for _, name := range names {
+		dirs := sourceSets[name]
+		if dirs == nil {
+			dirs = []string{"src/" + name + "/java", "src/" + name + "/kotlin"}
+		}
+		for _, dir := range dirs {
+			decideSources(scope, dir)
+			pattern := path.Join(dir, "**", "*"+sourceExtensions)
+			scope.CollectPatterns = append(scope.CollectPatterns, pattern)
+			if isIntegrationSourceSet(name) {
+				scope.IntegrationPatterns = append(scope.IntegrationPatterns, pattern)
+			}
+			if name == defaultSourceSet {
+				testPatterns = append(testPatterns, pattern)
+			}
+		}
+	}
+
+	// The test task runs the test source set alone; withType(Test) configures the tasks
+	// of every source set.
+	testFilter := framework.TagFilter{Patterns: testPatterns}
+	for _, m := range testTaskPattern.FindAllStringSubmatchIndex(content, -1) {
+		include, exclude := &testFilter.Include, &testFilter.Exclude
+		if m[2] >= 0 {
+			include, exclude = &scope.IncludeTags, &scope.ExcludeTags
+		}
+		task := blockAt(content, m[1]-1)
+		for _, platform := range junitPlatformPattern.FindAllStringIndex(task, -1) {
+			for _, tm := range tagFilterPattern.FindAllStringSubmatch(blockAt(task, platform[1]-1), -1) {
+				tags := plainTags(configutil.ExtractQuotedStrings([]byte(tm[2])))
+				if tm[1] == "includeTags" {
+					*include = append(*include, tags...)
+				} else {
+					*exclude = append(*exclude, tags...)
+				}
+			}
+		}
+	}
+	if len(testFilter.Include) > 0 || len(testFilter.Exclude) > 0 {
+		scope.TagFilters = append(scope.TagFilters, testFilter)
+	}
+}
+
+// sourceDirs returns the Java and Kotlin directories of a source set's configuration,
+// or nil when it keeps the defaults. srcDir adds to the defaults; srcDirs = and
+// setSrcDirs replace them.
+func sourceDirs(name, body string) []string {
+	body = stripResources(body)
+
+	var dirs []string
+	replaced := false
+	for _, m := range srcDirPattern.FindAllStringSubmatch(body, -1) {
+		if m[1] == "setSrcDirs" || m[2] == "=" {
+			replaced = true
+		}
+		for _, dir := range configutil.ExtractQuotedStrings([]byte(m[3])) {
+			if dir = cleanDir(dir); dir != "" {
+				dirs = append(dirs, dir)
+			}
+		}
+	}
+	if len(dirs) == 0 {
+		return nil
+	}
+	if !replaced {
+		dirs = append([]string{"src/" + name + "/java", "src/" + name + "/kotlin"}, dirs...)
+	}
+	return dirs
+}
+
+// stripResources removes the resources configuration, whose directories hold no sources.
+func stripResources(body string) string {
+	for {
+		loc := resourcesPattern.FindStringIndex(body)
+		if loc == nil {
+			return body
+		}
+		end := loc[1]
+		if strings.HasSuffix(body[loc[0]:loc[1]], "{") {
+			end = loc[1] + len(blockAt(body, loc[1]-1)) + 1
+		}
+		body = body[:loc[0]] + body[min(end, len(body)):]
+	}
+}
+
+func isTestSourceSet(name string) bool {
+	lower := strings.ToLower(name)
+	return strings.Contains(lower, "test") || isIntegrationSourceSet(name)
+}
+
+func isIntegrationSourceSet(name string) bool {
+	lower := strings.ToLower(name)
+	return strings.Contains(lower, "integration") || lower == "it"
+}
+
+// plainTags drops JUnit Platform tag expressions ("fast & !slow"), which cannot be
+// matched against a test's tags one by one.
+func plainTags(tags []string) []string {
+	var plain []string
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag != "" && !strings.ContainsAny(tag, "&|!() ") {
+			plain = append(plain, tag)
+		}
+	}
+	return plain
+}
+
+// forEachEntry calls fn with the header and body of every top-level block of content.
+func forEachEntry(content string, fn func(header, body string)) {
+	start := 0
+	for i := 0; i < len(content); i++ {
+		switch content[i] {
+		case '{':
+			body := blockAt(content, i)
+			fn(strings.TrimSpace(content[start:i]), body)
+			i += len(body) + 1
+			start = i + 1
+		case '\n', ';':
+			start = i + 1
+		}
+	}
+}
+
+// blockAt returns the content between the brace at open and its matching brace, or the
+// rest of content when the block is not closed.
+func blockAt(content string, open int) string {
+	depth := 0
+	for i := open; i < len(content); i++ {
+		switch content[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return content[open+1 : i]
+			}
+		}
+	}
+	return content[open+1:]
+}
@@ -0,0 +1,225 @@
+package jvmbuild
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+const (
+	surefirePlugin = "maven-surefire-plugin"
+	failsafePlugin = "maven-failsafe-plugin"
+)
+
+var (
+	// defaultTestSourceDirs are Maven's test sources, with the Kotlin plugin's convention.
+	defaultTestSourceDirs = []string{"src/test/java", "src/test/kotlin"}
+
+	surefireDefaultIncludes = []string{"**/Test*.java", "**/*Test.java", "**/*Tests.java", "**/*TestCase.java"}
+	failsafeDefaultIncludes = []string{"**/IT*.java", "**/*IT.java", "**/*ITCase.java"}
+
+	// kotestDefaultIncludes add Kotest's spec naming to the Surefire defaults of builds
+	// that depend on Kotest, which names its specs after their style (OrderSpec).
+	kotestDefaultIncludes = []string{"**/*Spec.java"}
+)
+
+// kotestGroupID is the Maven group of the Kotest artifacts.
+const kotestGroupID = "io.kotest"
+
+type pomProject struct {
+	Build        pomBuild        `xml:"build"`
+	Dependencies []pomDependency `xml:"dependencies>dependency"`
+}
+
+type pomDependency struct {
+	GroupID string `xml:"groupId"`
+}
+
+type pomBuild struct {
+	TestSourceDirectory string      `xml:"testSourceDirectory"`
+	Plugins             []pomPlugin `xml:"plugins>plugin"`
+	ManagedPlugins      []pomPlugin `xml:"pluginManagement>plugins>plugin"`
+}
+
+type pomPlugin struct {
+	ArtifactID    string          `xml:"artifactId"`
+	Configuration pomTestConfig   `xml:"configuration"`
+	Executions    []pomTestConfig `xml:"executions>execution>configuration"`
+}
+
+type pomTestConfig struct {
+	Includes []string `xml:"includes>include"`
+	Excludes []string `xml:"excludes>exclude"`
+}
+
+// testRunner is the filter of a test plugin, with includes and excludes as globs of
+// class names relative to a test source directory ("**/*IT").
+type testRunner struct {
+	includes    []string
+	excludes    []string
+	integration bool
+}
+
+// applyPom scopes the test source directory to the files the Surefire plugin, and the
+// Failsafe plugin when the build declares it, run. A plugin's exclude that another
+// plugin's include matches is left out, as the usual Surefire exclude of the **/*IT.java
+// files Failsafe runs would otherwise exclude them from the scope. When the project
+// depends on Kotest, the Surefire defaults also take in its *Spec classes.
+func applyPom(scope *framework.ConfigScope, configPath string, content []byte) error {
+	var project pomProject
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	// Element values are ASCII paths, so the raw bytes are read whatever the declared encoding.
+	decoder.CharsetReader = func(charset string, input io.Reader) (io.Reader, error) { return input, nil }
+	if err := decoder.Decode(&project); err != nil {
+		return fmt.Errorf("maven config: failed to parse %s: %w", configPath, err)
+	}
+
+	build := project.Build
+	dirs := defaultTestSourceDirs
+	if build.TestSourceDirectory != "" {
+		dir := cleanDir(build.TestSourceDirectory)
+		if dir == "" {
+			return nil
+		}
+		dirs = []string{dir}
+	}
+
+	surefireIncludes := surefireDefaultIncludes
+	if project.dependsOn(kotestGroupID) {
+		surefireIncludes = append(slices.Clone(surefireIncludes), kotestDefaultIncludes...)
+	}
+
+	runners := []*testRunner{pluginRunner(build, surefirePlugin, surefireIncludes, false)}
+	if failsafe := pluginRunner(build, failsafePlugin, failsafeDefaultIncludes, true); failsafe != nil {
+		failsafe.integration = true
+		runners = append(runners, failsafe)
+	}
+
+	for _, dir := range dirs {
+		decideSources(scope, dir)
+		for _, runner := range runners {
+			for _, include := range runner.includes {
+				pattern := path.Join(dir, include+sourceExtensions)
+				scope.CollectPatterns = append(scope.CollectPatterns, pattern)
+				if runner.integration {
+					scope.IntegrationPatterns = append(scope.IntegrationPatterns, pattern)
+				}
+			}
+			for _, exclude := range runner.excludes {
+				if !includedByOther(runners, runner, exclude) {
+					scope.Exclude = append(scope.Exclude, path.Join(dir, exclude+sourceExtensions))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// dependsOn reports whether the project declares a dependency of the Maven group.
+func (p *pomProject) dependsOn(groupID string) bool {
+	for _, dependency := range p.Dependencies {
+		if strings.TrimSpace(dependency.GroupID) == groupID {
+			return true
+		}
+	}
+	return false
+}
+
+// pluginRunner returns the filter of a test plugin of the build, configured in
+// <plugins> or else in <pluginManagement>. Plugins that only run when declared
+// (Failsafe) are nil when <plugins> lacks them.
+func pluginRunner(build pomBuild, artifactID string, defaultIncludes []string, declaredOnly bool) *testRunner {
+	plugin := findPlugin(build.Plugins, artifactID)
+	if declaredOnly && plugin == nil {
+		return nil
+	}
+	managed := findPlugin(build.ManagedPlugins, artifactID)
+
+	var includes, excludes []string
+	if plugin != nil {
+		includes, excludes = plugin.filters()
+	}
+	if managed != nil {
+		managedIncludes, managedExcludes := managed.filters()
+		if len(includes) == 0 {
+			includes = managedIncludes
+		}
+		if len(excludes) == 0 {
+			excludes = managedExcludes
+		}
+	}
+	if len(includes) == 0 {
+		includes = defaultIncludes
+	}
+
+	return &testRunner{
+		includes: classPatterns(includes),
+		excludes: classPatterns(excludes),
+	}
+}
+
+func findPlugin(plugins []pomPlugin, artifactID string) *pomPlugin {
+	for i := range plugins {
+		if strings.TrimSpace(plugins[i].ArtifactID) == artifactID {
+			return &plugins[i]
+		}
+	}
+	return nil
+}
+
+// filters returns the includes and excludes of the plugin and its executions.
+func (p *pomPlugin) filters() (includes, excludes []string) {
+	for _, config := range append([]pomTestConfig{p.Configuration}, p.Executions...) {
+		includes = append(includes, config.Includes...)
+		excludes = append(excludes, config.Excludes...)
+	}
+	return includes, excludes
+}
+
+// classPatterns converts plugin patterns, which match class files ("**/*Test.java",
+// "**/Foo*", "*IT.class", "**/*Test.java, **/*Spec.java"), to globs of class names
+// ("**/*Test"). %regex[...] patterns are skipped.
+func classPatterns(values []string) []string {
+	var patterns []string
+	for _, value := range values {
+		for _, pattern := range strings.Split(value, ",") {
+			pattern = strings.TrimSpace(pattern)
+			if inner, ok := strings.CutPrefix(pattern, "%ant["); ok {
+				pattern = strings.TrimSuffix(inner, "]")
+			}
+			if pattern == "" || strings.HasPrefix(pattern, "%regex[") || strings.HasPrefix(pattern, "!") {
+				continue
+			}
+			pattern = strings.TrimSuffix(strings.TrimSuffix(pattern, ".java"), ".class")
+			if !strings.Contains(pattern, "/") {
+				pattern = "**/" + pattern
+			}
+			patterns = append(patterns, pattern)
+		}
+	}
+	return patterns
+}
+
+// includedByOther reports whether an include of a runner other than owner matches the
+// exclude pattern read as a path.
+func includedByOther(runners []*testRunner, owner *testRunner, exclude string) bool {
+	for _, runner := range runners {
+		if runner == owner {
+			continue
+		}
+		for _, include := range runner.includes {
+			if match, err := doublestar.Match(include, exclude); err == nil && match {
+				return true
+			}
+		}
+	}
+	return false
+}
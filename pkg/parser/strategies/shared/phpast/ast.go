@@ -22,6 +22,8 @@ const (
 	NodeQualifiedName      = "qualified_name"
 	NodeNamespaceUse       = "namespace_use_declaration"
 	NodeBaseClause         = "base_clause"
+	NodeStaticModifier     = "static_modifier"
+	NodeAbstractModifier   = "abstract_modifier"
 )
 
 // GetClassName extracts the class name from a class_declaration node.
@@ -149,16 +151,49 @@ func IsPHPTestFileName(filename string) bool {
 // Matches: TestCase, BaseTestCase, *TestCase, *Test (suffix match for indirect inheritance)
 // Does NOT match: TestCaseHelper, TestHelper (not valid test base class suffix)
 func ExtendsTestCase(node *sitter.Node, source []byte) bool {
+	baseName := GetBaseClassName(node, source)
+	return strings.HasSuffix(baseName, "TestCase") || strings.HasSuffix(baseName, "Test")
+}
+
+// GetBaseClassName returns the unqualified name of the class a class_declaration extends,
+// or "" when it extends none.
+func GetBaseClassName(node *sitter.Node, source []byte) string {
 	for i := 0; i < int(node.ChildCount()); i++ {
 		child := node.Child(i)
 		if child.Type() == NodeBaseClause {
-			baseName := extractBaseClassName(child, source)
-			return strings.HasSuffix(baseName, "TestCase") || strings.HasSuffix(baseName, "Test")
+			return extractBaseClassName(child, source)
+		}
+	}
+	return ""
+}
+
+// IsAbstractClass checks if a class_declaration is declared abstract.
+func IsAbstractClass(node *sitter.Node) bool {
+	for i := 0; i < int(node.ChildCount()); i++ {
+		if node.Child(i).Type() == NodeAbstractModifier {
+			return true
 		}
 	}
 	return false
 }
 
+// IsPublicInstanceMethod checks if a method_declaration is public (explicitly or by
+// default) and not static.
+func IsPublicInstanceMethod(node *sitter.Node, source []byte) bool {
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		switch child.Type() {
+		case NodeStaticModifier:
+			return false
+		case NodeVisibilityModifier:
+			if child.Content(source) != "public" {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 // extractBaseClassName extracts the base class name from a base_clause node.
 // For qualified names like \PHPUnit\Framework\TestCase, returns "TestCase".
 func extractBaseClassName(baseClause *sitter.Node, source []byte) string {
@@ -0,0 +1,134 @@
+package phpast
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+)
+
+// ParseTestClasses extracts PHPUnit-style test classes: classes extending a TestCase
+// whose methods are marked with #[Test], @test or a "test" prefix.
+// Pest runs these classes as-is, so both strategies share this parser.
+func ParseTestClasses(root *sitter.Node, source []byte, filename string) []domain.TestSuite {
+	var suites []domain.TestSuite
+	collectTestClasses(root, source, filename, &suites)
+	return suites
+}
+
+func collectTestClasses(node *sitter.Node, source []byte, filename string, suites *[]domain.TestSuite) {
+	if node.Type() == NodeClassDeclaration {
+		if suite := parseTestClass(node, source, filename); suite != nil {
+			*suites = append(*suites, *suite)
+		}
+		return
+	}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		collectTestClasses(node.NamedChild(i), source, filename, suites)
+	}
+}
+
+func parseTestClass(node *sitter.Node, source []byte, filename string) *domain.TestSuite {
+	className := GetClassName(node, source)
+	if className == "" {
+		return nil
+	}
+
+	if !ExtendsTestCase(node, source) {
+		return nil
+	}
+
+	tests := ParseTestMethods(node, source, filename)
+	if len(tests) == 0 {
+		return nil
+	}
+
+	return &domain.TestSuite{
+		Name:     className,
+		Status:   domain.TestStatusActive,
+		Location: location(node, filename),
+		Tests:    tests,
+	}
+}
+
+// ParseTestMethods extracts the test methods of a class_declaration: methods marked
+// with #[Test], @test or a "test" prefix.
+func ParseTestMethods(class *sitter.Node, source []byte, filename string) []domain.Test {
+	body := GetDeclarationList(class)
+	if body == nil {
+		return nil
+	}
+
+	var tests []domain.Test
+	var prevComment *sitter.Node
+
+	for i := 0; i < int(body.ChildCount()); i++ {
+		child := body.Child(i)
+
+		switch child.Type() {
+		case NodeComment:
+			prevComment = child
+
+		case NodeMethodDeclaration:
+			if test := parseTestMethod(child, source, filename, prevComment); test != nil {
+				tests = append(tests, *test)
+			}
+			prevComment = nil
+
+		default:
+			prevComment = nil
+		}
+	}
+	return tests
+}
+
+func parseTestMethod(node *sitter.Node, source []byte, filename string, prevComment *sitter.Node) *domain.Test {
+	methodName := GetMethodName(node, source)
+	if methodName == "" {
+		return nil
+	}
+
+	attrs := GetAttributes(node)
+	hasTestAttr := HasTestAttribute(attrs, source)
+
+	hasTestAnnotation := false
+	if prevComment != nil {
+		commentText := prevComment.Content(source)
+		hasTestAnnotation = HasTestAnnotation(commentText)
+	}
+
+	hasTestPrefix := strings.HasPrefix(methodName, "test")
+
+	if !hasTestAttr && !hasTestAnnotation && !hasTestPrefix {
+		return nil
+	}
+
+	status := domain.TestStatusActive
+	modifier := ""
+	if skipped, skipMod := HasSkipAttribute(attrs, source); skipped {
+		status = domain.TestStatusSkipped
+		modifier = skipMod
+	}
+
+	return &domain.Test{
+		Name:     methodName,
+		Status:   status,
+		Modifier: modifier,
+		Location: location(node, filename),
+	}
+}
+
+// location converts a node position to a 1-based [domain.Location].
+func location(node *sitter.Node, filename string) domain.Location {
+	start := node.StartPoint()
+	end := node.EndPoint()
+
+	return domain.Location{
+		File:      filename,
+		StartLine: int(start.Row) + 1,
+		EndLine:   int(end.Row) + 1,
+		StartCol:  int(start.Column),
+		EndCol:    int(end.Column),
+	}
+}
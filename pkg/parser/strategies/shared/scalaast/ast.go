@@ -0,0 +1,254 @@
+// Package scalaast provides shared Scala AST traversal utilities for test framework parsers.
+package scalaast
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/parser"
+)
+
+// Scala AST node types.
+const (
+	NodeAnnotation              = "annotation"
+	NodeArguments               = "arguments"
+	NodeBlock                   = "block"
+	NodeCallExpression          = "call_expression"
+	NodeClassDefinition         = "class_definition"
+	NodeColonArgument           = "colon_argument"
+	NodeExtendsClause           = "extends_clause"
+	NodeFieldExpression         = "field_expression"
+	NodeGenericType             = "generic_type"
+	NodeIdentifier              = "identifier"
+	NodeIndentedBlock           = "indented_block"
+	NodeInfixExpression         = "infix_expression"
+	NodeInterpolatedString      = "interpolated_string"
+	NodeInterpolatedStringExpr  = "interpolated_string_expression"
+	NodeInterpolation           = "interpolation"
+	NodeObjectDefinition        = "object_definition"
+	NodeOperatorIdentifier      = "operator_identifier"
+	NodeParenthesizedExpression = "parenthesized_expression"
+	NodeStableTypeIdentifier    = "stable_type_identifier"
+	NodeString                  = "string"
+	NodeTemplateBody            = "template_body"
+	NodeTupleExpression         = "tuple_expression"
+	NodeTypeIdentifier          = "type_identifier"
+)
+
+// Spec is a class or object definition with an extends clause.
+type Spec struct {
+	Name    string
+	Node    *sitter.Node
+	Parents []string
+	Body    *sitter.Node
+}
+
+// Extends reports whether the definition directly extends or mixes in one of the given types.
+func (s Spec) Extends(types map[string]bool) bool {
+	for _, parent := range s.Parents {
+		if types[parent] {
+			return true
+		}
+	}
+	return false
+}
+
+// Specs returns every class and object definition that extends another type, including nested ones.
+func Specs(root *sitter.Node, source []byte) []Spec {
+	var specs []Spec
+	parser.WalkTree(root, func(node *sitter.Node) bool {
+		if node.Type() != NodeClassDefinition && node.Type() != NodeObjectDefinition {
+			return true
+		}
+		name := node.ChildByFieldName("name")
+		extends := node.ChildByFieldName("extend")
+		if name == nil || extends == nil {
+			return true
+		}
+		specs = append(specs, Spec{
+			Name:    parser.GetNodeText(name, source),
+			Node:    node,
+			Parents: parentTypes(extends, source),
+			Body:    node.ChildByFieldName("body"),
+		})
+		return true
+	})
+	return specs
+}
+
+// parentTypes returns the simple names of the types in an extends clause.
+// Qualified types such as munit.FunSuite are reported both qualified and unqualified.
+func parentTypes(extends *sitter.Node, source []byte) []string {
+	var parents []string
+	for i := 0; i < int(extends.NamedChildCount()); i++ {
+		child := extends.NamedChild(i)
+		if child.Type() == NodeGenericType {
+			child = child.ChildByFieldName("type")
+			if child == nil {
+				continue
+			}
+		}
+		switch child.Type() {
+		case NodeTypeIdentifier:
+			parents = append(parents, parser.GetNodeText(child, source))
+		case NodeStableTypeIdentifier:
+			qualified := parser.GetNodeText(child, source)
+			parents = append(parents, qualified)
+			if idx := strings.LastIndex(qualified, "."); idx >= 0 {
+				parents = append(parents, qualified[idx+1:])
+			}
+		}
+	}
+	return parents
+}
+
+// HasAnnotation reports whether a definition carries the named annotation.
+func HasAnnotation(node *sitter.Node, source []byte, name string) bool {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() != NodeAnnotation {
+			continue
+		}
+		annotation := child.ChildByFieldName("name")
+		if annotation == nil {
+			continue
+		}
+		text := parser.GetNodeText(annotation, source)
+		if text == name || strings.HasSuffix(text, "."+name) {
+			return true
+		}
+	}
+	return false
+}
+
+// StringValue returns the content of a string literal or interpolated string.
+// Interpolated strings keep their $placeholders since values are only known at runtime.
+func StringValue(node *sitter.Node, source []byte) (string, bool) {
+	if node == nil {
+		return "", false
+	}
+	switch node.Type() {
+	case NodeString:
+		return unquote(parser.GetNodeText(node, source)), true
+	case NodeInterpolatedStringExpr:
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			child := node.NamedChild(i)
+			if child.Type() == NodeInterpolatedString {
+				return unquote(parser.GetNodeText(child, source)), true
+			}
+		}
+	}
+	return "", false
+}
+
+func unquote(text string) string {
+	if strings.HasPrefix(text, `"""`) && strings.HasSuffix(text, `"""`) && len(text) >= 6 {
+		return text[3 : len(text)-3]
+	}
+	if strings.HasPrefix(text, `"`) && strings.HasSuffix(text, `"`) && len(text) >= 2 {
+		return text[1 : len(text)-1]
+	}
+	return text
+}
+
+// Call is a curried test registration call such as test("name") { ... }.
+type Call struct {
+	Name string
+	// Receiver is the qualifier of a method-style registration such as fixture.test("name"), if any.
+	Receiver *sitter.Node
+	// Args are the arguments of the first parameter list.
+	Args []*sitter.Node
+	// Body is the second parameter list: a block, a Scala 3 colon argument,
+	// or a parenthesized argument such as (pending).
+	Body *sitter.Node
+}
+
+// CurriedCall matches name(args)(body), name(args) { body } and receiver.name(args) { body } calls.
+func CurriedCall(node *sitter.Node, source []byte) (Call, bool) {
+	if node == nil || node.Type() != NodeCallExpression {
+		return Call{}, false
+	}
+	inner := node.ChildByFieldName("function")
+	if inner == nil || inner.Type() != NodeCallExpression {
+		return Call{}, false
+	}
+	call := Call{
+		Args: NamedChildren(inner.ChildByFieldName("arguments")),
+		Body: node.ChildByFieldName("arguments"),
+	}
+	fn := inner.ChildByFieldName("function")
+	switch {
+	case fn == nil:
+		return Call{}, false
+	case fn.Type() == NodeIdentifier:
+		call.Name = parser.GetNodeText(fn, source)
+	case fn.Type() == NodeFieldExpression:
+		field := fn.ChildByFieldName("field")
+		if field == nil {
+			return Call{}, false
+		}
+		call.Name = parser.GetNodeText(field, source)
+		call.Receiver = fn.ChildByFieldName("value")
+	default:
+		return Call{}, false
+	}
+	return call, true
+}
+
+// Infix is a binary operator application such as "name" in { ... }.
+type Infix struct {
+	Left     *sitter.Node
+	Operator string
+	Right    *sitter.Node
+}
+
+// InfixParts splits an infix_expression into its operands and operator.
+func InfixParts(node *sitter.Node, source []byte) (Infix, bool) {
+	if node == nil || node.Type() != NodeInfixExpression {
+		return Infix{}, false
+	}
+	op := node.ChildByFieldName("operator")
+	left := node.ChildByFieldName("left")
+	right := node.ChildByFieldName("right")
+	if op == nil || left == nil || right == nil {
+		return Infix{}, false
+	}
+	return Infix{Left: left, Operator: parser.GetNodeText(op, source), Right: right}, true
+}
+
+// BlockOf returns the statement container of a block-like node, or nil when node is not one.
+func BlockOf(node *sitter.Node) *sitter.Node {
+	if node == nil {
+		return nil
+	}
+	switch node.Type() {
+	case NodeBlock, NodeIndentedBlock, NodeTemplateBody:
+		return node
+	case NodeColonArgument:
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			if child := node.NamedChild(i); child.Type() == NodeIndentedBlock {
+				return child
+			}
+		}
+		return node
+	}
+	return nil
+}
+
+// NamedChildren returns the named children of node, or nil when node is nil.
+func NamedChildren(node *sitter.Node) []*sitter.Node {
+	if node == nil {
+		return nil
+	}
+	children := make([]*sitter.Node, 0, node.NamedChildCount())
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		children = append(children, node.NamedChild(i))
+	}
+	return children
+}
+
+// IsIdentifier reports whether node is the given plain identifier.
+func IsIdentifier(node *sitter.Node, source []byte, name string) bool {
+	return node != nil && node.Type() == NodeIdentifier && parser.GetNodeText(node, source) == name
+}
@@ -0,0 +1,131 @@
+package scalaast
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/scala"
+)
+
+func parseScala(t *testing.T, content string) *sitter.Node {
+	t.Helper()
+	parser := sitter.NewParser()
+	parser.SetLanguage(scala.GetLanguage())
+	tree, err := parser.ParseCtx(context.Background(), nil, []byte(content))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	return tree.RootNode()
+}
+
+func TestSpecs(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected map[string][]string
+	}{
+		{
+			name:     "class with mixins",
+			content:  `class SetSuite extends AnyFunSuite with Matchers with BeforeAndAfter {}`,
+			expected: map[string][]string{"SetSuite": {"AnyFunSuite", "Matchers", "BeforeAndAfter"}},
+		},
+		{
+			name:     "qualified parent",
+			content:  `object MySuite extends munit.FunSuite {}`,
+			expected: map[string][]string{"MySuite": {"munit.FunSuite", "FunSuite"}},
+		},
+		{
+			name:     "generic parent",
+			content:  `class Spec extends FixtureAnyFunSuite[Db] {}`,
+			expected: map[string][]string{"Spec": {"FixtureAnyFunSuite"}},
+		},
+		{
+			name:     "class without parents",
+			content:  `class Helper {}`,
+			expected: map[string][]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := []byte(tt.content)
+			got := make(map[string][]string)
+			for _, spec := range Specs(parseScala(t, tt.content), content) {
+				got[spec.Name] = spec.Parents
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Specs() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStringValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{"plain string", `val x = "hello"`, "hello"},
+		{"triple quoted", `val x = """multi "quoted" line"""`, `multi "quoted" line`},
+		{"interpolated", `val x = s"value $n"`, "value $n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := []byte(tt.content)
+			var value *sitter.Node
+			root := parseScala(t, tt.content)
+			var find func(n *sitter.Node)
+			find = func(n *sitter.Node) {
+				if value != nil {
+					return
+				}
+				if n.Type() == NodeString || n.Type() == NodeInterpolatedStringExpr {
+					value = n
+					return
+				}
+				for i := 0; i < int(n.NamedChildCount()); i++ {
+					find(n.NamedChild(i))
+				}
+			}
+			find(root)
+
+			got, ok := StringValue(value, content)
+			if !ok || got != tt.expected {
+				t.Errorf("StringValue() = %q, %v, want %q", got, ok, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCurriedCall(t *testing.T) {
+	tests := []struct {
+		name         string
+		content      string
+		wantName     string
+		wantArgs     int
+		wantReceiver bool
+	}{
+		{"block body", `test("a") { }`, "test", 1, false},
+		{"parenthesized body", `it("a") (pending)`, "it", 1, false},
+		{"receiver", `files.test("a") { f => f }`, "test", 1, true},
+		{"tags", `test("a", Slow, Db) { }`, "test", 3, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := []byte(tt.content)
+			root := parseScala(t, tt.content)
+			call, ok := CurriedCall(root.NamedChild(0), content)
+			if !ok {
+				t.Fatalf("expected a curried call in %q", tt.content)
+			}
+			if call.Name != tt.wantName || len(call.Args) != tt.wantArgs || (call.Receiver != nil) != tt.wantReceiver {
+				t.Errorf("CurriedCall() = %q with %d args (receiver %v)", call.Name, len(call.Args), call.Receiver != nil)
+			}
+		})
+	}
+}
@@ -21,6 +21,12 @@ const (
 	NodeAttribute            = "attribute"
 	NodeModifiers            = "modifiers"
 	NodeUserType             = "user_type"
+	NodeCallExpression       = "call_expression"
+	NodeCallSuffix           = "call_suffix"
+	NodeValueArguments       = "value_arguments"
+	NodeValueArgument        = "value_argument"
+	NodeLambdaLiteral        = "lambda_literal"
+	NodeLineStringLiteral    = "line_string_literal"
 )
 
 // GetClassName extracts the class name from a class_declaration node.
@@ -150,8 +156,9 @@ func IsSwiftTestFile(path string) bool {
 
 	name := strings.TrimSuffix(base, ".swift")
 
-	// Swift test naming conventions: *Tests.swift, *Test.swift
-	if strings.HasSuffix(name, "Test") || strings.HasSuffix(name, "Tests") {
+	// Swift test naming conventions: *Tests.swift, *Test.swift, and Quick's *Spec.swift
+	if strings.HasSuffix(name, "Test") || strings.HasSuffix(name, "Tests") ||
+		strings.HasSuffix(name, "Spec") || strings.HasSuffix(name, "Specs") {
 		return true
 	}
 
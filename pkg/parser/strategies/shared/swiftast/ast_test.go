@@ -269,6 +269,7 @@ func TestIsSwiftTestFile(t *testing.T) {
 		// Filename patterns
 		{"Test suffix", "MyTest.swift", true},
 		{"Tests suffix", "MyTests.swift", true},
+		{"Quick Spec suffix", "DolphinSpec.swift", true},
 		{"non-test file", "MyClass.swift", false},
 		{"java file", "MyTest.java", false},
 
@@ -0,0 +1,296 @@
+// Package specs2 implements specs2 framework support for Scala test files.
+package specs2
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+	"github.com/specvital/core/pkg/parser/framework"
+	"github.com/specvital/core/pkg/parser/framework/matchers"
+	"github.com/specvital/core/pkg/parser/strategies/shared/scalaast"
+)
+
+const frameworkName = framework.FrameworkSpecs2
+
+// specTypes are the specs2 base specifications (mutable and acceptance).
+var specTypes = map[string]bool{
+	"Specification":          true,
+	"SpecificationLike":      true,
+	"SpecificationWithJUnit": true,
+	"Spec":                   true,
+	"SpecLike":               true,
+	"SpecWithJUnit":          true,
+}
+
+// Mutable specification operators: "group" should { ... }, "example" in { ... }, "name" >> { ... }
+const (
+	opShould = "should"
+	opCan    = "can"
+	opIn     = "in"
+	opArrow  = ">>"
+
+	identPending = "pending"
+	identSkipped = "skipped"
+	identPUF     = "pendingUntilFixed"
+
+	interpolatorS2 = "s2"
+)
+
+// groupOps always open a group; >> opens a group only when its block contains examples.
+var groupOps = map[string]bool{opShould: true, opCan: true}
+
+// formattingFragments are s2 interpolations that lay out the report rather than run examples.
+var formattingFragments = map[string]bool{"p": true, "br": true, "t": true, "bt": true, "end": true}
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageScala},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher("org.specs2", "org.specs2."),
+			&Specs2ContentMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &Specs2Parser{},
+		Priority:     framework.PriorityGeneric,
+	}
+}
+
+// Specs2ContentMatcher matches specs2-specific patterns in file content.
+type Specs2ContentMatcher struct{}
+
+var specs2Patterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`import\s+org\.specs2\b`), "specs2 import"},
+	{regexp.MustCompile(`extends\s+(?:mutable\.)?Specification(?:WithJUnit)?\b`), "extends Specification"},
+	{regexp.MustCompile(`\bs2"""`), "s2 acceptance string"},
+}
+
+func (m *Specs2ContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range specs2Patterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found specs2 pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+// Specs2Parser extracts test definitions from Scala specs2 files.
+// Mutable specifications contribute "group" should/can/>> { } suites and "example" in/>> { }
+// tests. Acceptance specifications contribute one test per example interpolated into an s2
+// string, named after the text preceding it on the same line.
+type Specs2Parser struct{}
+
+func (p *Specs2Parser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageScala, source)
+	if err != nil {
+		return nil, fmt.Errorf("specs2 parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	file := &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageScala,
+		Framework: frameworkName,
+	}
+
+	for _, spec := range scalaast.Specs(tree.RootNode(), source) {
+		if !spec.Extends(specTypes) || spec.Body == nil {
+			continue
+		}
+
+		suite := domain.TestSuite{
+			Name:     spec.Name,
+			Status:   domain.TestStatusActive,
+			Location: parser.GetLocation(spec.Node, filename),
+		}
+
+		walk(spec.Body, source, filename, &suite)
+
+		if len(suite.Tests) > 0 || len(suite.Suites) > 0 {
+			file.Suites = append(file.Suites, suite)
+		}
+	}
+
+	return file, nil
+}
+
+func walk(node *sitter.Node, source []byte, filename string, suite *domain.TestSuite) {
+	for _, child := range scalaast.NamedChildren(node) {
+		switch child.Type() {
+		case scalaast.NodeClassDefinition, scalaast.NodeObjectDefinition:
+			continue
+		case scalaast.NodeInfixExpression:
+			if visitInfix(child, source, filename, suite) {
+				continue
+			}
+		case scalaast.NodeInterpolatedStringExpr:
+			if interpolator := child.ChildByFieldName("interpolator"); interpolator != nil &&
+				parser.GetNodeText(interpolator, source) == interpolatorS2 {
+				suite.Tests = append(suite.Tests, acceptanceExamples(child, source, filename)...)
+				continue
+			}
+		}
+		walk(child, source, filename, suite)
+	}
+}
+
+func visitInfix(node *sitter.Node, source []byte, filename string, suite *domain.TestSuite) bool {
+	infix, ok := scalaast.InfixParts(node, source)
+	if !ok {
+		return false
+	}
+	name, ok := scalaast.StringValue(infix.Left, source)
+	if !ok {
+		return false
+	}
+
+	body := scalaast.BlockOf(infix.Right)
+	isGroup := groupOps[infix.Operator] ||
+		(infix.Operator == opArrow && body != nil && containsExamples(body, source))
+
+	if isGroup {
+		if body == nil {
+			return false
+		}
+		nested := domain.TestSuite{
+			Name:     name,
+			Status:   domain.TestStatusActive,
+			Location: parser.GetLocation(node, filename),
+		}
+		walk(body, source, filename, &nested)
+		if len(nested.Tests) > 0 || len(nested.Suites) > 0 {
+			suite.Suites = append(suite.Suites, nested)
+		}
+		return true
+	}
+
+	if infix.Operator != opIn && infix.Operator != opArrow {
+		return false
+	}
+
+	status, modifier := exampleStatus(infix.Right, source)
+	suite.Tests = append(suite.Tests, domain.Test{
+		Name:     name,
+		Status:   status,
+		Modifier: modifier,
+		Location: parser.GetLocation(node, filename),
+	})
+	return true
+}
+
+// containsExamples reports whether a block registers nested examples or groups.
+func containsExamples(body *sitter.Node, source []byte) bool {
+	for _, child := range scalaast.NamedChildren(body) {
+		infix, ok := scalaast.InfixParts(child, source)
+		if !ok {
+			continue
+		}
+		if _, ok := scalaast.StringValue(infix.Left, source); !ok {
+			continue
+		}
+		if infix.Operator == opIn || infix.Operator == opArrow || groupOps[infix.Operator] {
+			return true
+		}
+	}
+	return false
+}
+
+// exampleStatus derives the status of an example from its body:
+// `pending`/`skipped` results and `.pendingUntilFixed` wrappers.
+func exampleStatus(body *sitter.Node, source []byte) (domain.TestStatus, string) {
+	switch body.Type() {
+	case scalaast.NodeFieldExpression, scalaast.NodeCallExpression:
+		target := body
+		if body.Type() == scalaast.NodeCallExpression {
+			target = body.ChildByFieldName("function")
+		}
+		if target != nil && target.Type() == scalaast.NodeFieldExpression {
+			if field := target.ChildByFieldName("field"); field != nil && parser.GetNodeText(field, source) == identPUF {
+				return domain.TestStatusXfail, identPUF
+			}
+		}
+	}
+
+	result := body
+	if block := scalaast.BlockOf(body); block != nil {
+		statements := scalaast.NamedChildren(block)
+		if len(statements) == 0 {
+			return domain.TestStatusActive, ""
+		}
+		result = statements[len(statements)-1]
+	}
+	if result.Type() == scalaast.NodeCallExpression {
+		result = result.ChildByFieldName("function")
+	}
+
+	switch {
+	case scalaast.IsIdentifier(result, source, identPending):
+		return domain.TestStatusTodo, identPending
+	case scalaast.IsIdentifier(result, source, identSkipped):
+		return domain.TestStatusSkipped, identSkipped
+	}
+	return domain.TestStatusActive, ""
+}
+
+// acceptanceExamples returns one test per example interpolated into an s2 string.
+func acceptanceExamples(expr *sitter.Node, source []byte, filename string) []domain.Test {
+	var text *sitter.Node
+	for _, child := range scalaast.NamedChildren(expr) {
+		if child.Type() == scalaast.NodeInterpolatedString {
+			text = child
+		}
+	}
+	if text == nil {
+		return nil
+	}
+
+	var tests []domain.Test
+	for _, interp := range scalaast.NamedChildren(text) {
+		if interp.Type() != scalaast.NodeInterpolation {
+			continue
+		}
+		if formattingFragments[strings.Trim(strings.TrimPrefix(parser.GetNodeText(interp, source), "$"), "{} ")] {
+			continue
+		}
+
+		line := source[text.StartByte():interp.StartByte()]
+		if idx := bytes.LastIndexByte(line, '\n'); idx >= 0 {
+			line = line[idx+1:]
+		}
+		name := strings.TrimSpace(strings.TrimLeft(string(line), `"`))
+		if name == "" {
+			continue
+		}
+
+		tests = append(tests, domain.Test{
+			Name:     name,
+			Status:   domain.TestStatusActive,
+			Location: parser.GetLocation(interp, filename),
+		})
+	}
+	return tests
+}
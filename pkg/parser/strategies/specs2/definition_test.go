@@ -0,0 +1,184 @@
+package specs2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+func TestSpecs2Parser_Parse(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		checkFunc func(t *testing.T, file *domain.TestFile)
+	}{
+		{
+			name: "mutable specification",
+			source: `
+import org.specs2.mutable.Specification
+
+class HelloWorldSpec extends Specification {
+  "The 'Hello world' string" should {
+    "contain 11 characters" in {
+      "Hello world" must haveSize(11)
+    }
+    "start with 'Hello'" in {
+      "Hello world" must startWith("Hello")
+    }
+    "be translated" in pending
+    "be skipped" in { skipped("no network") }
+    "eventually work" in {
+      1 must_== 2
+    }.pendingUntilFixed
+  }
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 || len(file.Suites[0].Suites) != 1 {
+					t.Fatalf("expected class suite with 1 group, got %+v", file.Suites)
+				}
+				group := file.Suites[0].Suites[0]
+				if group.Name != "The 'Hello world' string" {
+					t.Errorf("unexpected group %q", group.Name)
+				}
+				want := []struct {
+					name   string
+					status domain.TestStatus
+				}{
+					{"contain 11 characters", domain.TestStatusActive},
+					{"start with 'Hello'", domain.TestStatusActive},
+					{"be translated", domain.TestStatusTodo},
+					{"be skipped", domain.TestStatusSkipped},
+					{"eventually work", domain.TestStatusXfail},
+				}
+				if len(group.Tests) != len(want) {
+					t.Fatalf("expected %d tests, got %d", len(want), len(group.Tests))
+				}
+				for i, w := range want {
+					if group.Tests[i].Name != w.name || group.Tests[i].Status != w.status {
+						t.Errorf("test %d: expected %q/%q, got %q/%q", i, w.name, w.status, group.Tests[i].Name, group.Tests[i].Status)
+					}
+				}
+				if group.Tests[0].Location.StartLine != 6 {
+					t.Errorf("expected first example at line 6, got %d", group.Tests[0].Location.StartLine)
+				}
+			},
+		},
+		{
+			name: "arrow blocks nest only when they contain examples",
+			source: `
+class ParserSpec extends mutable.Specification {
+  "Parser" >> {
+    "parses numbers" >> {
+      parse("1") must beRight
+    }
+    "errors" can {
+      "report position" in { ok }
+    }
+  }
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if file.CountTests() != 2 {
+					t.Fatalf("expected 2 tests, got %d", file.CountTests())
+				}
+				parserGroup := file.Suites[0].Suites[0]
+				if parserGroup.Name != "Parser" || len(parserGroup.Tests) != 1 || len(parserGroup.Suites) != 1 {
+					t.Errorf("unexpected group %q with %d tests and %d groups", parserGroup.Name, len(parserGroup.Tests), len(parserGroup.Suites))
+				}
+			},
+		},
+		{
+			name: "acceptance specification",
+			source: `
+import org.specs2._
+
+class HelloWorldSpec extends Specification { def is = s2"""
+
+ This is a specification to check the 'Hello world' string
+
+ The 'Hello world' string should
+   contain 11 characters                                         $e1
+   start with 'Hello'                                            ${e2}
+                                                                 $p
+ """
+
+  def e1 = "Hello world" must haveSize(11)
+  def e2 = "Hello world" must startWith("Hello")
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				tests := file.Suites[0].Tests
+				if len(tests) != 2 {
+					t.Fatalf("expected 2 examples, got %d", len(tests))
+				}
+				if tests[0].Name != "contain 11 characters" || tests[1].Name != "start with 'Hello'" {
+					t.Errorf("unexpected examples %q, %q", tests[0].Name, tests[1].Name)
+				}
+				if tests[0].Location.StartLine != 9 {
+					t.Errorf("expected first example at line 9, got %d", tests[0].Location.StartLine)
+				}
+			},
+		},
+	}
+
+	parser := &Specs2Parser{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := parser.Parse(ctx, []byte(tt.source), "HelloWorldSpec.scala")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if file.Framework != frameworkName {
+				t.Errorf("expected framework %q, got %q", frameworkName, file.Framework)
+			}
+
+			if file.Language != domain.LanguageScala {
+				t.Errorf("expected language Scala, got %q", file.Language)
+			}
+
+			if tt.checkFunc != nil {
+				tt.checkFunc(t, file)
+			}
+		})
+	}
+}
+
+func TestSpecs2ContentMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantMatch bool
+	}{
+		{"specs2 import", "import org.specs2.mutable.Specification", true},
+		{"mutable Specification", "class A extends mutable.Specification", true},
+		{"acceptance string", `def is = s2"""`, true},
+		{"scalatest", "class A extends AnyWordSpec", false},
+		{"munit", "class A extends munit.FunSuite", false},
+	}
+
+	matcher := &Specs2ContentMatcher{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := framework.Signal{
+				Type:    framework.SignalFileContent,
+				Value:   tt.content,
+				Context: []byte(tt.content),
+			}
+			result := matcher.Match(ctx, signal)
+			if (result.Confidence > 0) != tt.wantMatch {
+				t.Errorf("Match() confidence = %v, want match = %v", result.Confidence, tt.wantMatch)
+			}
+		})
+	}
+}
@@ -0,0 +1,303 @@
+// Package spock implements Spock framework support for Groovy specification files.
+package spock
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+	"github.com/specvital/core/pkg/parser/framework"
+	"github.com/specvital/core/pkg/parser/framework/matchers"
+)
+
+const frameworkName = framework.FrameworkSpock
+
+// Groovy AST node types.
+const (
+	nodeArgumentList    = "argument_list"
+	nodeBinaryOp        = "binary_op"
+	nodeClassDefinition = "class_definition"
+	nodeClosure         = "closure"
+	nodeDeclaration     = "declaration"
+	nodeERROR           = "ERROR"
+	nodeFunctionCall    = "function_call"
+	nodeFunctionDecl    = "function_declaration"
+	nodeIdentifier      = "identifier"
+	nodeLabel           = "label"
+	nodeList            = "list"
+	nodeString          = "string"
+	nodeStringContent   = "string_content"
+)
+
+// Spock annotations that change how a feature or specification runs.
+const (
+	annotationIgnore           = "Ignore"
+	annotationIgnoreIf         = "IgnoreIf"
+	annotationIgnoreRest       = "IgnoreRest"
+	annotationPendingFeature   = "PendingFeature"
+	annotationPendingFeatureIf = "PendingFeatureIf"
+	annotationRollup           = "Rollup"
+	annotationTag              = "Tag"
+)
+
+// annotationStatuses lists status-changing annotations in precedence order.
+var annotationStatuses = []struct {
+	name   string
+	status domain.TestStatus
+}{
+	{annotationIgnore, domain.TestStatusSkipped},
+	{annotationIgnoreIf, domain.TestStatusSkipped},
+	{annotationPendingFeature, domain.TestStatusXfail},
+	{annotationPendingFeatureIf, domain.TestStatusXfail},
+	{annotationIgnoreRest, domain.TestStatusFocused},
+}
+
+var annotationPattern = regexp.MustCompile(`@((?:[A-Za-z_]\w*\.)*[A-Za-z_]\w*)(?:\s*\(\s*"([^"]*)"\s*\))?`)
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageGroovy},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher("spock.lang", "spock."),
+			&SpockContentMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &SpockParser{},
+		Priority:     framework.PriorityGeneric,
+	}
+}
+
+// SpockContentMatcher matches Spock-specific patterns in file content.
+type SpockContentMatcher struct{}
+
+var spockPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`import\s+spock\.`), "Spock import"},
+	{regexp.MustCompile(`extends\s+(?:spock\.lang\.)?Specification\b`), "extends Specification"},
+	{regexp.MustCompile(`(?m)^\s*(?:def|void)\s+["'][^"'\n]+["']\s*\(\s*\)`), "string-named feature method"},
+}
+
+func (m *SpockContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range spockPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found Spock pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+// SpockParser extracts feature methods from Groovy Spock specifications.
+// Each specification class becomes a suite of its def "feature"() methods. Features with a
+// where: block whose data is known statically are unrolled into one test per iteration,
+// grouped under a suite named after the feature, as Spock 2 reports them.
+type SpockParser struct{}
+
+func (p *SpockParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageGroovy, source)
+	if err != nil {
+		return nil, fmt.Errorf("spock parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	file := &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageGroovy,
+		Framework: frameworkName,
+	}
+
+	parser.WalkTree(tree.RootNode(), func(node *sitter.Node) bool {
+		if node.Type() != nodeClassDefinition {
+			return true
+		}
+		if suite, ok := parseSpecification(node, source, filename); ok {
+			file.Suites = append(file.Suites, suite)
+		}
+		return true
+	})
+
+	return file, nil
+}
+
+// annotations are the annotation names and single string arguments preceding a declaration.
+type annotations struct {
+	names []string
+	args  map[string]string
+}
+
+func (a annotations) has(name string) bool {
+	for _, n := range a.names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (a annotations) status() (domain.TestStatus, string) {
+	for _, candidate := range annotationStatuses {
+		if a.has(candidate.name) {
+			return candidate.status, "@" + candidate.name
+		}
+	}
+	return domain.TestStatusActive, ""
+}
+
+func (a annotations) tags() []string {
+	if tag, ok := a.args[annotationTag]; ok && tag != "" {
+		return []string{tag}
+	}
+	return nil
+}
+
+// parseAnnotations reads annotation names from declaration text. The Groovy grammar
+// splits stacked annotations into ERROR and declaration fragments, so the text is
+// scanned instead of the annotation nodes. Qualified names are reduced to their simple name.
+func parseAnnotations(text string) annotations {
+	result := annotations{args: map[string]string{}}
+	for _, match := range annotationPattern.FindAllStringSubmatch(text, -1) {
+		name := match[1]
+		if idx := strings.LastIndex(name, "."); idx >= 0 {
+			name = name[idx+1:]
+		}
+		result.names = append(result.names, name)
+		if match[2] != "" {
+			result.args[name] = match[2]
+		}
+	}
+	return result
+}
+
+func parseSpecification(class *sitter.Node, source []byte, filename string) (domain.TestSuite, bool) {
+	name := class.ChildByFieldName("name")
+	body := class.ChildByFieldName("body")
+	if name == nil || body == nil {
+		return domain.TestSuite{}, false
+	}
+
+	suite := domain.TestSuite{
+		Name:     parser.GetNodeText(name, source),
+		Status:   domain.TestStatusActive,
+		Location: parser.GetLocation(class, filename),
+	}
+
+	classAnnotations := parseAnnotations(string(source[class.StartByte():name.StartByte()]))
+	if status, modifier := classAnnotations.status(); status == domain.TestStatusSkipped {
+		suite.Status = status
+		suite.Modifier = modifier
+	}
+
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		call := body.NamedChild(i)
+		featureName, ok := featureMethodName(call, source)
+		if !ok {
+			continue
+		}
+		block := call.NextNamedSibling()
+		if block == nil || block.Type() != nodeClosure {
+			continue
+		}
+
+		feature := parseAnnotations(featurePrelude(call, source))
+		status, modifier := feature.status()
+		location := parser.GetLocation(call, filename)
+		location.EndLine = int(block.EndPoint().Row) + 1
+		location.EndCol = int(block.EndPoint().Column)
+
+		test := domain.Test{
+			Name:     featureName,
+			Status:   status,
+			Modifier: modifier,
+			Location: location,
+			Tags:     append(classAnnotations.tags(), feature.tags()...),
+		}
+
+		if feature.has(annotationRollup) || classAnnotations.has(annotationRollup) {
+			suite.Tests = append(suite.Tests, test)
+			continue
+		}
+
+		table, ok := parseWhereBlock(block, source)
+		if !ok || len(table.rows) == 0 {
+			suite.Tests = append(suite.Tests, test)
+			continue
+		}
+		suite.Suites = append(suite.Suites, unroll(test, table, filename))
+	}
+
+	return suite, len(suite.Tests) > 0 || len(suite.Suites) > 0
+}
+
+// featureMethodName matches the "name"() call the Groovy grammar produces for def "name"() { }.
+func featureMethodName(node *sitter.Node, source []byte) (string, bool) {
+	if node.Type() != nodeFunctionCall {
+		return "", false
+	}
+	fn := node.ChildByFieldName("function")
+	args := node.ChildByFieldName("args")
+	if fn == nil || fn.Type() != nodeString || args == nil || args.Type() != nodeArgumentList {
+		return "", false
+	}
+	if args.NamedChildCount() != 0 {
+		return "", false
+	}
+
+	prev := node.PrevNamedSibling()
+	if prev == nil || !declaresMethod(prev, source) {
+		return "", false
+	}
+
+	for i := 0; i < int(fn.NamedChildCount()); i++ {
+		if child := fn.NamedChild(i); child.Type() == nodeStringContent {
+			return parser.GetNodeText(child, source), true
+		}
+	}
+	return "", false
+}
+
+// declaresMethod reports whether a declaration fragment ends with the def/void keyword of a feature method.
+// Depending on the surrounding annotations the grammar yields a declaration, a function
+// declaration or an ERROR node for it.
+func declaresMethod(node *sitter.Node, source []byte) bool {
+	switch node.Type() {
+	case nodeDeclaration, nodeFunctionDecl, nodeERROR:
+	default:
+		return false
+	}
+	text := strings.TrimSpace(parser.GetNodeText(node, source))
+	return strings.HasSuffix(text, "def") || strings.HasSuffix(text, "void")
+}
+
+// featurePrelude returns the annotation and modifier text preceding a feature method name.
+// It spans the def/void declaration and any directly preceding annotation fragments.
+func featurePrelude(call *sitter.Node, source []byte) string {
+	start := call.PrevNamedSibling()
+	for prev := start.PrevNamedSibling(); prev != nil; prev = prev.PrevNamedSibling() {
+		if !strings.HasPrefix(parser.GetNodeText(prev, source), "@") || prev.Type() == nodeClosure {
+			break
+		}
+		start = prev
+	}
+	return string(source[start.StartByte():call.StartByte()])
+}
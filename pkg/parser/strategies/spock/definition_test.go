@@ -0,0 +1,245 @@
+package spock
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+func TestSpockParser_Parse(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		checkFunc func(t *testing.T, file *domain.TestFile)
+	}{
+		{
+			name: "feature methods and annotations",
+			source: `
+package com.example
+
+import spock.lang.*
+
+class StackSpec extends Specification {
+  def stack = new Stack()
+
+  def "push adds an element"() {
+    when:
+    stack.push(1)
+    then:
+    stack.size() == 1
+  }
+
+  @Ignore("later")
+  def "ignored feature"() {
+    expect: true
+  }
+
+  @PendingFeature
+  def 'pending feature'() {
+    expect: false
+  }
+
+  @Issue("X-1")
+  @IgnoreIf({ os.windows })
+  @Tag("slow")
+  void "conditionally ignored"() {
+    expect: true
+  }
+
+  def setup() {}
+  def helper() { 1 }
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				suite := file.Suites[0]
+				if suite.Name != "StackSpec" {
+					t.Errorf("expected suite 'StackSpec', got %q", suite.Name)
+				}
+				if len(suite.Tests) != 4 {
+					t.Fatalf("expected 4 features, got %d", len(suite.Tests))
+				}
+				if suite.Tests[0].Name != "push adds an element" || suite.Tests[0].Location.StartLine != 9 {
+					t.Errorf("unexpected first feature %q at line %d", suite.Tests[0].Name, suite.Tests[0].Location.StartLine)
+				}
+				if suite.Tests[1].Status != domain.TestStatusSkipped || suite.Tests[1].Modifier != "@Ignore" {
+					t.Errorf("expected @Ignore as skipped, got %q/%q", suite.Tests[1].Status, suite.Tests[1].Modifier)
+				}
+				if suite.Tests[2].Name != "pending feature" || suite.Tests[2].Status != domain.TestStatusXfail {
+					t.Errorf("expected @PendingFeature as xfail, got %q/%q", suite.Tests[2].Name, suite.Tests[2].Status)
+				}
+				if suite.Tests[3].Status != domain.TestStatusSkipped || suite.Tests[3].Modifier != "@IgnoreIf" {
+					t.Errorf("expected @IgnoreIf as skipped, got %q/%q", suite.Tests[3].Status, suite.Tests[3].Modifier)
+				}
+				if tags := suite.Tests[3].Tags; len(tags) != 1 || tags[0] != "slow" {
+					t.Errorf("expected tags [slow], got %v", tags)
+				}
+			},
+		},
+		{
+			name: "data table unrolled with placeholders",
+			source: `
+class MathSpec extends Specification {
+  @Unroll
+  def "max of #a and #b is #c"() {
+    expect:
+    Math.max(a, b) == c
+
+    where:
+    a | b || c
+    1 | 3 || 3
+    7 | 4 || 7
+    0 | 0 || 0
+  }
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites[0].Suites) != 1 {
+					t.Fatalf("expected unrolled feature suite, got %+v", file.Suites[0])
+				}
+				feature := file.Suites[0].Suites[0]
+				if feature.Name != "max of #a and #b is #c" || len(feature.Tests) != 3 {
+					t.Fatalf("expected 3 iterations, got %q with %d", feature.Name, len(feature.Tests))
+				}
+				if feature.Tests[0].Name != "max of 1 and 3 is 3" || feature.Tests[0].Location.StartLine != 10 {
+					t.Errorf("unexpected iteration %q at line %d", feature.Tests[0].Name, feature.Tests[0].Location.StartLine)
+				}
+				if file.CountTests() != 3 {
+					t.Errorf("expected 3 tests, got %d", file.CountTests())
+				}
+			},
+		},
+		{
+			name: "data pipes and default iteration names",
+			source: `
+class PipeSpec extends Specification {
+  @PendingFeature
+  def "positive numbers"() {
+    expect: x > 0
+    where:
+    x << [1, 2]
+  }
+
+  def "multiple assignment"() {
+    expect: a < b
+    where:
+    [a, b] << [[1, 2], [3, 4]]
+  }
+
+  def "provider from a method"() {
+    expect: x > 0
+    where:
+    x << loadNumbers()
+  }
+
+  @Rollup
+  def "rolled up"() {
+    expect: x > 0
+    where:
+    x << [1, 2, 3]
+  }
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				suite := file.Suites[0]
+				if len(suite.Suites) != 2 || len(suite.Tests) != 2 {
+					t.Fatalf("expected 2 unrolled features and 2 single features, got %d/%d", len(suite.Suites), len(suite.Tests))
+				}
+				positive := suite.Suites[0]
+				if positive.Tests[1].Name != "positive numbers [x: 2, #1]" {
+					t.Errorf("unexpected iteration name %q", positive.Tests[1].Name)
+				}
+				if positive.Status != domain.TestStatusXfail || positive.Tests[0].Status != domain.TestStatusXfail {
+					t.Errorf("expected pending iterations as xfail, got %q/%q", positive.Status, positive.Tests[0].Status)
+				}
+				if suite.Suites[1].Tests[0].Name != "multiple assignment [a: 1, b: 2, #0]" {
+					t.Errorf("unexpected iteration name %q", suite.Suites[1].Tests[0].Name)
+				}
+				if suite.Tests[0].Name != "provider from a method" || suite.Tests[1].Name != "rolled up" {
+					t.Errorf("expected runtime and rolled-up features as single tests, got %q and %q", suite.Tests[0].Name, suite.Tests[1].Name)
+				}
+			},
+		},
+		{
+			name: "ignored specification",
+			source: `
+@Ignore
+class LegacySpec extends spock.lang.Specification {
+  def "old behaviour"() {
+    expect: true
+  }
+}
+
+class Helper {
+  def helper() { 1 }
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Suites) != 1 {
+					t.Fatalf("expected 1 suite, got %d", len(file.Suites))
+				}
+				if file.Suites[0].Status != domain.TestStatusSkipped || file.Suites[0].Modifier != "@Ignore" {
+					t.Errorf("expected skipped specification, got %q/%q", file.Suites[0].Status, file.Suites[0].Modifier)
+				}
+			},
+		},
+	}
+
+	parser := &SpockParser{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := parser.Parse(ctx, []byte(tt.source), "StackSpec.groovy")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if file.Framework != frameworkName {
+				t.Errorf("expected framework %q, got %q", frameworkName, file.Framework)
+			}
+
+			if file.Language != domain.LanguageGroovy {
+				t.Errorf("expected language Groovy, got %q", file.Language)
+			}
+
+			if tt.checkFunc != nil {
+				tt.checkFunc(t, file)
+			}
+		})
+	}
+}
+
+func TestSpockContentMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantMatch bool
+	}{
+		{"spock import", "import spock.lang.Specification", true},
+		{"extends Specification", "class A extends Specification {", true},
+		{"feature method", `  def "adds numbers"() {`, true},
+		{"plain groovy class", "class A {\n  def run() {}\n}", false},
+	}
+
+	matcher := &SpockContentMatcher{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := framework.Signal{
+				Type:    framework.SignalFileContent,
+				Value:   tt.content,
+				Context: []byte(tt.content),
+			}
+			result := matcher.Match(ctx, signal)
+			if (result.Confidence > 0) != tt.wantMatch {
+				t.Errorf("Match() confidence = %v, want match = %v", result.Confidence, tt.wantMatch)
+			}
+		})
+	}
+}
@@ -0,0 +1,277 @@
+package spock
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+)
+
+const (
+	labelWhere = "where"
+	labelAnd   = "and"
+
+	opCell       = "|"
+	opDoubleCell = "||"
+	opPipe       = "<<"
+
+	placeholderIterationIndex = "iterationIndex"
+)
+
+var trailingLabel = regexp.MustCompile(`\b(\w+)\s*:\s*$`)
+
+// dataTable is the statically known data of a where: block.
+type dataTable struct {
+	vars []string
+	// rows hold one value per var, as written in the source.
+	rows []dataRow
+}
+
+type dataRow struct {
+	values []string
+	node   *sitter.Node
+}
+
+// dataSource is one data table or data pipe; sources are combined column-wise.
+type dataSource struct {
+	vars []string
+	rows []dataRow
+}
+
+// parseWhereBlock reads the data tables and list-literal data pipes of a feature's where: block.
+// It reports false when the block is absent or a data provider is only known at runtime,
+// in which case the feature counts as a single test (ADR-02).
+func parseWhereBlock(block *sitter.Node, source []byte) (dataTable, bool) {
+	statements := whereStatements(block, source)
+	if len(statements) == 0 {
+		return dataTable{}, false
+	}
+
+	var sources []dataSource
+	// table is the index of the data table receiving rows, or -1 outside a table.
+	table := -1
+	lastRow := -1
+
+	for _, stmt := range statements {
+		if stmt.Type() == nodeLabel {
+			table = -1
+			continue
+		}
+
+		if cells, ok := tableCells(stmt, source); ok {
+			startRow := int(stmt.StartPoint().Row)
+			// A blank line or a new label starts a new table with its own header.
+			if table < 0 || startRow > lastRow+1 {
+				sources = append(sources, dataSource{vars: cells})
+				table = len(sources) - 1
+			} else {
+				sources[table].rows = append(sources[table].rows, dataRow{values: cells, node: stmt})
+			}
+			lastRow = int(stmt.EndPoint().Row)
+			continue
+		}
+		table = -1
+
+		if left, right, ok := binaryParts(stmt, source, opPipe); ok {
+			pipe, ok := dataPipe(left, right, source)
+			if !ok {
+				return dataTable{}, false
+			}
+			sources = append(sources, pipe)
+		}
+		// Derived data variables (c = a + b) add no iterations.
+	}
+
+	return combineSources(sources)
+}
+
+// whereStatements returns the statements following the where: label, including and: sections.
+func whereStatements(block *sitter.Node, source []byte) []*sitter.Node {
+	var statements []*sitter.Node
+	inWhere := false
+	for i := 0; i < int(block.NamedChildCount()); i++ {
+		child := block.NamedChild(i)
+		if child.Type() == nodeERROR {
+			// Comparisons such as a < b can swallow the following label into an ERROR node.
+			if match := trailingLabel.FindStringSubmatch(parser.GetNodeText(child, source)); match != nil {
+				inWhere = match[1] == labelWhere || (inWhere && match[1] == labelAnd)
+				continue
+			}
+		}
+		if child.Type() == nodeLabel {
+			label := labelName(child, source)
+			switch {
+			case label == labelWhere:
+				inWhere = true
+				continue
+			case inWhere && label != labelAnd:
+				inWhere = false
+			}
+		}
+		if inWhere {
+			statements = append(statements, child)
+		}
+	}
+	return statements
+}
+
+func labelName(label *sitter.Node, source []byte) string {
+	if name := label.ChildByFieldName("name"); name != nil {
+		return parser.GetNodeText(name, source)
+	}
+	return ""
+}
+
+// tableCells flattens a data table row such as a | b || c into its cells.
+func tableCells(node *sitter.Node, source []byte) ([]string, bool) {
+	left, right, ok := binaryParts(node, source, opCell, opDoubleCell)
+	if !ok {
+		return nil, false
+	}
+	cells, ok := tableCells(left, source)
+	if !ok {
+		cells = []string{strings.TrimSpace(parser.GetNodeText(left, source))}
+	}
+	return append(cells, strings.TrimSpace(parser.GetNodeText(right, source))), true
+}
+
+// binaryParts splits a binary_op whose operator is one of ops.
+func binaryParts(node *sitter.Node, source []byte, ops ...string) (*sitter.Node, *sitter.Node, bool) {
+	if node.Type() != nodeBinaryOp || node.ChildCount() != 3 {
+		return nil, nil, false
+	}
+	operator := parser.GetNodeText(node.Child(1), source)
+	for _, op := range ops {
+		if operator == op {
+			return node.Child(0), node.Child(2), true
+		}
+	}
+	return nil, nil, false
+}
+
+// dataPipe reads a pipe with a list literal provider: a << [1, 2] or [a, b] << [[1, 2], [3, 4]].
+func dataPipe(left, right *sitter.Node, source []byte) (dataSource, bool) {
+	if right.Type() != nodeList {
+		return dataSource{}, false
+	}
+
+	var pipe dataSource
+	switch left.Type() {
+	case nodeIdentifier:
+		pipe.vars = []string{parser.GetNodeText(left, source)}
+	case nodeList:
+		for i := 0; i < int(left.NamedChildCount()); i++ {
+			pipe.vars = append(pipe.vars, parser.GetNodeText(left.NamedChild(i), source))
+		}
+	default:
+		return dataSource{}, false
+	}
+
+	for i := 0; i < int(right.NamedChildCount()); i++ {
+		element := right.NamedChild(i)
+		row := dataRow{node: element}
+		if len(pipe.vars) == 1 {
+			row.values = []string{parser.GetNodeText(element, source)}
+		} else {
+			if element.Type() != nodeList {
+				return dataSource{}, false
+			}
+			for j := 0; j < int(element.NamedChildCount()); j++ {
+				row.values = append(row.values, parser.GetNodeText(element.NamedChild(j), source))
+			}
+		}
+		pipe.rows = append(pipe.rows, row)
+	}
+	return pipe, true
+}
+
+// combineSources joins data sources column-wise. Spock requires every source to
+// provide the same number of iterations; mismatches are left to runtime.
+func combineSources(sources []dataSource) (dataTable, bool) {
+	if len(sources) == 0 {
+		return dataTable{}, false
+	}
+
+	count := len(sources[0].rows)
+	table := dataTable{rows: make([]dataRow, count)}
+	for _, src := range sources {
+		if len(src.rows) != count {
+			return dataTable{}, false
+		}
+		for _, v := range src.vars {
+			if v != "_" {
+				table.vars = append(table.vars, v)
+			}
+		}
+		for i, row := range src.rows {
+			if table.rows[i].node == nil {
+				table.rows[i].node = row.node
+			}
+			for j, value := range row.values {
+				if j < len(src.vars) && src.vars[j] != "_" {
+					table.rows[i].values = append(table.rows[i].values, value)
+				}
+			}
+		}
+	}
+	return table, true
+}
+
+// unroll returns a suite named after the feature with one test per data row.
+// Names follow Spock 2: #placeholders are replaced with row values, otherwise
+// the data variables and iteration index are appended.
+func unroll(feature domain.Test, table dataTable, filename string) domain.TestSuite {
+	suite := domain.TestSuite{
+		Name:     feature.Name,
+		Status:   feature.Status,
+		Modifier: feature.Modifier,
+		Location: feature.Location,
+	}
+
+	for i, row := range table.rows {
+		suite.Tests = append(suite.Tests, domain.Test{
+			Name:     iterationName(feature.Name, table.vars, row.values, i),
+			Status:   feature.Status,
+			Modifier: feature.Modifier,
+			Location: parser.GetLocation(row.node, filename),
+			Tags:     feature.Tags,
+		})
+	}
+	return suite
+}
+
+func iterationName(name string, vars, values []string, index int) string {
+	if !strings.Contains(name, "#") {
+		parts := make([]string, 0, len(vars)+1)
+		for i, v := range vars {
+			if i < len(values) {
+				parts = append(parts, v+": "+values[i])
+			}
+		}
+		parts = append(parts, fmt.Sprintf("#%d", index))
+		return name + " [" + strings.Join(parts, ", ") + "]"
+	}
+
+	replacements := map[string]string{placeholderIterationIndex: fmt.Sprint(index)}
+	for i, v := range vars {
+		if i < len(values) {
+			replacements[v] = strings.Trim(values[i], `"'`)
+		}
+	}
+
+	// Replace longer names first so #ab is not matched by #a.
+	keys := make([]string, 0, len(replacements))
+	for k := range replacements {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return len(keys[i]) > len(keys[j]) })
+	for _, k := range keys {
+		name = strings.ReplaceAll(name, "#"+k, replacements[k])
+	}
+	return name
+}
@@ -0,0 +1,201 @@
+// Package terraformtest implements support for Terraform and OpenTofu native test files
+// (.tftest.hcl and .tofutest.hcl).
+package terraformtest
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+const frameworkName = framework.FrameworkTerraform
+
+// Test file suffixes for Terraform and OpenTofu.
+const (
+	terraformTestSuffix = ".tftest.hcl"
+	tofuTestSuffix      = ".tofutest.hcl"
+)
+
+// HCL AST node types.
+const (
+	nodeAttribute       = "attribute"
+	nodeBlock           = "block"
+	nodeBody            = "body"
+	nodeCollectionValue = "collection_value"
+	nodeExpression      = "expression"
+	nodeIdentifier      = "identifier"
+	nodeStringLit       = "string_lit"
+	nodeTemplateLiteral = "template_literal"
+	nodeTuple           = "tuple"
+)
+
+const (
+	blockRun              = "run"
+	attrExpectFailures    = "expect_failures"
+	modifierExpectFailure = "expect_failures"
+)
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageHCL},
+		Matchers: []framework.Matcher{
+			&TerraformTestFileMatcher{},
+			&TerraformTestContentMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &TerraformTestParser{},
+		Priority:     framework.PriorityGeneric,
+	}
+}
+
+// TerraformTestFileMatcher matches *.tftest.hcl and *.tofutest.hcl files.
+type TerraformTestFileMatcher struct{}
+
+func (m *TerraformTestFileMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileName {
+		return framework.NoMatch()
+	}
+
+	base := filepath.Base(signal.Value)
+	if strings.HasSuffix(base, terraformTestSuffix) || strings.HasSuffix(base, tofuTestSuffix) {
+		return framework.DefiniteMatch("Terraform test file extension")
+	}
+
+	return framework.NoMatch()
+}
+
+// TerraformTestContentMatcher matches run blocks.
+type TerraformTestContentMatcher struct{}
+
+var terraformTestPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`(?m)^\s*run\s+"[^"]*"\s*\{`), "run block"},
+}
+
+func (m *TerraformTestContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range terraformTestPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found Terraform test pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+// TerraformTestParser extracts run blocks as tests. A run with expect_failures is
+// expected to fail its checks and is reported as xfail.
+type TerraformTestParser struct{}
+
+func (p *TerraformTestParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageHCL, source)
+	if err != nil {
+		return nil, fmt.Errorf("terraform-test parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	var tests []domain.Test
+	for _, block := range blocks(tree.RootNode()) {
+		if blockType(block, source) != blockRun {
+			continue
+		}
+		name, ok := blockLabel(block, source)
+		if !ok {
+			continue
+		}
+
+		test := domain.Test{
+			Name:     name,
+			Status:   domain.TestStatusActive,
+			Location: parser.GetLocation(block, filename),
+		}
+		if expectsFailures(block, source) {
+			test.Status = domain.TestStatusXfail
+			test.Modifier = modifierExpectFailure
+		}
+		tests = append(tests, test)
+	}
+
+	return &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageHCL,
+		Framework: frameworkName,
+		Tests:     tests,
+	}, nil
+}
+
+// blocks returns the blocks of a config_file or block body.
+func blocks(node *sitter.Node) []*sitter.Node {
+	body := parser.FindChildByType(node, nodeBody)
+	if body == nil {
+		return nil
+	}
+	return parser.FindChildrenByType(body, nodeBlock)
+}
+
+func blockType(block *sitter.Node, source []byte) string {
+	if id := parser.FindChildByType(block, nodeIdentifier); id != nil {
+		return parser.GetNodeText(id, source)
+	}
+	return ""
+}
+
+// blockLabel returns the first label of a block: run "name" { }.
+func blockLabel(block *sitter.Node, source []byte) (string, bool) {
+	label := parser.FindChildByType(block, nodeStringLit)
+	if label == nil {
+		return "", false
+	}
+	if text := parser.FindChildByType(label, nodeTemplateLiteral); text != nil {
+		return parser.GetNodeText(text, source), true
+	}
+	return "", true
+}
+
+// expectsFailures reports whether a run lists any checkable objects in expect_failures.
+func expectsFailures(block *sitter.Node, source []byte) bool {
+	body := parser.FindChildByType(block, nodeBody)
+	if body == nil {
+		return false
+	}
+	for _, attr := range parser.FindChildrenByType(body, nodeAttribute) {
+		id := parser.FindChildByType(attr, nodeIdentifier)
+		if id == nil || parser.GetNodeText(id, source) != attrExpectFailures {
+			continue
+		}
+		expr := parser.FindChildByType(attr, nodeExpression)
+		if expr == nil {
+			return false
+		}
+		collection := parser.FindChildByType(expr, nodeCollectionValue)
+		if collection == nil {
+			return false
+		}
+		tuple := parser.FindChildByType(collection, nodeTuple)
+		return tuple != nil && len(parser.FindChildrenByType(tuple, nodeExpression)) > 0
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+package terraformtest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+func TestTerraformTestParser_Parse(t *testing.T) {
+	source := `variables {
+  bucket_prefix = "test"
+}
+
+provider "aws" {
+  region = "us-east-1"
+}
+
+run "setup" {
+  module {
+    source = "./tests/setup"
+  }
+}
+
+run "valid_name" {
+  command = plan
+
+  assert {
+    condition     = aws_s3_bucket.bucket.bucket == "test-bucket"
+    error_message = "wrong name"
+  }
+}
+
+run "invalid_name" {
+  command = plan
+
+  variables {
+    bucket_prefix = "INVALID"
+  }
+
+  expect_failures = [
+    var.bucket_prefix,
+  ]
+}
+
+run "no_failures" {
+  expect_failures = []
+}
+`
+
+	parser := &TerraformTestParser{}
+	file, err := parser.Parse(context.Background(), []byte(source), "tests/main.tftest.hcl")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if file.Framework != frameworkName {
+		t.Errorf("expected framework %q, got %q", frameworkName, file.Framework)
+	}
+	if file.Language != domain.LanguageHCL {
+		t.Errorf("expected language HCL, got %q", file.Language)
+	}
+
+	want := []struct {
+		name     string
+		line     int
+		status   domain.TestStatus
+		modifier string
+	}{
+		{"setup", 9, domain.TestStatusActive, ""},
+		{"valid_name", 15, domain.TestStatusActive, ""},
+		{"invalid_name", 24, domain.TestStatusXfail, "expect_failures"},
+		{"no_failures", 36, domain.TestStatusActive, ""},
+	}
+	if len(file.Tests) != len(want) {
+		t.Fatalf("expected %d runs, got %+v", len(want), file.Tests)
+	}
+	for i, w := range want {
+		got := file.Tests[i]
+		if got.Name != w.name || got.Location.StartLine != w.line {
+			t.Errorf("expected %q at line %d, got %q at line %d", w.name, w.line, got.Name, got.Location.StartLine)
+		}
+		if got.Status != w.status || got.Modifier != w.modifier {
+			t.Errorf("%q: expected %s/%q, got %s/%q", got.Name, w.status, w.modifier, got.Status, got.Modifier)
+		}
+	}
+}
+
+func TestTerraformTestFileMatcher_Match(t *testing.T) {
+	tests := []struct {
+		filename  string
+		wantMatch bool
+	}{
+		{"main.tftest.hcl", true},
+		{"tests/main.tofutest.hcl", true},
+		{"terragrunt.hcl", false},
+		{"main.tf", false},
+	}
+
+	matcher := &TerraformTestFileMatcher{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			result := matcher.Match(ctx, framework.Signal{Type: framework.SignalFileName, Value: tt.filename})
+			if (result.Confidence == 100) != tt.wantMatch {
+				t.Errorf("Match() confidence = %v, want definite match = %v", result.Confidence, tt.wantMatch)
+			}
+		})
+	}
+}
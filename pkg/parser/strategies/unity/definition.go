@@ -0,0 +1,233 @@
+// Package unity implements Unity (ThrowTheSwitch) framework support for C test files.
+package unity
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser"
+	"github.com/specvital/core/pkg/parser/framework"
+	"github.com/specvital/core/pkg/parser/framework/matchers"
+	"github.com/specvital/core/pkg/parser/strategies/shared/cppast"
+)
+
+const frameworkName = framework.FrameworkUnity
+
+const (
+	macroRunTest    = "RUN_TEST"
+	macroTest       = "TEST"
+	macroIgnoreTest = "IGNORE_TEST"
+)
+
+// testFunctionPrefixes are the name prefixes Unity's generate_test_runner.rb treats as tests.
+var testFunctionPrefixes = []string{"test", "spec"}
+
+// ignoreMacros mark a test as ignored when called in its body.
+var ignoreMacros = map[string]bool{
+	"TEST_IGNORE":         true,
+	"TEST_IGNORE_MESSAGE": true,
+}
+
+func init() {
+	framework.Register(NewDefinition())
+}
+
+func NewDefinition() *framework.Definition {
+	return &framework.Definition{
+		Name:      frameworkName,
+		Languages: []domain.Language{domain.LanguageC},
+		Matchers: []framework.Matcher{
+			matchers.NewImportMatcher(
+				"unity.h",
+				"unity_fixture.h",
+				"unity/unity.h",
+				"unity/unity_fixture.h",
+			),
+			&UnityContentMatcher{},
+		},
+		ConfigParser: nil,
+		Parser:       &UnityParser{},
+		Priority:     framework.PriorityGeneric,
+	}
+}
+
+// UnityContentMatcher matches Unity-specific patterns in file content.
+type UnityContentMatcher struct{}
+
+var unityPatterns = []struct {
+	pattern *regexp.Regexp
+	desc    string
+}{
+	{regexp.MustCompile(`#include\s*[<"](?:unity/)?unity(?:_fixture)?\.h[>"]`), "#include \"unity.h\""},
+	{regexp.MustCompile(`\bUNITY_BEGIN\s*\(`), "UNITY_BEGIN() macro"},
+	{regexp.MustCompile(`\bTEST_ASSERT\w*\s*\(`), "TEST_ASSERT macro"},
+	{regexp.MustCompile(`\bTEST_GROUP\s*\(`), "TEST_GROUP() macro"},
+}
+
+func (m *UnityContentMatcher) Match(ctx context.Context, signal framework.Signal) framework.MatchResult {
+	if signal.Type != framework.SignalFileContent {
+		return framework.NoMatch()
+	}
+
+	content, ok := signal.Context.([]byte)
+	if !ok {
+		content = []byte(signal.Value)
+	}
+
+	for _, p := range unityPatterns {
+		if p.pattern.Match(content) {
+			return framework.PartialMatch(40, "Found Unity pattern: "+p.desc)
+		}
+	}
+
+	return framework.NoMatch()
+}
+
+// UnityParser extracts test definitions from C Unity files.
+//
+// Two styles are supported:
+//   - Plain Unity: `void test_xxx(void)` functions, plus any other functions passed to RUN_TEST
+//   - Unity Fixture: TEST(group, name) and IGNORE_TEST(group, name), grouped into suites
+type UnityParser struct{}
+
+func (p *UnityParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	tree, err := parser.ParseWithPool(ctx, domain.LanguageC, source)
+	if err != nil {
+		return nil, fmt.Errorf("unity parser: failed to parse %s: %w", filename, err)
+	}
+	defer tree.Close()
+
+	root := tree.RootNode()
+
+	file := &domain.TestFile{
+		Path:      filename,
+		Language:  domain.LanguageC,
+		Framework: frameworkName,
+	}
+
+	file.Tests = parseTestFunctions(root, source, filename)
+	file.Suites = parseFixtureTests(root, source, filename)
+
+	return file, nil
+}
+
+// parseTestFunctions returns the test functions defined in the file.
+func parseTestFunctions(root *sitter.Node, source []byte, filename string) []domain.Test {
+	functions := cppast.Functions(root, source)
+	registered := runTestTargets(functions, source)
+
+	var tests []domain.Test
+	for _, fn := range functions {
+		if !fn.TakesNoArguments(source) {
+			continue
+		}
+		if !registered[fn.Name] && !hasTestPrefix(fn.Name) {
+			continue
+		}
+
+		status := domain.TestStatusActive
+		modifier := ""
+		if ignored := ignoreCall(fn.Body, source); ignored != "" {
+			status = domain.TestStatusSkipped
+			modifier = ignored
+		}
+
+		tests = append(tests, domain.Test{
+			Name:     fn.Name,
+			Status:   status,
+			Modifier: modifier,
+			Location: parser.GetLocation(fn.Node, filename),
+		})
+	}
+	return tests
+}
+
+// runTestTargets collects the functions passed to RUN_TEST in any function body.
+func runTestTargets(functions []cppast.Function, source []byte) map[string]bool {
+	targets := make(map[string]bool)
+	for _, fn := range functions {
+		if fn.Body == nil {
+			continue
+		}
+		parser.WalkTree(fn.Body, func(node *sitter.Node) bool {
+			if node.Type() != cppast.NodeCallExpression || cppast.CallName(node, source) != macroRunTest {
+				return true
+			}
+			if args := cppast.CallArgs(node); len(args) > 0 {
+				targets[parser.GetNodeText(args[0], source)] = true
+			}
+			return false
+		})
+	}
+	return targets
+}
+
+func hasTestPrefix(name string) bool {
+	for _, prefix := range testFunctionPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ignoreCall returns the name of the first TEST_IGNORE macro called in body, or "".
+func ignoreCall(body *sitter.Node, source []byte) string {
+	for _, name := range cppast.CalledFunctions(body, source) {
+		if ignoreMacros[name] {
+			return name
+		}
+	}
+	return ""
+}
+
+// parseFixtureTests returns one suite per Unity Fixture test group, in order of first use.
+func parseFixtureTests(root *sitter.Node, source []byte, filename string) []domain.TestSuite {
+	var suites []domain.TestSuite
+	index := make(map[string]int)
+
+	for _, m := range cppast.Macros(root, source) {
+		if m.Name != macroTest && m.Name != macroIgnoreTest {
+			continue
+		}
+		group := m.ArgText(0, source)
+		name := m.ArgText(1, source)
+		if group == "" || name == "" {
+			continue
+		}
+
+		status := domain.TestStatusActive
+		modifier := ""
+		if m.Name == macroIgnoreTest {
+			status = domain.TestStatusSkipped
+			modifier = macroIgnoreTest
+		} else if ignored := ignoreCall(m.Body, source); ignored != "" {
+			status = domain.TestStatusSkipped
+			modifier = ignored
+		}
+
+		i, ok := index[group]
+		if !ok {
+			i = len(suites)
+			index[group] = i
+			suites = append(suites, domain.TestSuite{
+				Name:     group,
+				Status:   domain.TestStatusActive,
+				Location: m.Location(filename),
+			})
+		}
+		suites[i].Tests = append(suites[i].Tests, domain.Test{
+			Name:     name,
+			Status:   status,
+			Modifier: modifier,
+			Location: m.Location(filename),
+		})
+	}
+
+	return suites
+}
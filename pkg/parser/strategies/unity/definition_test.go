@@ -0,0 +1,209 @@
+package unity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specvital/core/pkg/domain"
+	"github.com/specvital/core/pkg/parser/framework"
+)
+
+func TestUnityParser_Parse(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		checkFunc func(t *testing.T, file *domain.TestFile)
+	}{
+		{
+			name: "test functions",
+			source: `
+#include "unity.h"
+#include "stack.h"
+
+void setUp(void) {}
+void tearDown(void) {}
+
+void test_push_increments_size(void)
+{
+    TEST_ASSERT_EQUAL(1, stack_size());
+}
+
+static void test_pop_on_empty(void) {
+    TEST_ASSERT_NULL(stack_pop());
+}
+
+static int test_helper(int value) {
+    return value;
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 2 {
+					t.Fatalf("expected 2 tests, got %d", len(file.Tests))
+				}
+				if file.Tests[0].Name != "test_push_increments_size" {
+					t.Errorf("unexpected first test %q", file.Tests[0].Name)
+				}
+				if file.Tests[1].Name != "test_pop_on_empty" {
+					t.Errorf("unexpected second test %q", file.Tests[1].Name)
+				}
+				if file.Tests[0].Location.StartLine != 8 {
+					t.Errorf("expected start line 8, got %d", file.Tests[0].Location.StartLine)
+				}
+			},
+		},
+		{
+			name: "RUN_TEST registers functions without test prefix",
+			source: `
+#include "unity.h"
+
+void should_accept_valid_input(void) {
+    TEST_ASSERT_TRUE(validate("ok"));
+}
+
+void helper(void) {}
+
+int main(void)
+{
+    UNITY_BEGIN();
+    RUN_TEST(should_accept_valid_input);
+    return UNITY_END();
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 1 {
+					t.Fatalf("expected 1 test, got %d", len(file.Tests))
+				}
+				if file.Tests[0].Name != "should_accept_valid_input" {
+					t.Errorf("unexpected test %q", file.Tests[0].Name)
+				}
+			},
+		},
+		{
+			name: "TEST_IGNORE marks test as skipped",
+			source: `
+#include "unity.h"
+
+void test_not_ready(void) {
+    TEST_IGNORE_MESSAGE("waiting on driver");
+}
+
+void test_ready(void) {
+    TEST_PASS();
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 2 {
+					t.Fatalf("expected 2 tests, got %d", len(file.Tests))
+				}
+				if file.Tests[0].Status != domain.TestStatusSkipped {
+					t.Errorf("expected skipped, got %q", file.Tests[0].Status)
+				}
+				if file.Tests[0].Modifier != "TEST_IGNORE_MESSAGE" {
+					t.Errorf("expected modifier TEST_IGNORE_MESSAGE, got %q", file.Tests[0].Modifier)
+				}
+				if file.Tests[1].Status != domain.TestStatusActive {
+					t.Errorf("expected active, got %q", file.Tests[1].Status)
+				}
+			},
+		},
+		{
+			name: "Unity Fixture groups",
+			source: `
+#include "unity_fixture.h"
+
+TEST_GROUP(Stack);
+
+TEST_SETUP(Stack) {}
+TEST_TEAR_DOWN(Stack) {}
+
+TEST(Stack, StartsEmpty) {
+    TEST_ASSERT_EQUAL(0, stack_size());
+}
+
+IGNORE_TEST(Stack, Overflow) {
+}
+
+TEST(Queue, StartsEmpty) {}
+
+TEST_GROUP_RUNNER(Stack) {
+    RUN_TEST_CASE(Stack, StartsEmpty);
+    RUN_TEST_CASE(Stack, Overflow);
+}
+`,
+			checkFunc: func(t *testing.T, file *domain.TestFile) {
+				if len(file.Tests) != 0 {
+					t.Errorf("expected 0 top-level tests, got %d", len(file.Tests))
+				}
+				if len(file.Suites) != 2 {
+					t.Fatalf("expected 2 suites, got %d", len(file.Suites))
+				}
+				stack := file.Suites[0]
+				if stack.Name != "Stack" || len(stack.Tests) != 2 {
+					t.Fatalf("expected suite Stack with 2 tests, got %q with %d", stack.Name, len(stack.Tests))
+				}
+				if stack.Tests[1].Status != domain.TestStatusSkipped || stack.Tests[1].Modifier != "IGNORE_TEST" {
+					t.Errorf("expected IGNORE_TEST skipped, got %q/%q", stack.Tests[1].Status, stack.Tests[1].Modifier)
+				}
+				if file.Suites[1].Name != "Queue" {
+					t.Errorf("expected suite Queue, got %q", file.Suites[1].Name)
+				}
+			},
+		},
+	}
+
+	parser := &UnityParser{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := parser.Parse(ctx, []byte(tt.source), "test_stack.c")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if file.Framework != frameworkName {
+				t.Errorf("expected framework %q, got %q", frameworkName, file.Framework)
+			}
+
+			if file.Language != domain.LanguageC {
+				t.Errorf("expected language C, got %q", file.Language)
+			}
+
+			if tt.checkFunc != nil {
+				tt.checkFunc(t, file)
+			}
+		})
+	}
+}
+
+func TestUnityContentMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantMatch bool
+	}{
+		{"unity include", `#include "unity.h"`, true},
+		{"unity fixture include", `#include "unity_fixture.h"`, true},
+		{"UNITY_BEGIN", "UNITY_BEGIN();", true},
+		{"TEST_ASSERT macro", "TEST_ASSERT_EQUAL(1, x);", true},
+		{"cmocka include", "#include <cmocka.h>", false},
+		{"plain c code", "int main(void) { return 0; }", false},
+	}
+
+	matcher := &UnityContentMatcher{}
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := framework.Signal{
+				Type:    framework.SignalFileContent,
+				Value:   tt.content,
+				Context: []byte(tt.content),
+			}
+			result := matcher.Match(ctx, signal)
+			if (result.Confidence > 0) != tt.wantMatch {
+				t.Errorf("Match() confidence = %v, want match = %v", result.Confidence, tt.wantMatch)
+			}
+		})
+	}
+}
@@ -2,12 +2,16 @@ package vitest
 
 import (
 	"context"
+	"path"
+	"path/filepath"
 	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/specvital/core/pkg/domain"
-	"github.com/specvital/core/pkg/parser/detection/extraction"
 	"github.com/specvital/core/pkg/parser/framework"
 	"github.com/specvital/core/pkg/parser/framework/matchers"
+	"github.com/specvital/core/pkg/parser/strategies/shared/jsconfig"
 	"github.com/specvital/core/pkg/parser/strategies/shared/jstest"
 )
 
@@ -28,6 +32,11 @@ func NewDefinition() *framework.Definition {
 				"vitest.config.ts",
 				"vitest.config.mjs",
 				"vitest.config.mts",
+				"vitest.workspace.ts",
+				"vitest.workspace.mts",
+				"vitest.workspace.js",
+				"vitest.workspace.mjs",
+				"vitest.workspace.json",
 			),
 			&VitestContentMatcher{},
 		},
@@ -39,73 +48,171 @@ func NewDefinition() *framework.Definition {
 
 type VitestConfigParser struct{}
 
+// Parse reads vitest.config.* and vitest.workspace.* files. Each test.projects entry
+// (test.workspace before Vitest 3.2, or the workspace file's array) becomes a project:
+// inline configs with their name, root, include, exclude and environment, and path or
+// glob entries as ProjectPatterns for the scanner to expand. A config with test.name
+// is itself a project, which names the files of a workspace entry pointing at it.
 func (p *VitestConfigParser) Parse(ctx context.Context, configPath string, content []byte) (*framework.ConfigScope, error) {
-	root := parseRoot(content)
-	scope := framework.NewConfigScope(configPath, root)
+	config, err := jsconfig.Evaluate(ctx, configPath, content)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.HasPrefix(filepath.Base(configPath), workspaceFilePrefix) {
+		scope := framework.NewConfigScope(configPath, "")
+		scope.Framework = frameworkName
+		// A workspace file only lists projects; a config next to it keeps the test options.
+		scope.Passive = true
+		addProjects(scope, config, jsconfig.Unknown)
+		return scope, nil
+	}
+
+	scope := framework.NewConfigScope(configPath, parseRoot(config))
 	scope.Framework = frameworkName
-	scope.GlobalsMode = parseGlobals(ctx, content)
-	scope.Include = parseInclude(content)
-	scope.Exclude = parseExclude(content)
+	scope.GlobalsMode = parseGlobals(config)
+	scope.IncludeCollects = true
+	// Only test.include/test.exclude select test files; coverage has its own.
+	scope.Include = config.Lookup("test", "include").Strings()
+	scope.Exclude = config.Lookup("test", "exclude").Strings()
+
+	entries := config.Lookup("test", "projects")
+	if !entries.Known() {
+		entries = config.Lookup("test", "workspace")
+	}
+	if len(entries.Items()) > 0 {
+		addProjects(scope, entries, config)
+	} else if name := parseName(config); name != "" {
+		scope.Projects = append(scope.Projects, framework.ProjectScope{
+			Name:     name,
+			BaseDir:  scope.BaseDir,
+			Include:  scope.Include,
+			Exclude:  scope.Exclude,
+			Settings: projectSettings(config),
+		})
+	}
 	return scope, nil
 }
 
-type VitestParser struct{}
+const workspaceFilePrefix = "vitest.workspace."
 
-func (p *VitestParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
-	return jstest.Parse(ctx, source, filename, frameworkName)
-}
+// addProjects adds the projects of the entries array. root is the config inline
+// projects extend with extends: true.
+func addProjects(scope *framework.ConfigScope, entries jsconfig.Value, root jsconfig.Value) {
+	configDir := filepath.Dir(scope.ConfigPath)
 
-var (
-	configRootPattern    = regexp.MustCompile(`root\s*:\s*['"]([^'"]+)['"]`)
-	configGlobalsPattern = regexp.MustCompile(`globals\s*:\s*true`)
-	// Pattern to remove coverage block (which has its own include/exclude)
-	configCoveragePattern = regexp.MustCompile(`(?s)coverage\s*:\s*\{[^}]*(?:\{[^}]*\}[^}]*)*\}`)
-	configIncludePattern  = regexp.MustCompile(`(?:^|[,\s])include\s*:\s*\[([^\]]+)\]`)
-	configExcludePattern  = regexp.MustCompile(`(?:^|[,\s])exclude\s*:\s*\[([^\]]+)\]`)
-	configItemPattern     = regexp.MustCompile(`['"]([^'"]+)['"]`)
-)
+	for _, entry := range entries.Items() {
+		if pattern, ok := entry.AsString(); ok {
+			scope.ProjectPatterns = append(scope.ProjectPatterns, projectPattern(configDir, scope.BaseDir, pattern))
+			continue
+		}
+		if !entry.IsObject() {
+			continue
+		}
 
-func parseRoot(content []byte) string {
-	if match := configRootPattern.FindSubmatch(content); match != nil {
-		return string(match[1])
+		project := framework.ProjectScope{
+			Name:     parseName(entry),
+			BaseDir:  configDir,
+			Include:  entry.Lookup("test", "include").Strings(),
+			Exclude:  entry.Lookup("test", "exclude").Strings(),
+			Settings: projectSettings(entry),
+		}
+		if projectRoot := parseRoot(entry); filepath.IsAbs(projectRoot) {
+			project.BaseDir = filepath.Clean(projectRoot)
+		} else if projectRoot != "" {
+			project.BaseDir = filepath.Join(configDir, filepath.FromSlash(projectRoot))
+		}
+		if extends, _ := entry.Get("extends").AsBool(); extends && len(project.Include) == 0 {
+			project.Include = root.Lookup("test", "include").Strings()
+		}
+		scope.Projects = append(scope.Projects, project)
+		addCollectPatterns(scope, project)
+	}
+
+	// The root include would hide the files only a project includes.
+	if len(scope.Include) > 0 {
+		for _, project := range scope.Projects {
+			for _, pattern := range project.Include {
+				if !slices.Contains(scope.Include, pattern) {
+					scope.Include = append(scope.Include, pattern)
+				}
+			}
+		}
 	}
-	return ""
 }
 
-func parseGlobals(ctx context.Context, content []byte) bool {
-	return extraction.MatchPatternExcludingComments(ctx, content, configGlobalsPattern)
+// addCollectPatterns collects the files an inline project includes, which a workspace
+// file or a config without test.include would not name as tests otherwise.
+func addCollectPatterns(scope *framework.ConfigScope, project framework.ProjectScope) {
+	rel, err := filepath.Rel(scope.BaseDir, project.BaseDir)
+	if err != nil || strings.HasPrefix(filepath.ToSlash(rel), "..") {
+		return
+	}
+	for _, pattern := range project.Include {
+		if strings.HasPrefix(pattern, "!") || path.IsAbs(pattern) {
+			continue
+		}
+		if pattern = path.Join(filepath.ToSlash(rel), pattern); !slices.Contains(scope.CollectPatterns, pattern) {
+			scope.CollectPatterns = append(scope.CollectPatterns, pattern)
+		}
+	}
 }
 
-func parseInclude(content []byte) []string {
-	// Remove coverage block first to avoid matching coverage.include
-	cleaned := configCoveragePattern.ReplaceAll(content, []byte{})
-	match := configIncludePattern.FindSubmatch(cleaned)
-	if match == nil {
-		return nil
+// projectPattern makes a workspace entry relative to BaseDir, keeping its "!" prefix.
+func projectPattern(configDir, baseDir, pattern string) string {
+	negated := strings.HasPrefix(pattern, "!")
+	pattern = strings.TrimPrefix(pattern, "!")
+	if !filepath.IsAbs(pattern) {
+		pattern = filepath.Join(configDir, filepath.FromSlash(pattern))
 	}
-	return extractPatterns(match[1])
+	if rel, err := filepath.Rel(baseDir, pattern); err == nil {
+		pattern = filepath.ToSlash(rel)
+	}
+	if negated {
+		return "!" + pattern
+	}
+	return pattern
 }
 
-func parseExclude(content []byte) []string {
-	// Remove coverage block first to avoid matching coverage.exclude
-	cleaned := configCoveragePattern.ReplaceAll(content, []byte{})
-	match := configExcludePattern.FindSubmatch(cleaned)
-	if match == nil {
-		return nil
+// parseName returns test.name, which Vitest 3 also accepts as { label, color }.
+func parseName(config jsconfig.Value) string {
+	name := config.Lookup("test", "name")
+	if label, ok := name.Get("label").AsString(); ok {
+		return label
 	}
-	return extractPatterns(match[1])
+	label, _ := name.AsString()
+	return label
 }
 
-func extractPatterns(content []byte) []string {
-	items := configItemPattern.FindAllSubmatch(content, -1)
-	if len(items) == 0 {
-		return nil
+func projectSettings(config jsconfig.Value) map[string]interface{} {
+	settings := make(map[string]interface{})
+	if environment, ok := config.Lookup("test", "environment").AsString(); ok {
+		settings[settingEnvironment] = environment
 	}
-	var patterns []string
-	for _, item := range items {
-		patterns = append(patterns, string(item[1]))
+	return settings
+}
+
+// settingEnvironment holds a project's test.environment (node, jsdom, happy-dom, ...).
+const settingEnvironment = "environment"
+
+type VitestParser struct{}
+
+func (p *VitestParser) Parse(ctx context.Context, source []byte, filename string) (*domain.TestFile, error) {
+	return jstest.Parse(ctx, source, filename, frameworkName)
+}
+
+// parseRoot returns test.root, which overrides the Vite project root.
+func parseRoot(config jsconfig.Value) string {
+	if root, ok := config.Lookup("test", "root").AsString(); ok {
+		return root
 	}
-	return patterns
+	root, _ := config.Get("root").AsString()
+	return root
+}
+
+func parseGlobals(config jsconfig.Value) bool {
+	globals, _ := config.Lookup("test", "globals").AsBool()
+	return globals
 }
 
 // VitestContentMatcher matches vitest-specific patterns (vi.fn, vi.mock, etc.).
@@ -10,6 +10,7 @@ import (
 
 	"github.com/specvital/core/pkg/domain"
 	"github.com/specvital/core/pkg/parser/framework"
+	"github.com/specvital/core/pkg/parser/strategies/shared/jsconfig"
 )
 
 func TestNewDefinition(t *testing.T) {
@@ -226,6 +227,143 @@ export default defineConfig({
 	assert.Equal(t, expectedBaseDir, actualBaseDir)
 }
 
+func TestVitestConfigParser_ParseModule(t *testing.T) {
+	configContent := `
+import { resolve } from 'node:path';
+import { defineConfig } from 'vitest/config';
+
+const testGlob = 'src/**/*.test.ts';
+const sharedExclude = ['**/node_modules/**'];
+
+export default defineConfig(({ mode }) => ({
+  root: resolve(__dirname, 'packages/core'),
+  test: {
+    coverage: {
+      include: ['src/**'],
+      exclude: ['**/*.d.ts'],
+    },
+    include: [testGlob],
+    exclude: [...sharedExclude, '**/e2e/**'],
+    globals: mode === 'test',
+  },
+}));
+`
+
+	scope, err := (&VitestConfigParser{}).Parse(context.Background(), "/project/vitest.config.mts", []byte(configContent))
+
+	require.NoError(t, err)
+	assert.Equal(t, "/project/packages/core", scope.BaseDir)
+	assert.Equal(t, []string{"src/**/*.test.ts"}, scope.Include)
+	assert.Equal(t, []string{"**/node_modules/**", "**/e2e/**"}, scope.Exclude)
+	assert.False(t, scope.GlobalsMode, "globals depending on mode is unknown")
+}
+
+func TestVitestConfigParser_ParseProjects(t *testing.T) {
+	configContent := `
+import { defineConfig, defineProject } from 'vitest/config';
+
+export default defineConfig({
+  test: {
+    include: ['src/**/*.test.ts'],
+    projects: [
+      'packages/*',
+      '!packages/legacy',
+      './tools/vitest.config.ts',
+      {
+        extends: true,
+        test: { name: 'unit', environment: 'node' },
+      },
+      defineProject({
+        test: {
+          name: { label: 'browser', color: 'green' },
+          root: './apps/web',
+          include: ['**/*.browser.test.ts'],
+          exclude: ['**/fixtures/**'],
+          environment: 'jsdom',
+        },
+      }),
+    ],
+  },
+});
+`
+
+	scope, err := (&VitestConfigParser{}).Parse(context.Background(), "/project/vitest.config.ts", []byte(configContent))
+
+	require.NoError(t, err)
+	assert.False(t, scope.Passive)
+	assert.Equal(t, []string{"packages/*", "!packages/legacy", "tools/vitest.config.ts"}, scope.ProjectPatterns)
+	require.Len(t, scope.Projects, 2)
+
+	unit := scope.Projects[0]
+	assert.Equal(t, "unit", unit.Name)
+	assert.Equal(t, "/project", unit.BaseDir)
+	assert.Equal(t, []string{"src/**/*.test.ts"}, unit.Include, "extends: true inherits the root include")
+	assert.Equal(t, "node", unit.Settings[settingEnvironment])
+
+	browser := scope.Projects[1]
+	assert.Equal(t, "browser", browser.Name)
+	assert.Equal(t, "/project/apps/web", browser.BaseDir)
+	assert.Equal(t, []string{"**/*.browser.test.ts"}, browser.Include)
+	assert.Equal(t, []string{"**/fixtures/**"}, browser.Exclude)
+	assert.Equal(t, "jsdom", browser.Settings[settingEnvironment])
+
+	assert.Equal(t, []string{"src/**/*.test.ts", "**/*.browser.test.ts"}, scope.Include)
+	assert.Equal(t, []string{"src/**/*.test.ts", "apps/web/**/*.browser.test.ts"}, scope.CollectPatterns,
+		"project includes resolve against the project root")
+	assert.Equal(t, "browser", scope.FindMatchingProject("/project/apps/web/src/app.browser.test.ts").Name)
+}
+
+func TestVitestConfigParser_ParseWorkspace(t *testing.T) {
+	tests := []struct {
+		name       string
+		configPath string
+		content    string
+	}{
+		{
+			name:       "typescript workspace",
+			configPath: "/project/vitest.workspace.ts",
+			content: `import { defineWorkspace } from 'vitest/config';
+
+export default defineWorkspace([
+  'packages/*',
+  { test: { name: 'e2e', root: 'e2e', environment: 'happy-dom' } },
+]);`,
+		},
+		{
+			name:       "json workspace",
+			configPath: "/project/vitest.workspace.json",
+			content:    `["packages/*", {"test": {"name": "e2e", "root": "e2e", "environment": "happy-dom"}}]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope, err := (&VitestConfigParser{}).Parse(context.Background(), tt.configPath, []byte(tt.content))
+
+			require.NoError(t, err)
+			assert.Equal(t, frameworkName, scope.Framework)
+			assert.True(t, scope.Passive, "the workspace file leaves test options to vitest.config")
+			assert.Equal(t, []string{"packages/*"}, scope.ProjectPatterns)
+			require.Len(t, scope.Projects, 1)
+			assert.Equal(t, "e2e", scope.Projects[0].Name)
+			assert.Equal(t, "/project/e2e", scope.Projects[0].BaseDir)
+			assert.Equal(t, "happy-dom", scope.Projects[0].Settings[settingEnvironment])
+		})
+	}
+}
+
+func TestVitestConfigParser_ParseName(t *testing.T) {
+	configContent := `export default defineConfig({ test: { name: 'core', include: ['src/**/*.spec.ts'] } });`
+
+	scope, err := (&VitestConfigParser{}).Parse(context.Background(), "/project/packages/core/vitest.config.ts", []byte(configContent))
+
+	require.NoError(t, err)
+	require.Len(t, scope.Projects, 1)
+	assert.Equal(t, "core", scope.Projects[0].Name)
+	assert.Equal(t, "/project/packages/core", scope.Projects[0].BaseDir)
+	assert.Equal(t, []string{"src/**/*.spec.ts"}, scope.Projects[0].Include)
+}
+
 func TestVitestParser_Parse(t *testing.T) {
 	testSource := `
 import { describe, test, expect } from 'vitest';
@@ -273,6 +411,14 @@ test('top-level test', () => {
 	assert.Equal(t, "top-level test", testFile.Tests[0].Name)
 }
 
+// evalConfigFragment evaluates config properties as the argument of defineConfig.
+func evalConfigFragment(t *testing.T, fragment string) jsconfig.Value {
+	t.Helper()
+	config, err := jsconfig.Evaluate(context.Background(), "/project/vitest.config.ts", []byte("export default defineConfig({\n"+fragment+"\n});"))
+	require.NoError(t, err)
+	return config
+}
+
 func TestParseRoot(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -308,7 +454,7 @@ func TestParseRoot(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := parseRoot([]byte(tt.content))
+			result := parseRoot(evalConfigFragment(t, tt.content))
 			assert.Equal(t, tt.expected, result)
 		})
 	}
@@ -352,8 +498,7 @@ globals: false
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			ctx := context.Background()
-			result := parseGlobals(ctx, []byte(tt.content))
+			result := parseGlobals(evalConfigFragment(t, "test: {"+tt.content+"}"))
 			assert.Equal(t, tt.expected, result)
 		})
 	}
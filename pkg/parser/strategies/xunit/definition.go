@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
 	sitter "github.com/smacker/go-tree-sitter"
@@ -14,6 +15,7 @@ import (
 	"github.com/specvital/core/pkg/parser/framework"
 	"github.com/specvital/core/pkg/parser/framework/matchers"
 	"github.com/specvital/core/pkg/parser/strategies/shared/dotnetast"
+	"github.com/specvital/core/pkg/parser/strategies/shared/dotnetproj"
 )
 
 const frameworkName = "xunit"
@@ -33,8 +35,9 @@ func NewDefinition() *framework.Definition {
 			),
 			&XUnitFileMatcher{},
 			&XUnitContentMatcher{},
+			matchers.NewConfigMatcher(dotnetproj.ConfigPatterns...),
 		},
-		ConfigParser: nil,
+		ConfigParser: &dotnetproj.ConfigParser{},
 		Parser:       &XUnitParser{},
 		Priority:     framework.PriorityGeneric,
 	}
@@ -193,6 +196,7 @@ func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string,
 
 	attrLists := dotnetast.GetAttributeLists(node)
 	classStatus, classModifier := getClassStatusAndModifier(attrLists, source)
+	classTraits := dotnetast.GetTraits(attrLists, source)
 
 	body := dotnetast.GetDeclarationList(node)
 	if body == nil {
@@ -205,7 +209,11 @@ func parseTestClassWithDepth(node *sitter.Node, source []byte, filename string,
 	for _, child := range dotnetast.GetDeclarationChildren(body) {
 		switch child.Type() {
 		case dotnetast.NodeMethodDeclaration:
-			tests = append(tests, parseTestMethod(child, source, filename, classStatus, classModifier)...)
+			methodTests := parseTestMethod(child, source, filename, classStatus, classModifier)
+			for i := range methodTests {
+				methodTests[i].Tags = append(slices.Clone(classTraits), dotnetast.GetTraits(dotnetast.GetAttributeLists(child), source)...)
+			}
+			tests = append(tests, methodTests...)
 
 		case dotnetast.NodeClassDeclaration:
 			if nested := parseTestClassWithDepth(child, source, filename, depth+1); nested != nil {
@@ -19,16 +19,23 @@ import (
 	"sync"
 
 	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/bash"
+	"github.com/smacker/go-tree-sitter/c"
 	"github.com/smacker/go-tree-sitter/cpp"
 	"github.com/smacker/go-tree-sitter/csharp"
+	"github.com/smacker/go-tree-sitter/elixir"
 	"github.com/smacker/go-tree-sitter/golang"
+	"github.com/smacker/go-tree-sitter/groovy"
+	"github.com/smacker/go-tree-sitter/hcl"
 	"github.com/smacker/go-tree-sitter/java"
 	"github.com/smacker/go-tree-sitter/javascript"
 	"github.com/smacker/go-tree-sitter/kotlin"
+	"github.com/smacker/go-tree-sitter/lua"
 	"github.com/smacker/go-tree-sitter/php"
 	"github.com/smacker/go-tree-sitter/python"
 	"github.com/smacker/go-tree-sitter/ruby"
 	"github.com/smacker/go-tree-sitter/rust"
+	"github.com/smacker/go-tree-sitter/scala"
 	"github.com/smacker/go-tree-sitter/swift"
 	"github.com/smacker/go-tree-sitter/typescript/tsx"
 	"github.com/smacker/go-tree-sitter/typescript/typescript"
@@ -40,16 +47,23 @@ import (
 const MaxTreeDepth = 1000
 
 var (
+	bashLang  *sitter.Language
+	cLang     *sitter.Language
 	cppLang   *sitter.Language
 	csLang    *sitter.Language
+	exLang    *sitter.Language
 	goLang    *sitter.Language
+	grLang    *sitter.Language
+	hclLang   *sitter.Language
 	javaLang  *sitter.Language
 	jsLang    *sitter.Language
 	ktLang    *sitter.Language
+	luaLang   *sitter.Language
 	phpLang   *sitter.Language
 	pyLang    *sitter.Language
 	rbLang    *sitter.Language
 	rsLang    *sitter.Language
+	scalaLang *sitter.Language
 	swiftLang *sitter.Language
 	tsLang    *sitter.Language
 	tsxLang   *sitter.Language
@@ -59,16 +73,23 @@ var (
 
 func initLanguages() {
 	langOnce.Do(func() {
+		bashLang = bash.GetLanguage()
+		cLang = c.GetLanguage()
 		cppLang = cpp.GetLanguage()
 		csLang = csharp.GetLanguage()
+		exLang = elixir.GetLanguage()
 		goLang = golang.GetLanguage()
+		grLang = groovy.GetLanguage()
+		hclLang = hcl.GetLanguage()
 		javaLang = java.GetLanguage()
 		jsLang = javascript.GetLanguage()
 		ktLang = kotlin.GetLanguage()
+		luaLang = lua.GetLanguage()
 		phpLang = php.GetLanguage()
 		pyLang = python.GetLanguage()
 		rbLang = ruby.GetLanguage()
 		rsLang = rust.GetLanguage()
+		scalaLang = scala.GetLanguage()
 		swiftLang = swift.GetLanguage()
 		tsLang = typescript.GetLanguage()
 		tsxLang = tsx.GetLanguage()
@@ -79,18 +100,28 @@ func initLanguages() {
 func GetLanguage(lang domain.Language) *sitter.Language {
 	initLanguages()
 	switch lang {
+	case domain.LanguageC:
+		return cLang
 	case domain.LanguageCpp:
 		return cppLang
 	case domain.LanguageCSharp:
 		return csLang
+	case domain.LanguageElixir:
+		return exLang
 	case domain.LanguageGo:
 		return goLang
+	case domain.LanguageGroovy:
+		return grLang
+	case domain.LanguageHCL:
+		return hclLang
 	case domain.LanguageJava:
 		return javaLang
 	case domain.LanguageJavaScript:
 		return jsLang
 	case domain.LanguageKotlin:
 		return ktLang
+	case domain.LanguageLua:
+		return luaLang
 	case domain.LanguagePHP:
 		return phpLang
 	case domain.LanguagePython:
@@ -99,6 +130,10 @@ func GetLanguage(lang domain.Language) *sitter.Language {
 		return rbLang
 	case domain.LanguageRust:
 		return rsLang
+	case domain.LanguageScala:
+		return scalaLang
+	case domain.LanguageShell:
+		return bashLang
 	case domain.LanguageSwift:
 		return swiftLang
 	case domain.LanguageTSX: